@@ -0,0 +1,267 @@
+package clientgenv2
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/99designs/gqlgen/codegen/templates"
+
+	"github.com/gqlgo/gqlgenc/parsequery"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// OperationBatch describes a query generated with generate.batches, merging the root fields of query operations to
+// fetch their results in one round-trip. The root fields and the variables of each operation are prefixed with
+// the name of the operation, so those of different operations do not collide.
+type OperationBatch struct {
+	// Name is the name of the method and of the query it sends.
+	Name string
+	// Document is the merged query.
+	Document string
+	// Operations are the operations of the batch, in order.
+	Operations []*BatchedOperation
+	// Args are the arguments of the operations, prefixed, in order.
+	Args                []*Argument
+	VariableDefinitions ast.VariableDefinitionList
+	// Sensitive are the sensitive fields of the operations, prefixed, when some of them have any.
+	Sensitive *SensitiveFields
+	// Extensions are the extensions the batch sends, such as the one of generate.complexityHints.
+	Extensions []*OperationExtension
+}
+
+// BatchedOperation is an operation of an OperationBatch.
+type BatchedOperation struct {
+	// Name is the name of the operation.
+	Name string
+	// ResponseStructName is the response struct of the operation, holding its result in the batch.
+	ResponseStructName string
+	// Prefix is the prefix of the root fields and variables of the operation in the document of the batch.
+	Prefix string
+	// ClientFields is set when the operation selects fields marked with @client.
+	ClientFields bool
+}
+
+// Batches returns the batches of generate.batches, by name, from the operations and the documents each of them
+// sends. Only queries can be batched, and the fragments they use must not use variables, since those are renamed
+// per operation.
+func (s *Source) Batches(operations []*Operation, queryDocuments []*ast.QueryDocument) ([]*OperationBatch, error) {
+	batchConfigs := s.generateConfig.GetBatches()
+	if len(batchConfigs) == 0 {
+		return nil, nil
+	}
+
+	queryDocumentsMap := queryDocumentMapByOperationName(queryDocuments)
+	operationsMap := make(map[string]*Operation, len(operations))
+
+	for _, operation := range operations {
+		operationsMap[operation.Name] = operation
+	}
+
+	batches := make([]*OperationBatch, 0, len(batchConfigs))
+
+	for _, name := range slices.Sorted(maps.Keys(batchConfigs)) {
+		batch, err := s.newOperationBatch(name, batchConfigs[name], operationsMap, queryDocumentsMap)
+		if err != nil {
+			return nil, fmt.Errorf("batch %s: %w", name, err)
+		}
+
+		batches = append(batches, batch)
+	}
+
+	return batches, nil
+}
+
+func (s *Source) newOperationBatch(name string, operationNames []string, operations map[string]*Operation, queryDocuments map[string]*ast.QueryDocument) (*OperationBatch, error) {
+	batch := &OperationBatch{Name: templates.ToGo(name)}
+
+	merged := &ast.OperationDefinition{Operation: ast.Query, Name: batch.Name}
+	document := &ast.QueryDocument{Operations: ast.OperationList{merged}}
+
+	for _, operationName := range operationNames {
+		operation := operations[operationName]
+		if operation == nil {
+			return nil, fmt.Errorf("unknown operation %s", operationName)
+		}
+
+		definition := s.queryDocument.Operations.ForName(operationName)
+		if definition.Operation != ast.Query {
+			return nil, fmt.Errorf("%s is a %s, only queries can be batched", operationName, definition.Operation)
+		}
+
+		batched := &BatchedOperation{
+			Name:               operation.Name,
+			ResponseStructName: operation.ResponseStructName,
+			Prefix:             operation.Name + "_",
+			ClientFields:       operation.ClientFields,
+		}
+
+		for _, other := range batch.Operations {
+			if strings.HasPrefix(batched.Prefix, other.Prefix) || strings.HasPrefix(other.Prefix, batched.Prefix) {
+				return nil, fmt.Errorf("the fields of %s and %s cannot be told apart, their names start the same", other.Name, operationName)
+			}
+		}
+
+		// the document sent by the operation is parsed again, so its fields can be renamed without changing it
+		parsed, err := parser.ParseQuery(&ast.Source{Name: operationName, Input: queryString(queryDocuments[operationName], false)})
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", operationName, err)
+		}
+
+		sent := parsed.Operations[0]
+
+		for _, selection := range sent.SelectionSet {
+			field, ok := selection.(*ast.Field)
+			if !ok {
+				return nil, fmt.Errorf("%s spreads fragments at its root, which cannot be prefixed", operationName)
+			}
+
+			field.Alias = batched.Prefix + field.Alias
+		}
+
+		prefixSelectionVariables(sent.SelectionSet, batched.Prefix)
+
+		for i, variable := range sent.VariableDefinitions {
+			variable.Variable = batched.Prefix + variable.Variable
+
+			arg := *operation.Args[i]
+			arg.Variable = variable.Variable
+			batch.Args = append(batch.Args, &arg)
+		}
+
+		for _, fragment := range parsed.Fragments {
+			if selectionUsesVariables(fragment.SelectionSet) {
+				return nil, fmt.Errorf("fragment %s of %s uses variables, which cannot be prefixed", fragment.Name, operationName)
+			}
+
+			if document.Fragments.ForName(fragment.Name) == nil {
+				document.Fragments = append(document.Fragments, fragment)
+			}
+		}
+
+		if operation.Sensitive != nil {
+			if batch.Sensitive == nil {
+				batch.Sensitive = &SensitiveFields{}
+			}
+
+			for _, path := range operation.Sensitive.Variables {
+				batch.Sensitive.Variables = append(batch.Sensitive.Variables, batched.Prefix+path)
+			}
+
+			for _, path := range operation.Sensitive.Response {
+				batch.Sensitive.Response = append(batch.Sensitive.Response, batched.Prefix+path)
+			}
+		}
+
+		merged.VariableDefinitions = append(merged.VariableDefinitions, sent.VariableDefinitions...)
+		merged.SelectionSet = append(merged.SelectionSet, sent.SelectionSet...)
+		batch.Operations = append(batch.Operations, batched)
+	}
+
+	batch.VariableDefinitions = merged.VariableDefinitions
+	batch.Document = queryString(document, false)
+
+	// the merged document is validated on its own, as the server will
+	validated, err := parser.ParseQuery(&ast.Source{Name: name, Input: batch.Document})
+	if err != nil {
+		return nil, fmt.Errorf("parse: %w", err)
+	}
+
+	if _, err := parsequery.ValidationRules(s.generateConfig.GetValidationRules()).Validate(s.schema, validated); err != nil {
+		return nil, err
+	}
+
+	// the complexity of the batch is the one of the merged query, whose fields validation resolved
+	complexityHint, err := newComplexityHint(validated.Operations[0], s.generateConfig.GetComplexityHints())
+	if err != nil {
+		return nil, fmt.Errorf("generating complexity hint failed: %w", err)
+	}
+
+	if complexityHint != nil {
+		batch.Extensions = append(batch.Extensions, complexityHint)
+	}
+
+	return batch, nil
+}
+
+// prefixSelectionVariables prefixes the variables the arguments of fields and directives of selectionSet refer to.
+func prefixSelectionVariables(selectionSet ast.SelectionSet, prefix string) {
+	for _, selection := range selectionSet {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			for _, argument := range selection.Arguments {
+				prefixVariables(argument.Value, prefix)
+			}
+
+			prefixDirectiveVariables(selection.Directives, prefix)
+			prefixSelectionVariables(selection.SelectionSet, prefix)
+		case *ast.InlineFragment:
+			prefixDirectiveVariables(selection.Directives, prefix)
+			prefixSelectionVariables(selection.SelectionSet, prefix)
+		case *ast.FragmentSpread:
+			prefixDirectiveVariables(selection.Directives, prefix)
+		}
+	}
+}
+
+func prefixDirectiveVariables(directives ast.DirectiveList, prefix string) {
+	for _, directive := range directives {
+		for _, argument := range directive.Arguments {
+			prefixVariables(argument.Value, prefix)
+		}
+	}
+}
+
+func prefixVariables(value *ast.Value, prefix string) {
+	if value == nil {
+		return
+	}
+
+	if value.Kind == ast.Variable {
+		value.Raw = prefix + value.Raw
+	}
+
+	for _, child := range value.Children {
+		prefixVariables(child.Value, prefix)
+	}
+}
+
+// selectionUsesVariables reports whether the arguments of fields and directives of selectionSet refer to variables.
+func selectionUsesVariables(selectionSet ast.SelectionSet) bool {
+	variables := make(map[string]bool)
+
+	var collect func(selectionSet ast.SelectionSet)
+	collect = func(selectionSet ast.SelectionSet) {
+		for _, selection := range selectionSet {
+			var directives ast.DirectiveList
+
+			switch selection := selection.(type) {
+			case *ast.Field:
+				for _, argument := range selection.Arguments {
+					collectVariables(argument.Value, variables)
+				}
+
+				directives = selection.Directives
+				collect(selection.SelectionSet)
+			case *ast.InlineFragment:
+				directives = selection.Directives
+				collect(selection.SelectionSet)
+			case *ast.FragmentSpread:
+				directives = selection.Directives
+			}
+
+			for _, directive := range directives {
+				for _, argument := range directive.Arguments {
+					collectVariables(argument.Value, variables)
+				}
+			}
+		}
+	}
+
+	collect(selectionSet)
+
+	return len(variables) > 0
+}
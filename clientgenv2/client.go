@@ -2,6 +2,7 @@ package clientgenv2
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/99designs/gqlgen/codegen/config"
 	"github.com/99designs/gqlgen/plugin"
@@ -21,6 +22,12 @@ type Plugin struct {
 	operationQueryDocuments []*ast.QueryDocument
 	Client                  config.PackageConfig
 	GenerateConfig          *gqlgencConfig.GenerateConfig
+	// SchemaHash is the hash of the server schema recorded in the client when generate.schemaHash is set.
+	SchemaHash string
+	// OverlayFields are the fields of the overlay schema, as <Type>.<field>, flagged on the operations selecting them.
+	OverlayFields map[string]bool
+	// Progress reports the phases of the generation and the time the response types of each operation took.
+	Progress *Progress
 }
 
 func New(queryDocument *ast.QueryDocument, operationQueryDocuments []*ast.QueryDocument, client config.PackageConfig, generateConfig *gqlgencConfig.GenerateConfig) *Plugin {
@@ -52,9 +59,27 @@ func (p *Plugin) MutateConfig(cfg *config.Config) error {
 			return fmt.Errorf("load query sources failed: %w", err)
 		}
 
-		queryDocument, err = parsequery.ParseQueryDocuments(cfg.Schema, querySources)
+		var warnings parsequery.Errors
+
+		queryDocument, warnings, err = parsequery.ParseQueryDocumentsWithRules(cfg.Schema, querySources, p.GenerateConfig.GetValidationRules())
 		if err != nil {
-			return fmt.Errorf(": %w", err)
+			return err
+		}
+
+		if len(warnings) > 0 {
+			fmt.Fprintln(os.Stderr, warnings)
+		}
+
+		if !p.GenerateConfig.ShouldAllowSubscriptions() {
+			err = parsequery.RejectSubscriptions(queryDocument)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = parsequery.DeriveSubsets(queryDocument)
+		if err != nil {
+			return err
 		}
 	}
 
@@ -62,9 +87,9 @@ func (p *Plugin) MutateConfig(cfg *config.Config) error {
 
 	operationQueryDocuments := p.operationQueryDocuments
 	if operationQueryDocuments == nil {
-		operationQueryDocuments, err = querydocument.QueryDocumentsByOperations(cfg.Schema, queryDocument.Operations)
+		operationQueryDocuments, err = querydocument.QueryDocumentsByOperations(cfg.Schema, queryDocument.Operations, p.GenerateConfig.GetValidationRules())
 		if err != nil {
-			return fmt.Errorf(": %w", err)
+			return err
 		}
 	}
 
@@ -72,12 +97,17 @@ func (p *Plugin) MutateConfig(cfg *config.Config) error {
 	// Generate code from template and document source
 	sourceGenerator := NewSourceGenerator(cfg, p.Client, p.GenerateConfig)
 	source := NewSource(cfg.Schema, queryDocument, sourceGenerator, p.GenerateConfig)
+	source.progress = p.Progress
+
+	p.Progress.Phase("generating %d fragments", len(queryDocument.Fragments))
 
 	fragments, err := source.Fragments()
 	if err != nil {
 		return fmt.Errorf("generating fragment failed: %w", err)
 	}
 
+	p.Progress.Phase("generating the response types of %d operations", len(queryDocument.Operations))
+
 	operationResponses, err := source.OperationResponses()
 	if err != nil {
 		return fmt.Errorf("generating operation response failed: %w", err)
@@ -88,10 +118,72 @@ func (p *Plugin) MutateConfig(cfg *config.Config) error {
 		return fmt.Errorf("generating operation failed: %w", err)
 	}
 
-	err = RenderTemplate(cfg, fragments, operations, operationResponses, source.ResponseSubTypes(), p.GenerateConfig, p.Client)
+	if len(p.OverlayFields) > 0 {
+		markOverlayFields(operations, queryDocument, p.OverlayFields)
+	}
+
+	var validatedInputs []*ValidatedInput
+	if p.GenerateConfig.ShouldGenerateInputValidation() {
+		validatedInputs, err = sourceGenerator.ValidatedInputs()
+		if err != nil {
+			return fmt.Errorf("generating input validation failed: %w", err)
+		}
+
+		markValidatedArguments(operations, cfg.Model.ImportPath(), validatedInputs)
+	}
+
+	// the arguments of batches are copied from those of their operations, validation included
+	batches, err := source.Batches(operations, operationQueryDocuments)
+	if err != nil {
+		return fmt.Errorf("generating batch failed: %w", err)
+	}
+
+	p.Progress.Phase("rendering the client")
+
+	if p.GenerateConfig.ShouldGenerateDocumentsOnly() {
+		err = RenderDocuments(cfg, operations, p.Client)
+	} else {
+		err = RenderTemplate(cfg, fragments, operations, batches, operationResponses, source.ResponseSubTypes(), p.GenerateConfig, p.Client, p.SchemaHash)
+	}
+
 	if err != nil {
 		return fmt.Errorf("template failed: %w", err)
 	}
 
+	if manifest := p.GenerateConfig.GetOperationManifest(); manifest != "" {
+		err = WriteOperationManifest(manifest, operations)
+		if err != nil {
+			return fmt.Errorf("%s generating failed: %w", manifest, err)
+		}
+	}
+
+	if descriptions := p.GenerateConfig.GetOperationDescriptions(); descriptions != "" {
+		err = WriteOperationDescriptions(descriptions, cfg.Schema, queryDocument, operations, p.OverlayFields)
+		if err != nil {
+			return fmt.Errorf("%s generating failed: %w", descriptions, err)
+		}
+	}
+
+	if p.GenerateConfig.ShouldGenerateOmittableHelpers() {
+		err = RenderOmittableHelpers(cfg, sourceGenerator.OmittableInputs())
+		if err != nil {
+			return fmt.Errorf("template failed: %w", err)
+		}
+	}
+
+	if p.GenerateConfig.ShouldRedactSensitive() {
+		err = RenderRedaction(cfg, sourceGenerator.SensitiveInputs())
+		if err != nil {
+			return fmt.Errorf("template failed: %w", err)
+		}
+	}
+
+	if p.GenerateConfig.ShouldGenerateInputValidation() {
+		err = RenderValidation(cfg, validatedInputs)
+		if err != nil {
+			return fmt.Errorf("template failed: %w", err)
+		}
+	}
+
 	return nil
 }
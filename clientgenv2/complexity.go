@@ -0,0 +1,151 @@
+package clientgenv2
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	gqlgencConfig "github.com/gqlgo/gqlgenc/config"
+)
+
+// listSizeArguments are the arguments giving the number of items of list fields.
+var listSizeArguments = []string{"first", "last", "limit"}
+
+// ComplexityHint is the data the templates of generate.complexityHints are executed with.
+type ComplexityHint struct {
+	// Operation is the name of the operation.
+	Operation string
+	// Kind is the kind of the operation: query, mutation or subscription.
+	Kind string
+	// Complexity is the number of fields the operation selects, those under lists counted once per item.
+	Complexity int
+	// Depth is the number of levels of fields the operation selects.
+	Depth int
+}
+
+// OperationExtension is an extension generated operations send, with its JSON value.
+type OperationExtension struct {
+	Key   string
+	Value string
+}
+
+// newComplexityHint returns the extension of generate.complexityHints for operation, or nil when cfg is nil.
+func newComplexityHint(operation *ast.OperationDefinition, cfg *gqlgencConfig.ComplexityHintsConfig) (*OperationExtension, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+
+	complexity, depth := selectionComplexity(operation.SelectionSet, operation.VariableDefinitions, cfg.GetListSize(), false)
+	hint := ComplexityHint{
+		Operation:  operation.Name,
+		Kind:       string(operation.Operation),
+		Complexity: complexity,
+		Depth:      depth,
+	}
+
+	keyTemplate, err := cfg.KeyTemplate()
+	if err != nil {
+		return nil, err
+	}
+
+	valueTemplate, err := cfg.ValueTemplate()
+	if err != nil {
+		return nil, err
+	}
+
+	var key, value bytes.Buffer
+
+	if err := keyTemplate.Execute(&key, hint); err != nil {
+		return nil, fmt.Errorf("complexityHints.key: %w", err)
+	}
+
+	if err := valueTemplate.Execute(&value, hint); err != nil {
+		return nil, fmt.Errorf("complexityHints.value: %w", err)
+	}
+
+	if key.Len() == 0 {
+		return nil, fmt.Errorf("complexityHints.key of %s is empty", operation.Name)
+	}
+
+	if !json.Valid(value.Bytes()) {
+		return nil, fmt.Errorf("complexityHints.value of %s is not JSON: %s", operation.Name, value.String())
+	}
+
+	return &OperationExtension{Key: key.String(), Value: value.String()}, nil
+}
+
+// selectionComplexity returns the complexity and the depth of set. Every field counts for 1, plus the complexity
+// of its selection times its number of items: the value of its first, last or limit argument, or listSize for
+// lists without one. Lists directly under a field having such an argument, like the edges of connections, are
+// counted once, since the argument already gives their size. __typename is free.
+func selectionComplexity(set ast.SelectionSet, variables ast.VariableDefinitionList, listSize int, sized bool) (int, int) {
+	var complexity, depth int
+
+	for _, selection := range set {
+		var itemComplexity, itemDepth int
+
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if selection.Name == "__typename" {
+				continue
+			}
+
+			items, ok := sizeArgument(selection, variables)
+			if !ok {
+				items = 1
+				if !sized && selection.Definition != nil && selection.Definition.Type.Elem != nil {
+					items = listSize
+				}
+			}
+
+			childComplexity, childDepth := selectionComplexity(selection.SelectionSet, variables, listSize, ok)
+			itemComplexity = 1 + childComplexity*items
+			itemDepth = 1 + childDepth
+		case *ast.InlineFragment:
+			itemComplexity, itemDepth = selectionComplexity(selection.SelectionSet, variables, listSize, sized)
+		case *ast.FragmentSpread:
+			if selection.Definition != nil {
+				itemComplexity, itemDepth = selectionComplexity(selection.Definition.SelectionSet, variables, listSize, sized)
+			}
+		}
+
+		complexity += itemComplexity
+		depth = max(depth, itemDepth)
+	}
+
+	return complexity, depth
+}
+
+// sizeArgument returns the number of items given by the first, last or limit argument of field, when it is a
+// literal or a variable with a default value.
+func sizeArgument(field *ast.Field, variables ast.VariableDefinitionList) (int, bool) {
+	for _, name := range listSizeArguments {
+		argument := field.Arguments.ForName(name)
+		if argument == nil || argument.Value == nil {
+			continue
+		}
+
+		value := argument.Value
+		if value.Kind == ast.Variable {
+			variable := variables.ForName(value.Raw)
+			if variable == nil || variable.DefaultValue == nil {
+				continue
+			}
+
+			value = variable.DefaultValue
+		}
+
+		if value.Kind != ast.IntValue {
+			continue
+		}
+
+		if items, err := strconv.Atoi(value.Raw); err == nil && items >= 0 {
+			return items, true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,140 @@
+package clientgenv2
+
+import (
+	"go/types"
+
+	"github.com/99designs/gqlgen/codegen/templates"
+
+	"github.com/gqlgo/gqlgenc/parsequery"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// TotalCountField is the field of connection types the count methods select.
+const TotalCountField = "totalCount"
+
+// paginationArguments are the arguments of connection fields that page through the items without changing
+// how many there are, left out of the queries of count methods when they are optional.
+var paginationArguments = map[string]bool{"first": true, "last": true, "after": true, "before": true}
+
+// OperationCount describes a count method generated with generate.countMethods for a root field of a query whose
+// type has a totalCount field, like the connections of the Relay specification.
+type OperationCount struct {
+	// Name is the name of the method and of the query it sends, Count<Operation>, followed by the name of
+	// the field when the operation has several fields to count.
+	Name string
+	// Document is the query selecting only the totalCount of the field.
+	Document string
+	// FieldName is the Go name of the field in the response struct.
+	FieldName string
+	// FieldAlias is the JSON key of the field in the response data.
+	FieldAlias string
+	// Type is the type of totalCount.
+	Type types.Type
+	// Args are the arguments of the operation the query uses, in order.
+	Args                []*Argument
+	VariableDefinitions ast.VariableDefinitionList
+}
+
+// OperationCounts returns the count methods of the root fields of operation with a totalCount field. Mutations
+// and subscriptions have none, since sending them again is not free of side effects.
+func (r *SourceGenerator) OperationCounts(operation *ast.OperationDefinition, args []*Argument) []*OperationCount {
+	if operation.Operation != ast.Query {
+		return nil
+	}
+
+	var fields []*ast.Field
+
+	for _, selection := range operation.SelectionSet {
+		field, ok := selection.(*ast.Field)
+		if !ok || field.Definition == nil || field.Directives.ForName(parsequery.ClientDirective) != nil {
+			continue
+		}
+
+		if definition := r.cfg.Schema.Types[field.Definition.Type.Name()]; definition != nil && definition.Fields.ForName(TotalCountField) != nil {
+			fields = append(fields, field)
+		}
+	}
+
+	counts := make([]*OperationCount, 0, len(fields))
+
+	for _, field := range fields {
+		name := "Count" + templates.ToGo(operation.Name)
+		if len(fields) > 1 {
+			name += templates.ToGo(field.Alias)
+		}
+
+		countField := &ast.Field{
+			Alias:      field.Alias,
+			Name:       field.Name,
+			Directives: parsequery.StripClientDirectives(field.Directives),
+			SelectionSet: ast.SelectionSet{
+				&ast.Field{Alias: TotalCountField, Name: TotalCountField},
+			},
+		}
+
+		for _, argument := range field.Arguments {
+			definition := field.Definition.Arguments.ForName(argument.Name)
+			if definition != nil && !definition.Type.NonNull && paginationArguments[argument.Name] {
+				continue
+			}
+
+			countField.Arguments = append(countField.Arguments, argument)
+		}
+
+		variables := make(map[string]bool)
+		for _, argument := range countField.Arguments {
+			collectVariables(argument.Value, variables)
+		}
+
+		for _, directive := range countField.Directives {
+			for _, argument := range directive.Arguments {
+				collectVariables(argument.Value, variables)
+			}
+		}
+
+		count := &OperationCount{
+			Name:       name,
+			FieldName:  templates.ToGo(field.Alias),
+			FieldAlias: field.Alias,
+		}
+
+		for i, variable := range operation.VariableDefinitions {
+			if variables[variable.Variable] {
+				count.Args = append(count.Args, args[i])
+				count.VariableDefinitions = append(count.VariableDefinitions, variable)
+			}
+		}
+
+		totalCount := r.cfg.Schema.Types[field.Definition.Type.Name()].Fields.ForName(TotalCountField)
+		count.Type = r.binder.CopyModifiersFromAst(totalCount.Type, r.Type(totalCount.Type.Name()))
+
+		count.Document = queryString(&ast.QueryDocument{
+			Operations: ast.OperationList{{
+				Operation:           ast.Query,
+				Name:                name,
+				VariableDefinitions: count.VariableDefinitions,
+				SelectionSet:        ast.SelectionSet{countField},
+			}},
+		}, false)
+
+		counts = append(counts, count)
+	}
+
+	return counts
+}
+
+// collectVariables adds to variables the names of the variables value refers to.
+func collectVariables(value *ast.Value, variables map[string]bool) {
+	if value == nil {
+		return
+	}
+
+	if value.Kind == ast.Variable {
+		variables[value.Raw] = true
+	}
+
+	for _, child := range value.Children {
+		collectVariables(child.Value, variables)
+	}
+}
@@ -0,0 +1,110 @@
+package clientgenv2
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/99designs/gqlgen/codegen/templates"
+
+	gqlgencConfig "github.com/gqlgo/gqlgenc/config"
+)
+
+// DefaultClient is the NewDefaultClient constructor generated for generate.defaultClient, or a constructor
+// generated for an endpoint of generate.endpointClients.
+type DefaultClient struct {
+	// Name is the name of the constructor.
+	Name string
+	// Config is the key of the config of the constructor, for its comment.
+	Config string
+	// EndpointName is the name of the endpoint in generate.endpointClients, empty for generate.defaultClient.
+	EndpointName string
+	Endpoint     string
+	EndpointEnv  string
+	Headers      map[string]string
+	// Timeout is the Go expression of the timeout of requests, empty when there is none.
+	Timeout string
+	// MaxAttempts is the clientv2.RetryPolicy.MaxAttempts of the retries, 0 when requests are not retried.
+	MaxAttempts int
+}
+
+// NewDefaultClient returns the constructor to generate for cfg, or nil when cfg is nil.
+func NewDefaultClient(cfg *gqlgencConfig.DefaultClientConfig) (*DefaultClient, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+
+	timeout, err := cfg.TimeoutDuration()
+	if err != nil {
+		return nil, fmt.Errorf("defaultClient.timeout: %w", err)
+	}
+
+	c := &DefaultClient{
+		Name:        "NewDefaultClient",
+		Config:      "generate.defaultClient",
+		Endpoint:    cfg.Endpoint,
+		EndpointEnv: cfg.EndpointEnv,
+		Headers:     cfg.Headers,
+		Timeout:     durationExpr(timeout),
+	}
+
+	if cfg.Retries > 0 {
+		c.MaxAttempts = cfg.Retries + 1
+	}
+
+	return c, nil
+}
+
+// NewEndpointClients returns the New<Name>Client constructors to generate for the endpoints of cfgs by name,
+// sorted by name.
+func NewEndpointClients(cfgs map[string]*gqlgencConfig.DefaultClientConfig) ([]*DefaultClient, error) {
+	constructors := map[string]string{"NewDefaultClient": "defaultClient", "NewClient": "NewClient"}
+
+	clients := make([]*DefaultClient, 0, len(cfgs))
+
+	for _, name := range slices.Sorted(maps.Keys(cfgs)) {
+		c, err := NewDefaultClient(cfgs[name])
+		if err != nil {
+			return nil, fmt.Errorf("endpointClients.%s: %w", name, err)
+		}
+
+		c.Name = "New" + templates.ToGo(name) + "Client"
+		c.Config = "generate.endpointClients." + name
+		c.EndpointName = name
+
+		if other, ok := constructors[c.Name]; ok {
+			return nil, fmt.Errorf("endpointClients.%s: %s is already generated for %s", name, c.Name, other)
+		}
+
+		constructors[c.Name] = "endpointClients." + name
+
+		clients = append(clients, c)
+	}
+
+	return clients, nil
+}
+
+// durationExpr returns the Go expression of d in its largest whole unit, empty for 0.
+func durationExpr(d time.Duration) string {
+	if d == 0 {
+		return ""
+	}
+
+	for _, unit := range []struct {
+		duration time.Duration
+		name     string
+	}{
+		{time.Hour, "time.Hour"},
+		{time.Minute, "time.Minute"},
+		{time.Second, "time.Second"},
+		{time.Millisecond, "time.Millisecond"},
+		{time.Microsecond, "time.Microsecond"},
+	} {
+		if d%unit.duration == 0 {
+			return fmt.Sprintf("%d * %s", d/unit.duration, unit.name)
+		}
+	}
+
+	return fmt.Sprintf("%d * time.Nanosecond", d)
+}
@@ -0,0 +1,254 @@
+package clientgenv2
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/gqlgo/gqlgenc/parsequery"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// operationsDescription is the machine-readable description of the operations of the client written by
+// WriteOperationDescriptions, for tools generating documentation, clients in other languages or contract tests.
+type operationsDescription struct {
+	Operations []*operationDescription `json:"operations"`
+	// Types are the input objects, enums and custom scalars referenced by the operations, by name.
+	Types map[string]*typeDescription `json:"types"`
+
+	// overlayFields are the fields of the overlay schema, as <Type>.<field>.
+	overlayFields map[string]bool
+}
+
+type operationDescription struct {
+	Name string `json:"name"`
+	// Type is query, mutation or subscription.
+	Type      string                 `json:"type"`
+	Document  string                 `json:"document"`
+	Hash      string                 `json:"hash"`
+	Variables []*variableDescription `json:"variables"`
+	Response  *selectionDescription  `json:"response"`
+}
+
+type variableDescription struct {
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	DefaultValue string `json:"defaultValue,omitempty"`
+}
+
+// selectionDescription is the shape of a selected object: the fields selected on every type it may be, and
+// the fields selected on some of them only.
+type selectionDescription struct {
+	Fields    []*fieldDescription              `json:"fields"`
+	Fragments map[string]*selectionDescription `json:"fragments,omitempty"`
+}
+
+type fieldDescription struct {
+	// Name is the key of the field in responses, its alias when it has one.
+	Name string `json:"name"`
+	// Field is the name of the field in the schema when it differs from Name.
+	Field     string                `json:"field,omitempty"`
+	Type      string                `json:"type"`
+	Selection *selectionDescription `json:"selection,omitempty"`
+	// Overlay is set for the fields of the overlay schema, which the server may not serve yet.
+	Overlay bool `json:"overlay,omitempty"`
+}
+
+type typeDescription struct {
+	Kind   string              `json:"kind"`
+	Fields []*fieldDescription `json:"fields,omitempty"`
+	Values []string            `json:"values,omitempty"`
+}
+
+// WriteOperationDescriptions writes the description of the operations of queryDocument to filename as JSON:
+// their documents and hashes as sent by the client, their variables with their types, the shape of their
+// responses, and the input objects, enums and custom scalars they reference. The fields of overlayFields, given as
+// <Type>.<field>, are flagged as overlay fields.
+func WriteOperationDescriptions(filename string, schema *ast.Schema, queryDocument *ast.QueryDocument, operations []*Operation, overlayFields map[string]bool) error {
+	documents := make(map[string]string, len(operations))
+	for _, operation := range operations {
+		documents[operation.Name] = operation.Operation
+	}
+
+	description := &operationsDescription{
+		Operations:    make([]*operationDescription, 0, len(queryDocument.Operations)),
+		Types:         make(map[string]*typeDescription),
+		overlayFields: overlayFields,
+	}
+
+	for _, operation := range queryDocument.Operations {
+		variables := make([]*variableDescription, 0, len(operation.VariableDefinitions))
+		for _, variable := range operation.VariableDefinitions {
+			v := &variableDescription{Name: variable.Variable, Type: variable.Type.String()}
+			if variable.DefaultValue != nil {
+				v.DefaultValue = variable.DefaultValue.String()
+			}
+
+			variables = append(variables, v)
+			description.addType(schema, variable.Type.Name())
+		}
+
+		description.Operations = append(description.Operations, &operationDescription{
+			Name:      operation.Name,
+			Type:      string(operation.Operation),
+			Document:  documents[operation.Name],
+			Hash:      documentHash(documents[operation.Name]),
+			Variables: variables,
+			Response:  description.selection(schema, operation.SelectionSet, ""),
+		})
+	}
+
+	data, err := json.MarshalIndent(description, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode operation descriptions: %w", err)
+	}
+
+	if err := os.WriteFile(filename, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("write operation descriptions: %w", err)
+	}
+
+	return nil
+}
+
+// selection describes selectionSet selected on typeName, flattening the fragments on typeName
+// and grouping the others by type condition. Fields marked with @client are not sent, so they are left out.
+func (d *operationsDescription) selection(schema *ast.Schema, selectionSet ast.SelectionSet, typeName string) *selectionDescription {
+	s := &selectionDescription{Fields: []*fieldDescription{}}
+
+	d.addSelections(schema, s, selectionSet, typeName)
+
+	return s
+}
+
+func (d *operationsDescription) addSelections(schema *ast.Schema, s *selectionDescription, selectionSet ast.SelectionSet, typeName string) {
+	for _, selection := range selectionSet {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if selection.Directives.ForName(parsequery.ClientDirective) != nil {
+				continue
+			}
+
+			d.addField(schema, s, selection)
+		case *ast.InlineFragment:
+			d.addFragment(schema, s, selection.SelectionSet, selection.TypeCondition, typeName)
+		case *ast.FragmentSpread:
+			if selection.Definition != nil {
+				d.addFragment(schema, s, selection.Definition.SelectionSet, selection.Definition.TypeCondition, typeName)
+			}
+		}
+	}
+}
+
+func (d *operationsDescription) addFragment(schema *ast.Schema, s *selectionDescription, selectionSet ast.SelectionSet, typeCondition, typeName string) {
+	if typeCondition == "" || typeCondition == typeName {
+		d.addSelections(schema, s, selectionSet, typeName)
+
+		return
+	}
+
+	if s.Fragments == nil {
+		s.Fragments = make(map[string]*selectionDescription)
+	}
+
+	fragment, ok := s.Fragments[typeCondition]
+	if !ok {
+		fragment = &selectionDescription{Fields: []*fieldDescription{}}
+		s.Fragments[typeCondition] = fragment
+	}
+
+	d.addSelections(schema, fragment, selectionSet, typeCondition)
+}
+
+// addField adds field to s, merging its selection into the field of the same response key already selected.
+func (d *operationsDescription) addField(schema *ast.Schema, s *selectionDescription, field *ast.Field) {
+	var fieldType *ast.Type
+	if field.Definition != nil {
+		fieldType = field.Definition.Type
+	}
+
+	f := &fieldDescription{Name: field.Alias}
+	if f.Name == "" {
+		f.Name = field.Name
+	}
+
+	if field.Name != f.Name {
+		f.Field = field.Name
+	}
+
+	f.Overlay = d.overlayFields[overlayFieldName(field)]
+
+	switch {
+	case field.Name == "__typename":
+		f.Type = "String!"
+	case fieldType != nil:
+		f.Type = fieldType.String()
+
+		if definition := schema.Types[fieldType.Name()]; definition != nil && !definition.IsLeafType() {
+			f.Selection = &selectionDescription{Fields: []*fieldDescription{}}
+		} else {
+			d.addType(schema, fieldType.Name())
+		}
+	}
+
+	for _, existing := range s.Fields {
+		if existing.Name == f.Name {
+			f = existing
+
+			break
+		}
+	}
+
+	if f.Selection != nil && fieldType != nil {
+		d.addSelections(schema, f.Selection, field.SelectionSet, fieldType.Name())
+	}
+
+	if !containsField(s.Fields, f) {
+		s.Fields = append(s.Fields, f)
+	}
+}
+
+func containsField(fields []*fieldDescription, field *fieldDescription) bool {
+	for _, f := range fields {
+		if f == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+// addType adds the input object, enum or custom scalar named name, and the types of its fields, to the types.
+func (d *operationsDescription) addType(schema *ast.Schema, name string) {
+	definition := schema.Types[name]
+	if definition == nil || definition.BuiltIn {
+		return
+	}
+
+	if _, ok := d.Types[name]; ok {
+		return
+	}
+
+	t := &typeDescription{Kind: string(definition.Kind)}
+
+	switch definition.Kind {
+	case ast.InputObject:
+		d.Types[name] = t
+
+		for _, field := range definition.Fields {
+			t.Fields = append(t.Fields, &fieldDescription{Name: field.Name, Type: field.Type.String()})
+			d.addType(schema, field.Type.Name())
+		}
+	case ast.Enum:
+		for _, value := range definition.EnumValues {
+			t.Values = append(t.Values, value.Name)
+		}
+
+		sort.Strings(t.Values)
+
+		d.Types[name] = t
+	case ast.Scalar:
+		d.Types[name] = t
+	}
+}
@@ -0,0 +1,104 @@
+package clientgenv2
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// TestWriteOperationDescriptions tests that fields selected several times are described once with their
+// selections merged, and that fields marked with @client are left out of the response shape.
+func TestWriteOperationDescriptions(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+directive @client on FIELD
+
+type Profile {
+  bio: String
+  avatar: String
+}
+
+type User {
+  id: ID!
+  profile: Profile!
+  fullName: String!
+}
+
+type Query {
+  user(id: ID!): User
+}
+`})
+
+	queryDocument := gqlparser.MustLoadQuery(schema, `
+fragment Avatar on User {
+  profile {
+    avatar
+  }
+}
+
+query GetUser($id: ID!) {
+  user(id: $id) {
+    id
+    profile {
+      bio
+    }
+    ...Avatar
+    fullName @client
+  }
+}
+`)
+
+	filename := filepath.Join(t.TempDir(), "operations.json")
+	operations := []*Operation{{Name: "GetUser", Operation: "query GetUser { user { id } }"}}
+
+	if err := WriteOperationDescriptions(filename, schema, queryDocument, operations, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var description operationsDescription
+	if err := json.Unmarshal(data, &description); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &selectionDescription{Fields: []*fieldDescription{
+		{Name: "user", Type: "User", Selection: &selectionDescription{Fields: []*fieldDescription{
+			{Name: "id", Type: "ID!"},
+			{Name: "profile", Type: "Profile!", Selection: &selectionDescription{Fields: []*fieldDescription{
+				{Name: "bio", Type: "String"},
+				{Name: "avatar", Type: "String"},
+			}}},
+		}}},
+	}}
+
+	if len(description.Operations) != 1 {
+		t.Fatalf("Expected 1 operation, got %d", len(description.Operations))
+	}
+
+	if got := description.Operations[0].Response; !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected response %s, got %s", mustMarshal(t, expected), mustMarshal(t, got))
+	}
+
+	if got := description.Operations[0].Hash; got != documentHash(operations[0].Operation) {
+		t.Errorf("Expected the hash of the document sent, got %s", got)
+	}
+}
+
+func mustMarshal(t *testing.T, v any) string {
+	t.Helper()
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(data)
+}
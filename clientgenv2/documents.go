@@ -0,0 +1,44 @@
+package clientgenv2
+
+import (
+	"crypto/sha256"
+	_ "embed" // used to load template file
+	"encoding/hex"
+	"fmt"
+
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/codegen/templates"
+)
+
+//go:embed documents.gotpl
+var documentsTemplate string
+
+// RenderDocuments generates the client file of the documents only mode: the document, name and hash of each
+// operation and a struct of its variables, for responses decoded by other means than the generated client.
+func RenderDocuments(cfg *config.Config, operations []*Operation, client config.PackageConfig) error {
+	err := templates.Render(templates.Options{
+		PackageName: client.Package,
+		Filename:    client.Filename,
+		Template:    documentsTemplate,
+		Data: map[string]any{
+			"Operation": operations,
+		},
+		Packages:   cfg.Packages,
+		PackageDoc: "// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.\n",
+		Funcs: map[string]any{
+			"documentHash": documentHash,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("%s generating failed: %w", client.Filename, err)
+	}
+
+	return nil
+}
+
+// documentHash returns the hex encoded SHA-256 of document, the hash of persisted queries and operation allowlists.
+func documentHash(document string) string {
+	sum := sha256.Sum256([]byte(document))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,230 @@
+package clientgenv2
+
+import (
+	"bytes"
+	"fmt"
+	"go/types"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/codegen/templates"
+)
+
+// domainConverter generates the conversions between the generated structs and the domain types they are mapped to.
+type domainConverter struct {
+	clientImportPath string
+	// domainTypes are the domain types by the name of the generated struct mapped to them.
+	domainTypes map[string]types.Type
+	// vars counts the variables declared in the function being generated.
+	vars int
+}
+
+// loadDomainTypes resolves the domain types of mappings, from generated struct names to qualified Go type names.
+func loadDomainTypes(cfg *config.Config, mappings map[string]string) (map[string]types.Type, error) {
+	domainTypes := make(map[string]types.Type, len(mappings))
+
+	names := make([]string, 0, len(mappings))
+	for name := range mappings {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		qualified := mappings[name]
+
+		index := strings.LastIndex(qualified, ".")
+		if index <= 0 {
+			return nil, fmt.Errorf("domain type %q of %s must be a qualified Go type name", qualified, name)
+		}
+
+		pkg := cfg.Packages.Load(qualified[:index])
+		if pkg == nil || pkg.Types == nil {
+			return nil, fmt.Errorf("domain type %q of %s: package not found", qualified, name)
+		}
+
+		obj, ok := pkg.Types.Scope().Lookup(qualified[index+1:]).(*types.TypeName)
+		if !ok {
+			return nil, fmt.Errorf("domain type %q of %s: type not found", qualified, name)
+		}
+
+		if _, ok := obj.Type().Underlying().(*types.Struct); !ok {
+			return nil, fmt.Errorf("domain type %q of %s must be a struct", qualified, name)
+		}
+
+		domainTypes[name] = obj.Type()
+	}
+
+	return domainTypes, nil
+}
+
+// DomainConversionsFunc returns a template function that generates the ToDomain method and the <Name>FromDomain
+// function of a generated struct mapped to a domain type. The fields of the same name are converted when their
+// types are identical, or are mapped structs, pointers or slices of them; the other fields are left zero.
+func (g *GenGettersGenerator) DomainConversionsFunc(clientImportPath string, domainTypes map[string]types.Type) func(name string, p types.Type) string {
+	converter := &domainConverter{
+		clientImportPath: clientImportPath,
+		domainTypes:      domainTypes,
+	}
+
+	return func(name string, p types.Type) string {
+		domainType, ok := domainTypes[name]
+		if !ok {
+			return ""
+		}
+
+		it, ok := p.(*types.Struct)
+		if !ok {
+			return ""
+		}
+
+		domainStruct, _ := domainType.Underlying().(*types.Struct)
+		ref := templates.CurrentImports.LookupType(domainType)
+
+		var buf bytes.Buffer
+
+		converter.vars = 0
+
+		buf.WriteString("// ToDomain converts t to " + ref + ".\n")
+		buf.WriteString("func (t *" + name + ") ToDomain() " + ref + " {\n")
+		buf.WriteString("var d " + ref + "\n")
+		buf.WriteString("if t == nil {\n return d\n}\n")
+
+		for i := range it.NumFields() {
+			field := it.Field(i)
+			if domainField := structField(domainStruct, field.Name()); domainField != nil {
+				buf.WriteString(converter.convert("d."+field.Name(), "t."+field.Name(), field.Type(), domainField.Type()))
+			}
+		}
+
+		buf.WriteString("return d\n}\n\n")
+
+		converter.vars = 0
+
+		buf.WriteString("// " + name + "FromDomain converts d to " + name + ".\n")
+		buf.WriteString("func " + name + "FromDomain(d " + ref + ") " + name + " {\n")
+		buf.WriteString("var t " + name + "\n")
+
+		for i := range it.NumFields() {
+			field := it.Field(i)
+			if domainField := structField(domainStruct, field.Name()); domainField != nil {
+				buf.WriteString(converter.convert("t."+field.Name(), "d."+field.Name(), domainField.Type(), field.Type()))
+			}
+		}
+
+		buf.WriteString("return t\n}\n")
+
+		return buf.String()
+	}
+}
+
+// structField returns the exported field of it named name, or nil.
+func structField(it *types.Struct, name string) *types.Var {
+	for i := range it.NumFields() {
+		if field := it.Field(i); field.Exported() && field.Name() == name {
+			return field
+		}
+	}
+
+	return nil
+}
+
+// mapped returns the name of the generated struct t when it is mapped to a domain type.
+func (c *domainConverter) mapped(t types.Type) (string, bool) {
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil || named.Obj().Pkg().Path() != c.clientImportPath {
+		return "", false
+	}
+
+	_, ok = c.domainTypes[named.Obj().Name()]
+
+	return named.Obj().Name(), ok
+}
+
+// convert returns the statements assigning src of type from converted to type to to dst,
+// or "" when the types are not convertible.
+func (c *domainConverter) convert(dst, src string, from, to types.Type) string {
+	if types.Identical(from, to) {
+		return dst + " = " + src + "\n"
+	}
+
+	if name, ok := c.mapped(from); ok && types.Identical(c.domainTypes[name], to) {
+		return dst + " = " + src + ".ToDomain()\n"
+	}
+
+	if name, ok := c.mapped(to); ok && types.Identical(c.domainTypes[name], from) {
+		return dst + " = " + name + "FromDomain(" + src + ")\n"
+	}
+
+	fromPointer, fromIsPointer := from.(*types.Pointer)
+	toPointer, toIsPointer := to.(*types.Pointer)
+
+	switch {
+	case fromIsPointer:
+		elem := "*" + src
+		// ToDomain has a pointer receiver
+		if _, ok := c.mapped(fromPointer.Elem()); ok {
+			elem = src
+		}
+
+		target := to
+		if toIsPointer {
+			target = toPointer.Elem()
+		}
+
+		inner := c.convertTo(dst, elem, fromPointer.Elem(), target, toIsPointer)
+		if inner == "" {
+			return ""
+		}
+
+		return "if " + src + " != nil {\n" + inner + "}\n"
+	case toIsPointer:
+		return c.convertTo(dst, src, from, toPointer.Elem(), true)
+	}
+
+	fromSlice, ok := from.(*types.Slice)
+	if !ok {
+		return ""
+	}
+
+	toSlice, ok := to.(*types.Slice)
+	if !ok {
+		return ""
+	}
+
+	c.vars++
+	index, item := "i"+strconv.Itoa(c.vars), "v"+strconv.Itoa(c.vars)
+
+	inner := c.convertTo(dst+"["+index+"]", item, fromSlice.Elem(), toSlice.Elem(), false)
+	if inner == "" {
+		return ""
+	}
+
+	return "if " + src + " != nil {\n" +
+		dst + " = make(" + templates.CurrentImports.LookupType(to) + ", len(" + src + "))\n" +
+		"for " + index + ", " + item + " := range " + src + " {\n" + inner + "}\n" +
+		"}\n"
+}
+
+// convertTo returns the statements converting src to dst, taking the address of the converted value when pointer is set.
+func (c *domainConverter) convertTo(dst, src string, from, to types.Type, pointer bool) string {
+	if !pointer {
+		return c.convert(dst, src, from, to)
+	}
+
+	c.vars++
+	value := "v" + strconv.Itoa(c.vars)
+
+	inner := c.convert(value, src, from, to)
+	if inner == "" {
+		return ""
+	}
+
+	if assignment, ok := strings.CutPrefix(inner, value+" = "); ok && strings.Count(inner, "\n") == 1 {
+		return value + " := " + assignment + dst + " = &" + value + "\n"
+	}
+
+	return "var " + value + " " + templates.CurrentImports.LookupType(to) + "\n" + inner + dst + " = &" + value + "\n"
+}
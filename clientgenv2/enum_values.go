@@ -0,0 +1,111 @@
+package clientgenv2
+
+import (
+	"go/types"
+	"sort"
+
+	"github.com/99designs/gqlgen/codegen/templates"
+
+	"github.com/gqlgo/gqlgenc/parsequery"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// EnumValueSet is an enum an operation sends or receives, generated as a variable listing its values.
+type EnumValueSet struct {
+	// Name is the name of the variable, <Operation><Enum>Values.
+	Name string
+	Enum string
+	Type types.Type
+	// Values are the values of the enum in the order of the schema.
+	Values []string
+}
+
+// EnumValueSets returns the sets of values of the enums operation sends in its variables, including the fields
+// of its input objects, and receives in its response fields, sorted by enum name. Enums bound to Go types that
+// are not strings are left out, since their values cannot be written as string constants.
+func (r *SourceGenerator) EnumValueSets(operation *ast.OperationDefinition) []*EnumValueSet {
+	enums := make(map[string]bool)
+
+	for _, variable := range operation.VariableDefinitions {
+		collectInputEnums(r.cfg.Schema, variable.Type.Name(), enums, make(map[string]bool))
+	}
+
+	collectSelectionEnums(r.cfg.Schema, operation.SelectionSet, enums, make(map[string]bool))
+
+	names := make([]string, 0, len(enums))
+	for name := range enums {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	sets := make([]*EnumValueSet, 0, len(names))
+
+	for _, name := range names {
+		goType := r.Type(name)
+		if basic, ok := goType.Underlying().(*types.Basic); !ok || basic.Info()&types.IsString == 0 {
+			continue
+		}
+
+		set := &EnumValueSet{
+			Name: templates.ToGo(operation.Name) + templates.ToGo(name) + "Values",
+			Enum: name,
+			Type: goType,
+		}
+		for _, value := range r.cfg.Schema.Types[name].EnumValues {
+			set.Values = append(set.Values, value.Name)
+		}
+
+		sets = append(sets, set)
+	}
+
+	return sets
+}
+
+// collectInputEnums adds to enums the enum named typeName, or the enums of the fields of the input object named typeName.
+func collectInputEnums(schema *ast.Schema, typeName string, enums, visited map[string]bool) {
+	definition := schema.Types[typeName]
+	if definition == nil || visited[typeName] {
+		return
+	}
+
+	visited[typeName] = true
+
+	switch definition.Kind {
+	case ast.Enum:
+		enums[typeName] = true
+	case ast.InputObject:
+		for _, field := range definition.Fields {
+			collectInputEnums(schema, field.Type.Name(), enums, visited)
+		}
+	}
+}
+
+// collectSelectionEnums adds to enums the enums of the fields of selectionSet, through fragments.
+// Fields marked with @client are not received, so they are left out.
+func collectSelectionEnums(schema *ast.Schema, selectionSet ast.SelectionSet, enums, visitedFragments map[string]bool) {
+	for _, selection := range selectionSet {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if selection.Directives.ForName(parsequery.ClientDirective) != nil || selection.Definition == nil {
+				continue
+			}
+
+			if definition := schema.Types[selection.Definition.Type.Name()]; definition != nil && definition.Kind == ast.Enum {
+				enums[definition.Name] = true
+			}
+
+			collectSelectionEnums(schema, selection.SelectionSet, enums, visitedFragments)
+		case *ast.InlineFragment:
+			collectSelectionEnums(schema, selection.SelectionSet, enums, visitedFragments)
+		case *ast.FragmentSpread:
+			if selection.Definition == nil || visitedFragments[selection.Name] {
+				continue
+			}
+
+			visitedFragments[selection.Name] = true
+			collectSelectionEnums(schema, selection.Definition.SelectionSet, enums, visitedFragments)
+		}
+	}
+}
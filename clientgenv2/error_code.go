@@ -0,0 +1,36 @@
+package clientgenv2
+
+import (
+	"fmt"
+
+	"github.com/99designs/gqlgen/codegen/templates"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// ErrorCode is a value of the error code enum, generated as a sentinel error.
+type ErrorCode struct {
+	Name string
+	Code string
+}
+
+// ErrorCodes returns the sentinel errors to generate for the values of the enum named enumName.
+func ErrorCodes(schema *ast.Schema, enumName string) ([]*ErrorCode, error) {
+	if enumName == "" {
+		return nil, nil
+	}
+
+	def := schema.Types[enumName]
+	if def == nil || def.Kind != ast.Enum {
+		return nil, fmt.Errorf("error code enum %s is not an enum of the schema", enumName)
+	}
+
+	errorCodes := make([]*ErrorCode, 0, len(def.EnumValues))
+	for _, value := range def.EnumValues {
+		errorCodes = append(errorCodes, &ErrorCode{
+			Name: "Err" + templates.ToGo(value.Name),
+			Code: value.Name,
+		})
+	}
+
+	return errorCodes, nil
+}
@@ -0,0 +1,184 @@
+package clientgenv2
+
+import (
+	"bytes"
+	"fmt"
+	"go/types"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ErrorFunc returns a template function that generates an Error method for structs generated
+// for a configured error type, so they can be used as Go errors. The message is the selected
+// message field, or the GraphQL type name when it is not selected.
+func (g *GenGettersGenerator) ErrorFunc() func(name, errorType string, p types.Type) string {
+	return func(name, errorType string, p types.Type) string {
+		it, ok := p.(*types.Struct)
+		if errorType == "" || !ok {
+			return ""
+		}
+
+		var buf bytes.Buffer
+
+		buf.WriteString("func (t *" + name + ") Error() string {\n")
+		buf.WriteString("if t == nil {\n return " + strconv.Quote(errorType) + "\n}\n")
+
+		for i := range it.NumFields() {
+			if reflect.StructTag(it.Tag(i)).Get("graphql") != "message" {
+				continue
+			}
+
+			field := it.Field(i)
+
+			switch typ := field.Type().(type) {
+			case *types.Basic:
+				if typ.Kind() == types.String {
+					buf.WriteString("if t." + field.Name() + " != \"\" {\n return t." + field.Name() + "\n}\n")
+				}
+			case *types.Pointer:
+				if basic, ok := typ.Elem().(*types.Basic); ok && basic.Kind() == types.String {
+					buf.WriteString("if t." + field.Name() + " != nil && *t." + field.Name() + " != \"\" {\n return *t." + field.Name() + "\n}\n")
+				}
+			}
+		}
+
+		buf.WriteString("return " + strconv.Quote(errorType) + "\n}\n")
+
+		return buf.String()
+	}
+}
+
+// errorsWalker generates the statements collecting the error type values reachable from a struct.
+type errorsWalker struct {
+	// errorStructs are the names of the structs implementing error.
+	errorStructs map[string]bool
+	// clientStructs are the names of all structs generated in the client package.
+	clientStructs map[string]bool
+}
+
+// ErrorsFunc returns a template function that generates an Errors method collecting every
+// configured error type value selected in a response. Inline fragments on error types are
+// only collected when __typename is selected next to them and matches.
+func (g *GenGettersGenerator) ErrorsFunc(fragments []*Fragment, structSources []*StructSource, operationResponses []*OperationResponse) func(name string, p types.Type) string {
+	walker := &errorsWalker{
+		errorStructs:  make(map[string]bool),
+		clientStructs: make(map[string]bool),
+	}
+
+	for _, fragment := range fragments {
+		walker.clientStructs[fragment.Name] = true
+		walker.errorStructs[fragment.Name] = fragment.ErrorType != ""
+	}
+
+	for _, structSource := range structSources {
+		walker.clientStructs[structSource.Name] = true
+		walker.errorStructs[structSource.Name] = structSource.ErrorType != ""
+	}
+
+	for _, operationResponse := range operationResponses {
+		walker.clientStructs[operationResponse.Name] = true
+	}
+
+	return func(name string, p types.Type) string {
+		body := walker.walk("t", p, 0)
+		if body == "" {
+			return ""
+		}
+
+		var buf bytes.Buffer
+
+		buf.WriteString("func (t *" + name + ") Errors() []error {\n")
+		buf.WriteString("if t == nil {\n return nil\n}\n")
+		buf.WriteString("var errs []error\n")
+		buf.WriteString(body)
+		buf.WriteString("return errs\n}\n")
+
+		return buf.String()
+	}
+}
+
+// walk returns the statements appending the error values reachable from the addressable expression expr of type t.
+func (w *errorsWalker) walk(expr string, t types.Type, depth int) string {
+	switch typ := t.(type) {
+	case *types.Pointer:
+		if named, ok := typ.Elem().(*types.Named); ok && w.errorStructs[named.Obj().Name()] {
+			return "if " + expr + " != nil {\n errs = append(errs, " + expr + ")\n}\n"
+		}
+
+		inner := w.walk(expr, typ.Elem(), depth)
+		if inner == "" {
+			return ""
+		}
+
+		return "if " + expr + " != nil {\n" + inner + "}\n"
+	case *types.Slice:
+		index := fmt.Sprintf("i%d", depth)
+
+		inner := w.walk(expr+"["+index+"]", typ.Elem(), depth+1)
+		if inner == "" {
+			return ""
+		}
+
+		return "for " + index + " := range " + expr + " {\n" + inner + "}\n"
+	case *types.Named:
+		name := typ.Obj().Name()
+		if w.errorStructs[name] {
+			return "errs = append(errs, &" + expr + ")\n"
+		}
+
+		if !w.clientStructs[name] {
+			return ""
+		}
+
+		return w.walk(expr, typ.Underlying(), depth)
+	case *types.Struct:
+		return w.walkStruct(expr, typ, depth)
+	default:
+		return ""
+	}
+}
+
+func (w *errorsWalker) walkStruct(expr string, it *types.Struct, depth int) string {
+	var typenameField *types.Var
+
+	for i := range it.NumFields() {
+		if reflect.StructTag(it.Tag(i)).Get("graphql") == "__typename" {
+			typenameField = it.Field(i)
+		}
+	}
+
+	var buf bytes.Buffer
+
+	for i := range it.NumFields() {
+		field := it.Field(i)
+		tag := reflect.StructTag(it.Tag(i)).Get("graphql")
+
+		inner := w.walk(expr+"."+field.Name(), field.Type(), depth)
+		if inner == "" {
+			continue
+		}
+
+		if !strings.HasPrefix(tag, "... on ") {
+			buf.WriteString(inner)
+
+			continue
+		}
+
+		// inline fragments are decoded for every member, only the matching one holds the error
+		if typenameField == nil {
+			continue
+		}
+
+		typename := expr + "." + typenameField.Name()
+		condition := typename + " == " + strconv.Quote(strings.TrimPrefix(tag, "... on "))
+
+		if _, ok := typenameField.Type().(*types.Pointer); ok {
+			condition = typename + " != nil && *" + condition
+		}
+
+		buf.WriteString("if " + condition + " {\n" + inner + "}\n")
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,86 @@
+package clientgenv2
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/codegen/templates"
+
+	gqlgencConfig "github.com/gqlgo/gqlgenc/config"
+)
+
+// ErrorWrapping wraps the errors returned by generated operation methods as generate.errorWrapping configures.
+type ErrorWrapping struct {
+	Style gqlgencConfig.ErrorWrappingStyle
+	// Constructor is the function wrapping errors with the constructor style.
+	Constructor *types.Func
+}
+
+// NewErrorWrapping returns the error wrapping of cfg, resolving its constructor, or nil when cfg is nil.
+func NewErrorWrapping(cfg *config.Config, wrappingCfg *gqlgencConfig.ErrorWrappingConfig) (*ErrorWrapping, error) {
+	if wrappingCfg == nil {
+		return nil, nil
+	}
+
+	wrapping := &ErrorWrapping{Style: wrappingCfg.Style}
+	if wrappingCfg.Style != gqlgencConfig.ErrorWrappingConstructor {
+		return wrapping, nil
+	}
+
+	qualified := wrappingCfg.Constructor
+
+	index := strings.LastIndex(qualified, ".")
+	if index <= 0 {
+		return nil, fmt.Errorf("error wrapping constructor %q must be a qualified Go function name", qualified)
+	}
+
+	pkg := cfg.Packages.Load(qualified[:index])
+	if pkg == nil || pkg.Types == nil {
+		return nil, fmt.Errorf("error wrapping constructor %q: package not found", qualified)
+	}
+
+	fn, ok := pkg.Types.Scope().Lookup(qualified[index+1:]).(*types.Func)
+	if !ok {
+		return nil, fmt.Errorf("error wrapping constructor %q: function not found", qualified)
+	}
+
+	errorType := types.Universe.Lookup("error").Type()
+
+	signature, ok := fn.Type().(*types.Signature)
+	if !ok || signature.Params().Len() != 2 || signature.Results().Len() != 1 || signature.Variadic() ||
+		!types.Identical(signature.Params().At(0).Type(), types.Typ[types.String]) ||
+		!types.Identical(signature.Params().At(1).Type(), errorType) ||
+		!types.Identical(signature.Results().At(0).Type(), errorType) {
+		return nil, fmt.Errorf("error wrapping constructor %q must be a func(string, error) error", qualified)
+	}
+
+	wrapping.Constructor = fn
+
+	return wrapping, nil
+}
+
+// SentinelName returns the name of the sentinel error generated for the operation named name.
+func (w *ErrorWrapping) SentinelName(name string) string {
+	return "Err" + templates.ToGo(name)
+}
+
+// Wrap returns the Go expression wrapping the error expression err returned by the method of the operation
+// named name, err itself when errors are not wrapped.
+func (w *ErrorWrapping) Wrap(name, err string) string {
+	if w == nil {
+		return err
+	}
+
+	switch w.Style {
+	case gqlgencConfig.ErrorWrappingOperation:
+		return fmt.Sprintf("fmt.Errorf(%q, %s)", name+": %w", err)
+	case gqlgencConfig.ErrorWrappingSentinel:
+		return fmt.Sprintf("fmt.Errorf(\"%%w: %%w\", %s, %s)", w.SentinelName(name), err)
+	case gqlgencConfig.ErrorWrappingConstructor:
+		return fmt.Sprintf("%s.%s(%q, %s)", templates.CurrentImports.Lookup(w.Constructor.Pkg().Path()), w.Constructor.Name(), name, err)
+	default:
+		return err
+	}
+}
@@ -0,0 +1,43 @@
+package clientgenv2
+
+import (
+	_ "embed" // used to load template file
+	"fmt"
+	"strings"
+
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/codegen/templates"
+)
+
+//go:embed example.gotpl
+var exampleTemplate string
+
+// ExamplesSuffix replaces the .go extension of the client file in the name of the file of Example functions.
+const ExamplesSuffix = "_example_test.go"
+
+// ExamplesFilename returns the path of the file of Example functions generated next to the client file clientFilename.
+func ExamplesFilename(clientFilename string) string {
+	return strings.TrimSuffix(clientFilename, ".go") + ExamplesSuffix
+}
+
+// renderExamples generates an Example function for each method generated for operations, calling it with the
+// zero value of every argument. The examples have no output, so go test compiles them without running them.
+func renderExamples(cfg *config.Config, operations []*Operation, client config.PackageConfig) error {
+	filename := ExamplesFilename(client.Filename)
+
+	err := templates.Render(templates.Options{
+		PackageName: client.Package,
+		Filename:    filename,
+		Template:    exampleTemplate,
+		Data: map[string]any{
+			"Operation": operations,
+		},
+		Packages:   cfg.Packages,
+		PackageDoc: "// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.\n",
+	})
+	if err != nil {
+		return fmt.Errorf("%s generating failed: %w", filename, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package clientgenv2
+
+import (
+	"go/types"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// OperationFlat describes the <Operation>Flat method generated with generate.flattenResults for an operation
+// selecting a single root field, which returns the field rather than the response struct wrapping it.
+type OperationFlat struct {
+	// FieldName is the Go name of the root field in the response struct.
+	FieldName string
+	// FieldAlias is the response key of the root field.
+	FieldAlias string
+	// Type is the type of the root field.
+	Type types.Type
+}
+
+// newOperationFlat returns the flat method of operation, or nil when it selects something else than a single
+// root field.
+func newOperationFlat(operation *ast.OperationDefinition, responseFields ResponseFieldList) *OperationFlat {
+	if len(operation.SelectionSet) != 1 {
+		return nil
+	}
+
+	field, ok := operation.SelectionSet[0].(*ast.Field)
+	if !ok || field.Name == "__typename" {
+		return nil
+	}
+
+	for _, responseField := range responseFields {
+		if responseField.Name == field.Alias {
+			return &OperationFlat{
+				FieldName:  responseField.FieldName(),
+				FieldAlias: field.Alias,
+				Type:       responseField.Type,
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package clientgenv2
+
+import (
+	_ "embed" // used to load template file
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/codegen/templates"
+
+	gqlgencConfig "github.com/gqlgo/gqlgenc/config"
+)
+
+//go:embed fragments.gotpl
+var fragmentsTemplate string
+
+// FragmentFileSuffix ends the names of the files generated next to the client with the fragments of one GraphQL type.
+const FragmentFileSuffix = "_fragments_gen.go"
+
+// fragmentFile holds the fragments declared on one GraphQL type and the types generated for their selections.
+type fragmentFile struct {
+	Filename      string
+	Fragments     []*Fragment
+	StructSources []*StructSource
+}
+
+// splitFragmentFiles groups fragments by type condition into files in dir, sorted by filename and fragment name.
+// It returns the struct sources that do not belong to a fragment.
+func splitFragmentFiles(dir string, fragments []*Fragment, structSources []*StructSource) ([]*fragmentFile, []*StructSource) {
+	files := make(map[string]*fragmentFile)
+	fragmentFiles := make(map[string]*fragmentFile, len(fragments))
+
+	for _, fragment := range fragments {
+		filename := filepath.Join(dir, snakeCase(fragment.TypeCondition)+FragmentFileSuffix)
+
+		file, ok := files[filename]
+		if !ok {
+			file = &fragmentFile{Filename: filename}
+			files[filename] = file
+		}
+
+		file.Fragments = append(file.Fragments, fragment)
+		fragmentFiles[fragment.Name] = file
+	}
+
+	var rest []*StructSource
+
+	for _, structSource := range structSources {
+		if file := fragmentFileOf(fragmentFiles, structSource.Name); file != nil {
+			file.StructSources = append(file.StructSources, structSource)
+		} else {
+			rest = append(rest, structSource)
+		}
+	}
+
+	sorted := make([]*fragmentFile, 0, len(files))
+	for _, file := range files {
+		sort.SliceStable(file.Fragments, func(i, j int) bool { return file.Fragments[i].Name < file.Fragments[j].Name })
+		sorted = append(sorted, file)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Filename < sorted[j].Filename })
+
+	return sorted, rest
+}
+
+// fragmentFileOf returns the file of the fragment whose selections generated the struct named name,
+// which is prefixed by the fragment name.
+func fragmentFileOf(fragmentFiles map[string]*fragmentFile, name string) *fragmentFile {
+	for i := len(name) - 1; i > 0; i-- {
+		if name[i] != '_' {
+			continue
+		}
+
+		if file, ok := fragmentFiles[name[:i]]; ok {
+			return file
+		}
+	}
+
+	return nil
+}
+
+// renderFragmentFiles generates the fragment files in the client directory.
+func renderFragmentFiles(cfg *config.Config, fragmentFiles []*fragmentFile, generateCfg *gqlgencConfig.GenerateConfig, client config.PackageConfig, funcs map[string]any) error {
+	for _, file := range fragmentFiles {
+		err := templates.Render(templates.Options{
+			PackageName: client.Package,
+			Filename:    file.Filename,
+			Template:    fragmentsTemplate,
+			Data: map[string]any{
+				"Fragment":      file.Fragments,
+				"StructSources": file.StructSources,
+				"UnionMatchers": generateCfg.ShouldGenerateUnionMatchers(),
+			},
+			Packages:   cfg.Packages,
+			PackageDoc: "// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.\n",
+			Funcs:      funcs,
+		})
+		if err != nil {
+			return fmt.Errorf("%s generating failed: %w", file.Filename, err)
+		}
+	}
+
+	return nil
+}
+
+// snakeCase converts a GraphQL type name such as HTTPRequestLog to http_request_log.
+func snakeCase(name string) string {
+	runes := []rune(name)
+
+	var b strings.Builder
+
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			previous := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+
+			if unicode.IsLower(previous) || unicode.IsDigit(previous) || (unicode.IsUpper(previous) && nextIsLower) {
+				b.WriteRune('_')
+			}
+		}
+
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String()
+}
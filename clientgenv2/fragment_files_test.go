@@ -0,0 +1,55 @@
+package clientgenv2
+
+import "testing"
+
+// TestSnakeCase tests the snakeCase function naming the fragment files.
+func TestSnakeCase(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "User", expected: "user"},
+		{input: "UserProfile", expected: "user_profile"},
+		{input: "HTTPRequestLog", expected: "http_request_log"},
+		{input: "Base64Image", expected: "base64_image"},
+		{input: "userError", expected: "user_error"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			output := snakeCase(test.input)
+			if output != test.expected {
+				t.Errorf("Expected %s, but got %s", test.expected, output)
+			}
+		})
+	}
+}
+
+// TestFragmentFileOf tests that struct sources are attributed to the fragment prefixing their name.
+func TestFragmentFileOf(t *testing.T) {
+	user := &fragmentFile{Filename: "user_fragments_gen.go"}
+	userDetails := &fragmentFile{Filename: "user_details_fragments_gen.go"}
+	fragmentFiles := map[string]*fragmentFile{
+		"User":        user,
+		"User_Detail": userDetails,
+	}
+
+	tests := []struct {
+		name     string
+		expected *fragmentFile
+	}{
+		{name: "User_Profile", expected: user},
+		{name: "User_Detail_Profile", expected: userDetails},
+		{name: "GetUser_User", expected: nil},
+		{name: "User", expected: nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output := fragmentFileOf(fragmentFiles, test.name)
+			if output != test.expected {
+				t.Errorf("Expected %v, but got %v", test.expected, output)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package clientgenv2
+
+import (
+	"go/types"
+
+	"github.com/99designs/gqlgen/codegen/templates"
+
+	"github.com/gqlgo/gqlgenc/parsequery"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// OperationIterator describes the iterator method generated for a query marked with @iterator.
+type OperationIterator struct {
+	// FieldName is the Go name of the list root field in the response struct.
+	FieldName string
+	// ItemType is the type of the list items.
+	ItemType types.Type
+	// PageSizeType is the integer type of the limit and offset variables.
+	PageSizeType types.Type
+	// Params are the arguments of the operation except limit and offset.
+	Params []*Argument
+	// CallArgs are the expressions passed to the operation method for its arguments, in order.
+	CallArgs []string
+}
+
+// newOperationIterator returns the iterator of an operation marked with @iterator, or nil when it is not marked.
+func newOperationIterator(operation *ast.OperationDefinition, args []*Argument, responseFields ResponseFieldList) (*OperationIterator, error) {
+	directive := operation.Directives.ForName(parsequery.IteratorDirective)
+	if directive == nil {
+		return nil, nil
+	}
+
+	limitName, offsetName := "limit", "offset"
+	if arg := directive.Arguments.ForName("limit"); arg != nil {
+		limitName = arg.Value.Raw
+	}
+
+	if arg := directive.Arguments.ForName("offset"); arg != nil {
+		offsetName = arg.Value.Raw
+	}
+
+	rootField, itemType, err := listRootField(operation, directive, responseFields)
+	if err != nil {
+		return nil, err
+	}
+
+	iterator := &OperationIterator{
+		FieldName: rootField.FieldName(),
+		ItemType:  itemType,
+	}
+
+	for _, arg := range args {
+		switch arg.Variable {
+		case limitName, offsetName:
+			pageSizeType, pointer := arg.Type, false
+			if p, ok := pageSizeType.(*types.Pointer); ok {
+				pageSizeType, pointer = p.Elem(), true
+			}
+
+			if basic, ok := pageSizeType.Underlying().(*types.Basic); !ok || basic.Info()&types.IsInteger == 0 {
+				return nil, gqlerror.ErrorPosf(directive.Position, "@%s needs integer variables, $%s of %s is not an integer", parsequery.IteratorDirective, arg.Variable, operation.Name)
+			}
+
+			if iterator.PageSizeType != nil && !types.Identical(iterator.PageSizeType, pageSizeType) {
+				return nil, gqlerror.ErrorPosf(directive.Position, "@%s needs $%s and $%s of the same type in %s", parsequery.IteratorDirective, limitName, offsetName, operation.Name)
+			}
+
+			iterator.PageSizeType = pageSizeType
+
+			callArg := "limit"
+			if arg.Variable == offsetName {
+				callArg = "offset"
+			}
+
+			if pointer {
+				callArg = "&" + callArg
+			}
+
+			iterator.CallArgs = append(iterator.CallArgs, callArg)
+		default:
+			iterator.Params = append(iterator.Params, arg)
+			iterator.CallArgs = append(iterator.CallArgs, templates.ToGoPrivate(arg.Variable))
+		}
+	}
+
+	if len(iterator.Params)+2 != len(args) {
+		return nil, gqlerror.ErrorPosf(directive.Position, "@%s needs the variables $%s and $%s in %s", parsequery.IteratorDirective, limitName, offsetName, operation.Name)
+	}
+
+	return iterator, nil
+}
+
+// OperationStream describes the stream method generated for a query marked with @streamList.
+type OperationStream struct {
+	// FieldAlias is the JSON key of the list root field in the response data.
+	FieldAlias string
+	// ItemType is the type of the list items.
+	ItemType types.Type
+}
+
+// newOperationStream returns the stream of an operation marked with @streamList, or nil when it is not marked.
+func newOperationStream(operation *ast.OperationDefinition, responseFields ResponseFieldList) (*OperationStream, error) {
+	directive := operation.Directives.ForName(parsequery.StreamDirective)
+	if directive == nil {
+		return nil, nil
+	}
+
+	rootField, itemType, err := listRootField(operation, directive, responseFields)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OperationStream{
+		FieldAlias: rootField.Name,
+		ItemType:   itemType,
+	}, nil
+}
+
+// listRootField returns the single root field of an operation marked with directive and the type of its items.
+func listRootField(operation *ast.OperationDefinition, directive *ast.Directive, responseFields ResponseFieldList) (*ResponseField, types.Type, error) {
+	var rootFields []*ast.Field
+
+	for _, selection := range operation.SelectionSet {
+		if field, ok := selection.(*ast.Field); ok && field.Name != "__typename" {
+			rootFields = append(rootFields, field)
+		}
+	}
+
+	if len(rootFields) != 1 || len(rootFields) != len(operation.SelectionSet) {
+		return nil, nil, gqlerror.ErrorPosf(directive.Position, "@%s needs exactly one root field in %s", directive.Name, operation.Name)
+	}
+
+	for _, field := range responseFields {
+		if field.Name != rootFields[0].Alias {
+			continue
+		}
+
+		slice, ok := field.Type.(*types.Slice)
+		if !ok {
+			return nil, nil, gqlerror.ErrorPosf(rootFields[0].Position, "@%s needs a list root field, %s is not a list", directive.Name, rootFields[0].Alias)
+		}
+
+		return field, slice.Elem(), nil
+	}
+
+	return nil, nil, gqlerror.ErrorPosf(rootFields[0].Position, "@%s: root field %s not found in %s", directive.Name, rootFields[0].Alias, operation.Name)
+}
@@ -0,0 +1,29 @@
+package clientgenv2
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// WriteOperationManifest writes the persisted operation manifest of operations to filename: a JSON object mapping
+// the hex encoded SHA-256 hash of each document to the exact document the client sends, which servers enforcing
+// persisted queries and clientv2.ParseOperationManifest load as an allowlist.
+func WriteOperationManifest(filename string, operations []*Operation) error {
+	manifest := make(map[string]string, len(operations))
+	for _, operation := range operations {
+		manifest[documentHash(operation.Operation)] = operation.Operation
+	}
+
+	// map keys are sorted, so the manifest only changes with the documents
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode operation manifest: %w", err)
+	}
+
+	if err := os.WriteFile(filename, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("write operation manifest: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package clientgenv2
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+// TestWriteOperationManifest tests that the manifest is loaded as an allowlist of the documents sent by the client.
+func TestWriteOperationManifest(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "operations.json")
+	operations := []*Operation{
+		{Name: "GetUser", Operation: "query GetUser {\n\tuser {\n\t\tid\n\t}\n}\n"},
+		{Name: "UpdateUser", Operation: "mutation UpdateUser {\n\tupdateUser {\n\t\tid\n\t}\n}\n"},
+	}
+
+	if err := WriteOperationManifest(filename, operations); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	allowlist, err := clientv2.ParseOperationManifest(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, operation := range operations {
+		if !allowlist.Allows(operation.Operation) {
+			t.Errorf("Expected %s to be allowed", operation.Name)
+		}
+	}
+
+	if allowlist.Allows("query Other { other }") {
+		t.Error("Expected other documents to be rejected")
+	}
+}
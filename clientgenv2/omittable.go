@@ -0,0 +1,141 @@
+package clientgenv2
+
+import (
+	_ "embed" // used to load template file
+	"fmt"
+	"go/types"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/codegen/templates"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+//go:embed omittable.gotpl
+var omittableTemplate string
+
+// OmittableHelpersFilename is the file generated next to the models with the helpers of their Omittable fields.
+const OmittableHelpersFilename = "omittable_gen.go"
+
+// OmittableInput is an input model with graphql.Omittable fields.
+type OmittableInput struct {
+	Name   string
+	Fields []*OmittableField
+}
+
+// OmittableField is a graphql.Omittable field of an input model.
+type OmittableField struct {
+	Name string
+	// ValueType is the type returned by <Field>OrDefault, the dereferenced type of a pointer value.
+	ValueType types.Type
+	// Pointer is set when the value of the field is a pointer.
+	Pointer bool
+	// Nilable is set when the value of the field can be null.
+	Nilable bool
+}
+
+// OmittableInputs returns the input models generated in the model package having graphql.Omittable fields, sorted by name.
+func (r *SourceGenerator) OmittableInputs() []*OmittableInput {
+	if !r.cfg.Model.IsDefined() {
+		return nil
+	}
+
+	modelPrefix := r.cfg.Model.ImportPath() + "."
+
+	var inputs []*OmittableInput
+
+	for _, def := range r.cfg.Schema.Types {
+		if def.Kind != ast.InputObject || len(r.cfg.Models[def.Name].Model) == 0 {
+			continue
+		}
+
+		model := r.cfg.Models[def.Name].Model[0]
+		if !strings.HasPrefix(model, modelPrefix) {
+			continue
+		}
+
+		goType, err := r.binder.FindTypeFromName(model)
+		if err != nil {
+			continue
+		}
+
+		it, ok := goType.Underlying().(*types.Struct)
+		if !ok {
+			continue
+		}
+
+		var fields []*OmittableField
+
+		for i := range it.NumFields() {
+			if field := omittableField(it.Field(i)); field != nil {
+				fields = append(fields, field)
+			}
+		}
+
+		if len(fields) > 0 {
+			inputs = append(inputs, &OmittableInput{
+				Name:   strings.TrimPrefix(model, modelPrefix),
+				Fields: fields,
+			})
+		}
+	}
+
+	sort.Slice(inputs, func(i, j int) bool { return inputs[i].Name < inputs[j].Name })
+
+	return inputs
+}
+
+// omittableField returns the helpers to generate for field, or nil when it is not a graphql.Omittable.
+func omittableField(field *types.Var) *OmittableField {
+	named, ok := field.Type().(*types.Named)
+	if !ok || !isOmittable(named) {
+		return nil
+	}
+
+	valueType := named.TypeArgs().At(0)
+	omittable := &OmittableField{
+		Name:      field.Name(),
+		ValueType: valueType,
+	}
+
+	switch typ := valueType.Underlying().(type) {
+	case *types.Pointer:
+		omittable.ValueType = typ.Elem()
+		omittable.Pointer = true
+		omittable.Nilable = true
+	case *types.Slice, *types.Map, *types.Interface:
+		omittable.Nilable = true
+	}
+
+	return omittable
+}
+
+// isOmittable reports whether named is an instance of graphql.Omittable.
+func isOmittable(named *types.Named) bool {
+	return named.Obj().Name() == "Omittable" && named.Obj().Pkg() != nil &&
+		named.Obj().Pkg().Path() == "github.com/99designs/gqlgen/graphql" && named.TypeArgs().Len() == 1
+}
+
+// RenderOmittableHelpers generates the helpers of the Omittable fields of inputs next to the models.
+func RenderOmittableHelpers(cfg *config.Config, inputs []*OmittableInput) error {
+	filename := filepath.Join(cfg.Model.Dir(), OmittableHelpersFilename)
+
+	err := templates.Render(templates.Options{
+		PackageName: cfg.Model.Package,
+		Filename:    filename,
+		Template:    omittableTemplate,
+		Data: map[string]any{
+			"Inputs": inputs,
+		},
+		Packages:   cfg.Packages,
+		PackageDoc: "// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.\n",
+	})
+	if err != nil {
+		return fmt.Errorf("%s generating failed: %w", filename, err)
+	}
+
+	return nil
+}
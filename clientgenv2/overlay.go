@@ -0,0 +1,65 @@
+package clientgenv2
+
+import (
+	"sort"
+
+	"github.com/gqlgo/gqlgenc/parsequery"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// markOverlayFields sets the OverlayFields of operations to the fields of overlayFields, given as <Type>.<field>,
+// they select through fragments.
+func markOverlayFields(operations []*Operation, queryDocument *ast.QueryDocument, overlayFields map[string]bool) {
+	for _, operation := range operations {
+		definition := queryDocument.Operations.ForName(operation.Name)
+		if definition == nil {
+			continue
+		}
+
+		selected := make(map[string]bool)
+		collectOverlayFields(definition.SelectionSet, overlayFields, selected, make(map[string]bool))
+
+		operation.OverlayFields = make([]string, 0, len(selected))
+		for field := range selected {
+			operation.OverlayFields = append(operation.OverlayFields, field)
+		}
+
+		sort.Strings(operation.OverlayFields)
+	}
+}
+
+func collectOverlayFields(selectionSet ast.SelectionSet, overlayFields, selected, visitedFragments map[string]bool) {
+	for _, selection := range selectionSet {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if selection.Directives.ForName(parsequery.ClientDirective) != nil {
+				continue
+			}
+
+			if name := overlayFieldName(selection); overlayFields[name] {
+				selected[name] = true
+			}
+
+			collectOverlayFields(selection.SelectionSet, overlayFields, selected, visitedFragments)
+		case *ast.InlineFragment:
+			collectOverlayFields(selection.SelectionSet, overlayFields, selected, visitedFragments)
+		case *ast.FragmentSpread:
+			if selection.Definition == nil || visitedFragments[selection.Name] {
+				continue
+			}
+
+			visitedFragments[selection.Name] = true
+			collectOverlayFields(selection.Definition.SelectionSet, overlayFields, selected, visitedFragments)
+		}
+	}
+}
+
+// overlayFieldName returns field as <Type>.<field>, the keys of the overlay fields.
+func overlayFieldName(field *ast.Field) string {
+	if field.ObjectDefinition == nil {
+		return ""
+	}
+
+	return field.ObjectDefinition.Name + "." + field.Name
+}
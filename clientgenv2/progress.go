@@ -0,0 +1,39 @@
+package clientgenv2
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+// Progress reports the phases of a generation and the time each operation took, so long generations do not look
+// hung and pathological operations can be found. A nil Progress reports nothing.
+type Progress struct {
+	w     io.Writer
+	start time.Time
+	// now is time.Now, replaced by tests.
+	now func() time.Time
+}
+
+// NewProgress returns a Progress writing to w, or nil when w is nil.
+func NewProgress(w io.Writer) *Progress {
+	if w == nil {
+		return nil
+	}
+
+	return &Progress{w: w, start: time.Now(), now: time.Now}
+}
+
+// Phase reports the start of a phase of the generation, formatted like fmt.Sprintf.
+func (p *Progress) Phase(format string, args ...any) {
+	if p == nil {
+		return
+	}
+
+	fmt.Fprintf(p.w, "[%s] %s\n", p.now().Sub(p.start).Round(time.Millisecond), fmt.Sprintf(format, args...))
+}
+
+// Operation reports that the operation name, the i-th of n, was generated in elapsed.
+func (p *Progress) Operation(i, n int, name string, elapsed time.Duration) {
+	p.Phase("operation %d/%d %s (%s)", i, n, name, elapsed.Round(time.Microsecond))
+}
@@ -0,0 +1,40 @@
+package clientgenv2
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reports the time elapsed since the start", func(t *testing.T) {
+		t.Parallel()
+
+		var b bytes.Buffer
+
+		progress := NewProgress(&b)
+		now := progress.start
+		progress.now = func() time.Time { return now }
+
+		progress.Phase("loading the schema")
+
+		now = now.Add(1500 * time.Millisecond)
+		progress.Operation(2, 3, "GetUser", 1234567*time.Nanosecond)
+
+		require.Equal(t, "[0s] loading the schema\n[1.5s] operation 2/3 GetUser (1.235ms)\n", b.String())
+	})
+
+	t.Run("nil reports nothing", func(t *testing.T) {
+		t.Parallel()
+
+		progress := NewProgress(nil)
+		require.Nil(t, progress)
+
+		progress.Phase("loading the schema")
+		progress.Operation(1, 1, "GetUser", time.Millisecond)
+	})
+}
@@ -0,0 +1,248 @@
+package clientgenv2
+
+import (
+	_ "embed" // used to load template file
+	"fmt"
+	"go/types"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/codegen/templates"
+
+	gqlgencConfig "github.com/gqlgo/gqlgenc/config"
+	"github.com/gqlgo/gqlgenc/parsequery"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+//go:embed redact.gotpl
+var redactTemplate string
+
+// RedactionFilename is the file generated next to the models with the String methods of the input models
+// having sensitive fields.
+const RedactionFilename = "redact_gen.go"
+
+// Redacted replaces the values of sensitive fields in the output of generated String methods.
+const Redacted = "[REDACTED]"
+
+// RedactedStruct is a struct whose generated String method redacts its sensitive fields.
+type RedactedStruct struct {
+	Name   string
+	Fields []*RedactedField
+}
+
+// RedactedField is a field of a RedactedStruct.
+type RedactedField struct {
+	Name      string
+	Sensitive bool
+}
+
+// FormatString returns the format of the String method of s, printing its fields like %+v, with a verb for each
+// field that is not sensitive.
+func (s *RedactedStruct) FormatString() string {
+	fields := make([]string, 0, len(s.Fields))
+
+	for _, field := range s.Fields {
+		if field.Sensitive {
+			fields = append(fields, field.Name+":"+Redacted)
+		} else {
+			fields = append(fields, field.Name+":%v")
+		}
+	}
+
+	return "{" + strings.Join(fields, " ") + "}"
+}
+
+// SensitiveInputs returns the input models generated in the model package having fields marked @sensitive in
+// the schema or listed in generate.sensitiveFields, sorted by name.
+func (r *SourceGenerator) SensitiveInputs() []*RedactedStruct {
+	if !r.cfg.Model.IsDefined() {
+		return nil
+	}
+
+	modelPrefix := r.cfg.Model.ImportPath() + "."
+
+	var inputs []*RedactedStruct
+
+	for _, def := range r.cfg.Schema.Types {
+		if def.Kind != ast.InputObject || len(r.cfg.Models[def.Name].Model) == 0 {
+			continue
+		}
+
+		model := r.cfg.Models[def.Name].Model[0]
+		if !strings.HasPrefix(model, modelPrefix) {
+			continue
+		}
+
+		goType, err := r.binder.FindTypeFromName(model)
+		if err != nil {
+			continue
+		}
+
+		it, ok := goType.Underlying().(*types.Struct)
+		if !ok {
+			continue
+		}
+
+		input := &RedactedStruct{Name: strings.TrimPrefix(model, modelPrefix)}
+		sensitive := false
+
+		for i := range it.NumFields() {
+			jsonName, _, _ := strings.Cut(reflect.StructTag(it.Tag(i)).Get("json"), ",")
+
+			field := &RedactedField{Name: it.Field(i).Name()}
+			if fieldDef := def.Fields.ForName(jsonName); fieldDef != nil {
+				field.Sensitive = isSensitiveField(def.Name, fieldDef, r.generateConfig)
+			}
+
+			sensitive = sensitive || field.Sensitive
+			input.Fields = append(input.Fields, field)
+		}
+
+		if sensitive {
+			inputs = append(inputs, input)
+		}
+	}
+
+	sort.Slice(inputs, func(i, j int) bool { return inputs[i].Name < inputs[j].Name })
+
+	return inputs
+}
+
+// newRedactedVariables returns the variables struct of the operation named name when some of args are sensitive.
+func newRedactedVariables(name string, args []*Argument) *RedactedStruct {
+	variables := &RedactedStruct{Name: templates.ToGo(name) + "Variables"}
+	sensitive := false
+
+	for _, arg := range args {
+		variables.Fields = append(variables.Fields, &RedactedField{Name: templates.ToGo(arg.Variable), Sensitive: arg.Sensitive})
+		sensitive = sensitive || arg.Sensitive
+	}
+
+	if !sensitive {
+		return nil
+	}
+
+	return variables
+}
+
+// RenderRedaction generates the String methods of inputs next to the models.
+func RenderRedaction(cfg *config.Config, inputs []*RedactedStruct) error {
+	filename := filepath.Join(cfg.Model.Dir(), RedactionFilename)
+
+	err := templates.Render(templates.Options{
+		PackageName: cfg.Model.Package,
+		Filename:    filename,
+		Template:    redactTemplate,
+		Data: map[string]any{
+			"Inputs": inputs,
+		},
+		Packages:   cfg.Packages,
+		PackageDoc: "// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.\n",
+	})
+	if err != nil {
+		return fmt.Errorf("%s generating failed: %w", filename, err)
+	}
+
+	return nil
+}
+
+// SensitiveFields are the paths of the sensitive values of an operation, which its generated methods hide from
+// the transcripts of clientv2 with clientv2.WithSensitiveFields.
+type SensitiveFields struct {
+	Variables []string
+	Response  []string
+}
+
+// newSensitiveFields returns the paths of the variables of operation marked @sensitive and of the sensitive
+// fields of their input types, and of the fields it selects marked @sensitive in the query or the schema, or nil
+// when there are none. The fields listed in generate.sensitiveFields are sensitive too.
+func newSensitiveFields(schema *ast.Schema, operation *ast.OperationDefinition, generateConfig *gqlgencConfig.GenerateConfig) *SensitiveFields {
+	fields := &SensitiveFields{}
+
+	for _, variable := range operation.VariableDefinitions {
+		if variable.Directives.ForName(parsequery.SensitiveDirective) != nil {
+			fields.Variables = append(fields.Variables, variable.Variable)
+
+			continue
+		}
+
+		fields.Variables = append(fields.Variables, sensitiveInputPaths(schema, variable.Type.Name(), variable.Variable, generateConfig, map[string]bool{})...)
+	}
+
+	fields.Response = sensitiveSelectionPaths(operation.SelectionSet, "", generateConfig)
+
+	if len(fields.Variables) == 0 && len(fields.Response) == 0 {
+		return nil
+	}
+
+	fields.Response = slices.Compact(slices.Sorted(slices.Values(fields.Response)))
+
+	return fields
+}
+
+// sensitiveInputPaths returns the paths below prefix of the sensitive fields of the input type named typeName.
+// visiting holds the input types of the path, so recursive inputs end.
+func sensitiveInputPaths(schema *ast.Schema, typeName, prefix string, generateConfig *gqlgencConfig.GenerateConfig, visiting map[string]bool) []string {
+	def := schema.Types[typeName]
+	if def == nil || def.Kind != ast.InputObject || visiting[typeName] {
+		return nil
+	}
+
+	visiting[typeName] = true
+	defer delete(visiting, typeName)
+
+	var paths []string
+
+	for _, field := range def.Fields {
+		path := prefix + "." + field.Name
+		if isSensitiveField(def.Name, field, generateConfig) {
+			paths = append(paths, path)
+		} else {
+			paths = append(paths, sensitiveInputPaths(schema, field.Type.Name(), path, generateConfig, visiting)...)
+		}
+	}
+
+	return paths
+}
+
+// sensitiveSelectionPaths returns the paths below prefix of the sensitive fields selectionSet selects, by
+// response key. Fragments select fields of the object they are in, so they add no key to paths.
+func sensitiveSelectionPaths(selectionSet ast.SelectionSet, prefix string, generateConfig *gqlgencConfig.GenerateConfig) []string {
+	var paths []string
+
+	for _, selection := range selectionSet {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			path := selection.Alias
+			if prefix != "" {
+				path = prefix + "." + path
+			}
+
+			if selection.Directives.ForName(parsequery.SensitiveDirective) != nil ||
+				selection.Definition != nil && selection.ObjectDefinition != nil && isSensitiveField(selection.ObjectDefinition.Name, selection.Definition, generateConfig) {
+				paths = append(paths, path)
+			} else {
+				paths = append(paths, sensitiveSelectionPaths(selection.SelectionSet, path, generateConfig)...)
+			}
+		case *ast.InlineFragment:
+			paths = append(paths, sensitiveSelectionPaths(selection.SelectionSet, prefix, generateConfig)...)
+		case *ast.FragmentSpread:
+			if selection.Definition != nil {
+				paths = append(paths, sensitiveSelectionPaths(selection.Definition.SelectionSet, prefix, generateConfig)...)
+			}
+		}
+	}
+
+	return paths
+}
+
+// isSensitiveField reports whether the field of the type named typeName is marked @sensitive in the schema or
+// listed in generate.sensitiveFields.
+func isSensitiveField(typeName string, field *ast.FieldDefinition, generateConfig *gqlgencConfig.GenerateConfig) bool {
+	return field.Directives.ForName(parsequery.SensitiveDirective) != nil || generateConfig.IsSensitiveField(typeName, field.Name)
+}
@@ -0,0 +1,29 @@
+package clientgenv2
+
+import (
+	"fmt"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// CustomScalar returns the name of the scalar t is, or is a list of, when it is declared by the schema rather
+// than built in, or "" otherwise. Clients generated with generate.scalarCodecs encode and decode its values
+// with the clientv2.ScalarCodec registered for the name.
+func CustomScalar(schema *ast.Schema, t *ast.Type) string {
+	definition := schema.Types[t.Name()]
+	if definition == nil || definition.Kind != ast.Scalar || definition.BuiltIn {
+		return ""
+	}
+
+	return definition.Name
+}
+
+// ScalarTag returns the struct tag naming the custom scalar of a field of type t, or "" if it is not one.
+func ScalarTag(schema *ast.Schema, t *ast.Type) string {
+	scalar := CustomScalar(schema, t)
+	if scalar == "" {
+		return ""
+	}
+
+	return fmt.Sprintf(`scalar:"%s"`, scalar)
+}
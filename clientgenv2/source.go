@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"fmt"
 	"go/types"
+	"strings"
+	"time"
 
 	"github.com/99designs/gqlgen/codegen/templates"
 
 	"github.com/gqlgo/gqlgenc/config"
+	"github.com/gqlgo/gqlgenc/parsequery"
 
 	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/formatter"
@@ -18,6 +21,10 @@ type Source struct {
 	queryDocument   *ast.QueryDocument
 	sourceGenerator *SourceGenerator
 	generateConfig  *config.GenerateConfig
+	// responseFields holds the response fields of each operation, set by OperationResponses.
+	responseFields map[string]ResponseFieldList
+	// progress reports the time the response types of each operation took to generate.
+	progress *Progress
 }
 
 func NewSource(schema *ast.Schema, queryDocument *ast.QueryDocument, sourceGenerator *SourceGenerator, generateConfig *config.GenerateConfig) *Source {
@@ -26,6 +33,7 @@ func NewSource(schema *ast.Schema, queryDocument *ast.QueryDocument, sourceGener
 		queryDocument:   queryDocument,
 		sourceGenerator: sourceGenerator,
 		generateConfig:  generateConfig,
+		responseFields:  make(map[string]ResponseFieldList),
 	}
 }
 
@@ -40,23 +48,33 @@ type SpreadFragmentInfo struct {
 
 // Fragment represents a named GraphQL fragment definition and its generated Go type.
 type Fragment struct {
-	// Name is the fragment's name as declared in GraphQL.
+	// Name is the Go type name of the fragment, with the configured prefix and suffix.
 	Name string
+	// TypeCondition is the GraphQL type the fragment is declared on.
+	TypeCondition string
 	// Type is the Go struct type generated for this fragment.
 	Type types.Type
 	// SpreadFragments lists fragment spreads that were flattened into this fragment.
 	// Conversion getters are generated for each entry.
 	SpreadFragments []*SpreadFragmentInfo
+	// ErrorType is the type condition of the fragment when it is one of the configured error types.
+	ErrorType string
 }
 
 func (s *Source) Fragments() ([]*Fragment, error) {
 	fragments := make([]*Fragment, 0, len(s.queryDocument.Fragments))
 	for _, fragment := range s.queryDocument.Fragments {
-		responseFields := s.sourceGenerator.NewResponseFields(fragment.SelectionSet, fragment.Name)
+		name := getFragmentTypeName(fragment.Name, s.generateConfig)
+
+		responseFields := s.sourceGenerator.NewResponseFields(fragment.SelectionSet, name)
 		if s.sourceGenerator.cfg.Models.Exists(fragment.Name) {
 			return nil, fmt.Errorf("%s is duplicated", fragment.Name)
 		}
 
+		if unknownField := s.sourceGenerator.unknownTypeField(responseFields); unknownField != nil {
+			responseFields = append(responseFields, unknownField)
+		}
+
 		// When fragment spreads are present, apply the same merge strategy used by Operations.
 		// Flatten spread fields and deduplicate them so the graphqljson decoder can
 		// unmarshal all fields directly without traversing named pointer fields.
@@ -74,20 +92,24 @@ func (s *Source) Fragments() ([]*Fragment, error) {
 			structType = responseFields.StructType()
 		}
 
-		fragment := &Fragment{
-			Name:            fragment.Name,
-			Type:            structType,
-			SpreadFragments: spreadFragments,
+		var errorType string
+		if s.generateConfig.IsErrorType(fragment.TypeCondition) {
+			errorType = fragment.TypeCondition
 		}
 
-		fragments = append(fragments, fragment)
+		fragments = append(fragments, &Fragment{
+			Name:            name,
+			TypeCondition:   fragment.TypeCondition,
+			Type:            structType,
+			SpreadFragments: spreadFragments,
+			ErrorType:       errorType,
+		})
 	}
 
-	for _, fragment := range fragments {
-		name := fragment.Name
+	for _, fragment := range s.queryDocument.Fragments {
 		s.sourceGenerator.cfg.Models.Add(
-			name,
-			fmt.Sprintf("%s.%s", s.sourceGenerator.client.Pkg(), templates.ToGo(name)),
+			fragment.Name,
+			fmt.Sprintf("%s.%s", s.sourceGenerator.client.Pkg(), getFragmentTypeName(fragment.Name, s.generateConfig)),
 		)
 	}
 
@@ -100,18 +122,59 @@ type Operation struct {
 	Operation           string
 	Args                []*Argument
 	VariableDefinitions ast.VariableDefinitionList
+	// Iterator is set when the operation is marked with @iterator.
+	Iterator *OperationIterator
+	// Stream is set when the operation is marked with @streamList.
+	Stream *OperationStream
+	// ClientFields is set when the operation selects fields marked with @client.
+	ClientFields bool
+	// Mutation is set for mutation operations.
+	Mutation bool
+	// Comment is the lines of the comment above the operation in the query file.
+	Comment []string
+	// EnumValueSets are the enums the operation sends or receives, set when generate.operationEnumValues is.
+	EnumValueSets []*EnumValueSet
+	// OverlayFields are the fields of the overlay schema the operation selects, as <Type>.<field>.
+	OverlayFields []string
+	// Counts are the count methods of the operation, set when generate.countMethods is.
+	Counts []*OperationCount
+	// Flat is set when generate.flattenResults is and the operation selects a single root field.
+	Flat *OperationFlat
+	// RedactedVariables is set when generate.redactSensitive is and some variables are marked with @sensitive.
+	RedactedVariables *RedactedStruct
+	// Sensitive is set when generate.redactSensitive is and the operation has sensitive variables or fields.
+	Sensitive *SensitiveFields
+	// Extensions are the extensions the operation sends, such as the one of generate.complexityHints.
+	Extensions []*OperationExtension
 }
 
 func NewOperation(operation *ast.OperationDefinition, queryDocument *ast.QueryDocument, args []*Argument, generateConfig *config.GenerateConfig) *Operation {
 	return &Operation{
 		Name:                operation.Name,
 		ResponseStructName:  getResponseStructName(operation, generateConfig),
-		Operation:           queryString(queryDocument),
+		Operation:           queryString(queryDocument, generateConfig.ShouldKeepDocumentComments()),
 		Args:                args,
 		VariableDefinitions: operation.VariableDefinitions,
+		Comment:             commentLines(operation.Comment),
+		ClientFields:        parsequery.SelectsClientFields(operation.SelectionSet),
+		Mutation:            operation.Operation == ast.Mutation,
 	}
 }
 
+// commentLines returns the text of each comment in group, without the leading "# ".
+func commentLines(group *ast.CommentGroup) []string {
+	if group == nil {
+		return nil
+	}
+
+	lines := make([]string, 0, len(group.List))
+	for _, comment := range group.List {
+		lines = append(lines, strings.TrimRight(strings.TrimPrefix(comment.Text(), " "), " \t"))
+	}
+
+	return lines
+}
+
 func ValidateOperationList(os ast.OperationList) error {
 	err := IsUniqueName(os)
 	if err != nil {
@@ -148,12 +211,50 @@ func (s *Source) Operations(queryDocuments []*ast.QueryDocument) ([]*Operation,
 		queryDocument := queryDocumentsMap[operation.Name]
 
 		args := operationArgsMap[operation.Name]
-		operations = append(operations, NewOperation(
+		op := NewOperation(
 			operation,
 			queryDocument,
 			args,
 			s.generateConfig,
-		))
+		)
+
+		op.Iterator, err = newOperationIterator(operation, args, s.responseFields[operation.Name])
+		if err != nil {
+			return nil, fmt.Errorf("generating iterator failed: %w", err)
+		}
+
+		op.Stream, err = newOperationStream(operation, s.responseFields[operation.Name])
+		if err != nil {
+			return nil, fmt.Errorf("generating stream failed: %w", err)
+		}
+
+		if s.generateConfig.ShouldGenerateOperationEnumValues() {
+			op.EnumValueSets = s.sourceGenerator.EnumValueSets(operation)
+		}
+
+		if s.generateConfig.ShouldGenerateCountMethods() {
+			op.Counts = s.sourceGenerator.OperationCounts(operation, args)
+		}
+
+		if s.generateConfig.ShouldFlattenResults() {
+			op.Flat = newOperationFlat(operation, s.responseFields[operation.Name])
+		}
+
+		if s.generateConfig.ShouldRedactSensitive() {
+			op.RedactedVariables = newRedactedVariables(operation.Name, args)
+			op.Sensitive = newSensitiveFields(s.schema, operation, s.generateConfig)
+		}
+
+		complexityHint, err := newComplexityHint(operation, s.generateConfig.GetComplexityHints())
+		if err != nil {
+			return nil, fmt.Errorf("generating complexity hint failed: %w", err)
+		}
+
+		if complexityHint != nil {
+			op.Extensions = append(op.Extensions, complexityHint)
+		}
+
+		operations = append(operations, op)
 	}
 
 	return operations, nil
@@ -170,10 +271,15 @@ func queryDocumentMapByOperationName(queryDocuments []*ast.QueryDocument) map[st
 	return queryDocumentMap
 }
 
-func queryString(queryDocument *ast.QueryDocument) string {
+func queryString(queryDocument *ast.QueryDocument, keepComments bool) string {
 	var buf bytes.Buffer
 
-	astFormatter := formatter.NewFormatter(&buf)
+	var options []formatter.FormatterOption
+	if keepComments {
+		options = append(options, formatter.WithComments())
+	}
+
+	astFormatter := formatter.NewFormatter(&buf, options...)
 	astFormatter.FormatQueryDocument(queryDocument)
 
 	return buf.String()
@@ -186,8 +292,13 @@ type OperationResponse struct {
 
 func (s *Source) OperationResponses() ([]*OperationResponse, error) {
 	operationResponse := make([]*OperationResponse, 0, len(s.queryDocument.Operations))
-	for _, operation := range s.queryDocument.Operations {
+	for i, operation := range s.queryDocument.Operations {
+		started := time.Now()
+
+		s.sourceGenerator.unexportTypes = !s.generateConfig.ShouldExportQueryTypes(operation.Name)
 		responseFields := s.sourceGenerator.NewResponseFields(operation.SelectionSet, operation.Name)
+		s.sourceGenerator.unexportTypes = false
+		s.responseFields[operation.Name] = responseFields
 
 		name := getResponseStructName(operation, s.generateConfig)
 		if s.sourceGenerator.cfg.Models.Exists(name) {
@@ -198,13 +309,15 @@ func (s *Source) OperationResponses() ([]*OperationResponse, error) {
 			Name: name,
 			Type: responseFields.StructType(),
 		})
+
+		s.progress.Operation(i+1, len(s.queryDocument.Operations), operation.Name, time.Since(started))
 	}
 
 	for _, operationResponse := range operationResponse {
 		name := operationResponse.Name
 		s.sourceGenerator.cfg.Models.Add(
 			name,
-			fmt.Sprintf("%s.%s", s.sourceGenerator.client.Pkg(), templates.ToGo(name)),
+			fmt.Sprintf("%s.%s", s.sourceGenerator.client.Pkg(), name),
 		)
 	}
 
@@ -212,6 +325,10 @@ func (s *Source) OperationResponses() ([]*OperationResponse, error) {
 }
 
 func (s *Source) ResponseSubTypes() []*StructSource {
+	for _, structSource := range s.sourceGenerator.StructSources {
+		structSource.ErrorType = s.sourceGenerator.errorTypes[structSource.Name]
+	}
+
 	return s.sourceGenerator.StructSources
 }
 
@@ -236,6 +353,10 @@ func getResponseStructName(operation *ast.OperationDefinition, generateConfig *c
 			if operation.Operation == ast.Query {
 				name = fmt.Sprintf("%s%s", generateConfig.Prefix.Query, name)
 			}
+
+			if operation.Operation == ast.Subscription {
+				name = fmt.Sprintf("%s%s", generateConfig.Prefix.Subscription, name)
+			}
 		}
 
 		if generateConfig.Suffix != nil {
@@ -246,8 +367,30 @@ func getResponseStructName(operation *ast.OperationDefinition, generateConfig *c
 			if operation.Operation == ast.Query {
 				name = fmt.Sprintf("%s%s", name, generateConfig.Suffix.Query)
 			}
+
+			if operation.Operation == ast.Subscription {
+				name = fmt.Sprintf("%s%s", name, generateConfig.Suffix.Subscription)
+			}
+		}
+	}
+
+	if !generateConfig.ShouldExportQueryTypes(operation.Name) {
+		return templates.ToGoPrivate(name)
+	}
+
+	return templates.ToGo(name)
+}
+
+func getFragmentTypeName(name string, generateConfig *config.GenerateConfig) string {
+	if generateConfig != nil {
+		if generateConfig.Prefix != nil {
+			name = fmt.Sprintf("%s%s", generateConfig.Prefix.Fragment, name)
+		}
+
+		if generateConfig.Suffix != nil {
+			name = fmt.Sprintf("%s%s", name, generateConfig.Suffix.Fragment)
 		}
 	}
 
-	return name
+	return templates.ToGo(name)
 }
@@ -1,8 +1,11 @@
 package clientgenv2
 
 import (
+	"cmp"
 	"fmt"
 	"go/types"
+	"math"
+	"slices"
 	"sort"
 	"strings"
 
@@ -13,6 +16,7 @@ import (
 	"github.com/99designs/gqlgen/codegen/templates"
 
 	gqlgencConfig "github.com/gqlgo/gqlgenc/config"
+	"github.com/gqlgo/gqlgenc/parsequery"
 
 	"github.com/vektah/gqlparser/v2/ast"
 )
@@ -20,15 +24,27 @@ import (
 type Argument struct {
 	Variable string
 	Type     types.Type
+	// Validate is set when the argument is an input model with a generated Validate method.
+	Validate bool
+	// Sensitive is set when the variable is marked with @sensitive.
+	Sensitive bool
 }
 
 type ResponseField struct {
-	Name             string
+	Name string
+	// GoName overrides the Go field name derived from Name, set when another field
+	// in the same selection set maps to the same Go identifier.
+	GoName           string
 	IsFragmentSpread bool
 	IsInlineFragment bool
 	Type             types.Type
 	Tags             []string
 	ResponseFields   ResponseFieldList
+	// SchemaIndex is the position of the field in the fields of its type in the schema, -1 for __typename and
+	// math.MaxInt for fragments, which generate.fieldOrder schema puts last.
+	SchemaIndex int
+	// Order is the order of the fields of the structs holding the field, set by generate.fieldOrder.
+	Order gqlgencConfig.FieldOrder
 }
 
 func (r ResponseField) FieldTypeString() string {
@@ -38,6 +54,15 @@ func (r ResponseField) FieldTypeString() string {
 	return parts[len(parts)-1]
 }
 
+// FieldName returns the Go struct field name of the response field.
+func (r ResponseField) FieldName() string {
+	if r.GoName != "" {
+		return r.GoName
+	}
+
+	return templates.ToGo(r.Name)
+}
+
 type ResponseFieldList []*ResponseField
 
 func (rs ResponseFieldList) IsFragmentSpread() bool {
@@ -49,17 +74,55 @@ func (rs ResponseFieldList) IsFragmentSpread() bool {
 }
 
 func (rs ResponseFieldList) StructType() *types.Struct {
+	rs = rs.ordered()
+
 	vars := make([]*types.Var, 0, len(rs))
 	structTags := make([]string, 0, len(rs))
 
 	for _, field := range rs {
-		vars = append(vars, types.NewVar(0, nil, templates.ToGo(field.Name), field.Type))
+		vars = append(vars, types.NewVar(0, nil, field.FieldName(), field.Type))
 		structTags = append(structTags, strings.Join(field.Tags, " "))
 	}
 
 	return types.NewStruct(vars, structTags)
 }
 
+// structSizes are the sizes generate.fieldOrder size lays out fields for.
+var structSizes = types.SizesFor("gc", "amd64")
+
+// ordered returns a copy of rs in the order of its fields, or rs itself when they keep the order they are in.
+func (rs ResponseFieldList) ordered() ResponseFieldList {
+	var order gqlgencConfig.FieldOrder
+
+	for _, field := range rs {
+		if field.Order != "" {
+			order = field.Order
+
+			break
+		}
+	}
+
+	var less func(a, b *ResponseField) int
+
+	switch order {
+	case gqlgencConfig.FieldOrderAlphabetical:
+		less = func(a, b *ResponseField) int { return cmp.Compare(a.Name, b.Name) }
+	case gqlgencConfig.FieldOrderSchema:
+		less = func(a, b *ResponseField) int {
+			return cmp.Or(cmp.Compare(a.SchemaIndex, b.SchemaIndex), cmp.Compare(a.Name, b.Name))
+		}
+	case gqlgencConfig.FieldOrderSize:
+		// fields sorted by decreasing alignment leave no padding between them
+		less = func(a, b *ResponseField) int {
+			return cmp.Or(cmp.Compare(structSizes.Alignof(b.Type), structSizes.Alignof(a.Type)), cmp.Compare(a.Name, b.Name))
+		}
+	default:
+		return rs
+	}
+
+	return slices.SortedStableFunc(slices.Values(rs), less)
+}
+
 func (rs ResponseFieldList) IsFragment() bool {
 	if len(rs) != 1 {
 		return false
@@ -210,6 +273,8 @@ func (g *StructGenerator) GetCurrentResponseFieldList() ResponseFieldList {
 type StructSource struct {
 	Name string
 	Type types.Type
+	// ErrorType is the GraphQL type of the struct when it is one of the configured error types.
+	ErrorType string
 }
 
 type SourceGenerator struct {
@@ -218,6 +283,10 @@ type SourceGenerator struct {
 	client         config.PackageConfig
 	generateConfig *gqlgencConfig.GenerateConfig
 	StructSources  []*StructSource
+	// errorTypes maps the names of structs generated for configured error types to their GraphQL type.
+	errorTypes map[string]string
+	// unexportTypes is set while generating the types of an operation whose query types are not exported.
+	unexportTypes bool
 }
 
 func NewSourceGenerator(cfg *config.Config, client config.PackageConfig, generateConfig *gqlgencConfig.GenerateConfig) *SourceGenerator {
@@ -227,31 +296,156 @@ func NewSourceGenerator(cfg *config.Config, client config.PackageConfig, generat
 		client:         client,
 		generateConfig: generateConfig,
 		StructSources:  []*StructSource{},
+		errorTypes:     make(map[string]string),
+	}
+}
+
+// markErrorType records that the struct named name is generated for graphQLType, if it is an error type.
+func (r *SourceGenerator) markErrorType(name, graphQLType string) {
+	if r.generateConfig.IsErrorType(graphQLType) {
+		r.errorTypes[name] = graphQLType
 	}
 }
 
 func (r *SourceGenerator) NewResponseFields(selectionSet ast.SelectionSet, typeName string) ResponseFieldList {
+	selectionSet = mergeSameResponseNames(selectionSet)
+	goNames := uniqueGoFieldNames(selectionSet)
+
 	responseFields := make(ResponseFieldList, 0, len(selectionSet))
-	for _, selection := range selectionSet {
-		responseFields = append(responseFields, r.NewResponseField(selection, typeName))
+	for i, selection := range selectionSet {
+		responseFields = append(responseFields, r.newResponseField(selection, typeName, goNames[i]))
 	}
 
 	return responseFields
 }
 
+// mergeSameResponseNames returns selectionSet with the fields sharing a response name merged into the first of
+// them, selecting the sub-selections of all of them, as the field collection of the spec does. The merged field is
+// conditional only when all of them are.
+func mergeSameResponseNames(selectionSet ast.SelectionSet) ast.SelectionSet {
+	merged := make(ast.SelectionSet, 0, len(selectionSet))
+	// fields are the merged fields by response name, copied so the fields of the query document do not change
+	fields := make(map[string]*ast.Field)
+
+	for _, selection := range selectionSet {
+		field, ok := selection.(*ast.Field)
+		if !ok {
+			merged = append(merged, selection)
+
+			continue
+		}
+
+		first, exists := fields[field.Alias]
+		if !exists {
+			copied := *field
+			copied.SelectionSet = slices.Clone(field.SelectionSet)
+			fields[field.Alias] = &copied
+			merged = append(merged, &copied)
+
+			continue
+		}
+
+		first.SelectionSet = append(first.SelectionSet, field.SelectionSet...)
+
+		if !isConditionalSelection(field.Directives) {
+			first.Directives = field.Directives
+		}
+	}
+
+	return merged
+}
+
+// uniqueGoFieldNames returns, for each selection, a Go name override for fields whose
+// response name maps to the same Go identifier as an earlier field with a different
+// response name (e.g. "user_one" and "userOne"). Later fields get a numeric suffix.
+// The override is empty for every other selection.
+func uniqueGoFieldNames(selectionSet ast.SelectionSet) []string {
+	goNames := make([]string, len(selectionSet))
+	aliasByGoName := make(map[string]string)
+
+	for i, selection := range selectionSet {
+		field, ok := selection.(*ast.Field)
+		if !ok {
+			continue
+		}
+
+		goName := templates.ToGo(field.Alias)
+
+		alias, exists := aliasByGoName[goName]
+		if !exists || alias == field.Alias {
+			aliasByGoName[goName] = field.Alias
+
+			continue
+		}
+
+		for n := 2; ; n++ {
+			candidate := fmt.Sprintf("%s%d", goName, n)
+			if _, taken := aliasByGoName[candidate]; !taken {
+				aliasByGoName[candidate] = field.Alias
+				goNames[i] = candidate
+
+				break
+			}
+		}
+	}
+
+	return goNames
+}
+
 func NewLayerTypeName(base, thisField string) string {
 	return fmt.Sprintf("%s_%s", cases.Title(language.Und, cases.NoLower).String(base), thisField)
 }
 
+// layerTypeName is NewLayerTypeName, unexported while generating the types of an unexported operation.
+func (r *SourceGenerator) layerTypeName(base, thisField string) string {
+	name := NewLayerTypeName(base, thisField)
+	if r.unexportTypes {
+		return unexportedTypeName(name)
+	}
+
+	return name
+}
+
+// unexportedTypeName unexports the first layer of name, keeping the layers separated by _.
+func unexportedTypeName(name string) string {
+	root, layers, found := strings.Cut(name, "_")
+	root = templates.ToGoPrivate(root)
+
+	if !found {
+		return root
+	}
+
+	return root + "_" + layers
+}
+
 func (r *SourceGenerator) NewResponseField(selection ast.Selection, typeName string) *ResponseField {
+	return r.newResponseField(selection, typeName, "")
+}
+
+func (r *SourceGenerator) newResponseField(selection ast.Selection, typeName, goName string) *ResponseField {
 	var isOptional bool
 
 	switch selection := selection.(type) {
 	case *ast.Field:
-		typeName = NewLayerTypeName(typeName, templates.ToGo(selection.Alias))
+		layerName := goName
+		if layerName == "" {
+			layerName = templates.ToGo(selection.Alias)
+		}
+
+		typeName = r.layerTypeName(typeName, layerName)
+		r.markErrorType(typeName, selection.Definition.Type.Name())
 		fieldsResponseFields := r.NewResponseFields(selection.SelectionSet, typeName)
 
-		isOptional = !selection.Definition.Type.NonNull
+		fieldType := selection.Definition.Type
+		isOptional = !fieldType.NonNull
+
+		// a field under @skip or @include may be absent from the response even when the schema declares it non-null
+		if r.generateConfig.ConditionalFieldsOptional && isConditionalSelection(selection.Directives) && fieldType.NonNull {
+			nullableType := *fieldType
+			nullableType.NonNull = false
+			fieldType = &nullableType
+			isOptional = true
+		}
 
 		var baseType types.Type
 
@@ -265,6 +459,10 @@ func (r *SourceGenerator) NewResponseField(selection ast.Selection, typeName str
 		case fieldsResponseFields.IsStructType():
 			// 子フィールドにFragmentがある場合は、現在のフィールドとマージする
 			// if there is a fragment in child fields, merge it with the current field
+			if unknownField := r.unknownTypeField(fieldsResponseFields); unknownField != nil {
+				fieldsResponseFields = append(fieldsResponseFields, unknownField)
+			}
+
 			generator := NewStructGenerator(fieldsResponseFields)
 
 			// restruct struct sources
@@ -289,7 +487,7 @@ func (r *SourceGenerator) NewResponseField(selection ast.Selection, typeName str
 
 		// GraphQLの定義がオプショナルのはtypeのポインタ型が返り、配列の定義場合はポインタのスライスの型になって返ってきます
 		// return pointer type then optional type or slice pointer then slice type of definition in GraphQL.
-		typ := r.binder.CopyModifiersFromAst(selection.Definition.Type, baseType)
+		typ := r.responseFieldType(fieldType, baseType)
 
 		// json tag
 		jsonTag := fmt.Sprintf(`json:"%s`, selection.Alias)
@@ -312,18 +510,33 @@ func (r *SourceGenerator) NewResponseField(selection ast.Selection, typeName str
 			fmt.Sprintf(`graphql:"%s"`, selection.Alias),
 		}
 
+		// custom scalars are decoded by the codec registered for them
+		if r.generateConfig.ShouldGenerateScalarCodecs() {
+			if tag := ScalarTag(r.cfg.Schema, fieldType); tag != "" {
+				tags = append(tags, tag)
+			}
+		}
+
+		// client fields are filled by the resolver of their type and name
+		if selection.Directives.ForName(parsequery.ClientDirective) != nil {
+			tags = append(tags, fmt.Sprintf(`client:"%s.%s"`, selection.ObjectDefinition.Name, selection.Name))
+		}
+
 		return &ResponseField{
 			Name:           selection.Alias,
+			GoName:         goName,
 			Type:           typ,
 			Tags:           tags,
 			ResponseFields: fieldsResponseFields,
+			SchemaIndex:    schemaIndex(selection),
+			Order:          r.generateConfig.GetFieldOrder(),
 		}
 
 	case *ast.FragmentSpread:
 		// この構造体はテンプレート側で使われることはなく、ast.FieldでFragment判定するために使用する
-		fieldsResponseFields := r.NewResponseFields(selection.Definition.SelectionSet, NewLayerTypeName(typeName, templates.ToGo(selection.Name)))
+		fieldsResponseFields := r.NewResponseFields(selection.Definition.SelectionSet, r.layerTypeName(typeName, templates.ToGo(selection.Name)))
 		baseType := types.NewNamed(
-			types.NewTypeName(0, r.client.Pkg(), templates.ToGo(selection.Name), nil),
+			types.NewTypeName(0, r.client.Pkg(), getFragmentTypeName(selection.Name, r.generateConfig), nil),
 			fieldsResponseFields.StructType(),
 			nil,
 		)
@@ -338,18 +551,21 @@ func (r *SourceGenerator) NewResponseField(selection ast.Selection, typeName str
 			Type:             typ,
 			IsFragmentSpread: true,
 			ResponseFields:   fieldsResponseFields,
+			SchemaIndex:      math.MaxInt,
+			Order:            r.generateConfig.GetFieldOrder(),
 		}
 
 	case *ast.InlineFragment:
 		// InlineFragmentは子要素をそのままstructとしてもつので、ここで、構造体の型を作成します
 		// InlineFragment has child elements, so create a struct type here
-		name := NewLayerTypeName(typeName, templates.ToGo(selection.TypeCondition))
+		name := r.layerTypeName(typeName, templates.ToGo(selection.TypeCondition))
+		r.markErrorType(name, selection.TypeCondition)
 		fieldsResponseFields := r.NewResponseFields(selection.SelectionSet, name)
 
 		// if single fields that is also a fragment spread, reuse that fragment
 		if len(fieldsResponseFields) == 1 && fieldsResponseFields[0].IsFragmentSpread {
 			baseType := types.NewNamed(
-				types.NewTypeName(0, r.client.Pkg(), templates.ToGo(fieldsResponseFields[0].Name), nil),
+				types.NewTypeName(0, r.client.Pkg(), getFragmentTypeName(fieldsResponseFields[0].Name, r.generateConfig), nil),
 				fieldsResponseFields.StructType(),
 				nil,
 			)
@@ -364,6 +580,8 @@ func (r *SourceGenerator) NewResponseField(selection ast.Selection, typeName str
 				Type:           typ,
 				Tags:           []string{fmt.Sprintf(`graphql:"... on %s"`, selection.TypeCondition)},
 				ResponseFields: fieldsResponseFields,
+				SchemaIndex:    math.MaxInt,
+				Order:          r.generateConfig.GetFieldOrder(),
 			}
 		}
 
@@ -410,6 +628,8 @@ func (r *SourceGenerator) NewResponseField(selection ast.Selection, typeName str
 				IsInlineFragment: true,
 				Tags:             []string{fmt.Sprintf(`graphql:"... on %s"`, selection.TypeCondition)},
 				ResponseFields:   allFields.SortByName(),
+				SchemaIndex:      math.MaxInt,
+				Order:            r.generateConfig.GetFieldOrder(),
 			}
 		}
 		// フラグメントスプレッドがない場合
@@ -436,18 +656,62 @@ func (r *SourceGenerator) NewResponseField(selection ast.Selection, typeName str
 			IsInlineFragment: true,
 			Tags:             []string{fmt.Sprintf(`graphql:"... on %s"`, selection.TypeCondition)},
 			ResponseFields:   fieldsResponseFields.SortByName(),
+			SchemaIndex:      math.MaxInt,
+			Order:            r.generateConfig.GetFieldOrder(),
 		}
 	}
 
 	panic("unexpected selection type")
 }
 
+// responseFieldType returns the Go type of a response field of type t whose named type is base, with the nullable
+// lists represented as generate.nullableLists says.
+func (r *SourceGenerator) responseFieldType(t *ast.Type, base types.Type) types.Type {
+	mode := r.generateConfig.GetNullableLists()
+	if t.Elem == nil || mode == gqlgencConfig.NullableListSlice {
+		return r.binder.CopyModifiersFromAst(t, base)
+	}
+
+	elem := r.responseFieldType(t.Elem, base)
+	if _, isStruct := elem.Underlying().(*types.Struct); isStruct && t.Elem.Elem == nil && !r.cfg.OmitSliceElementPointers {
+		elem = types.NewPointer(elem)
+	}
+
+	switch {
+	case t.NonNull:
+		return types.NewSlice(elem)
+	case mode == gqlgencConfig.NullableListPointer:
+		return types.NewPointer(types.NewSlice(elem))
+	default:
+		return nullableListType(elem)
+	}
+}
+
+// nullableListType returns graphqljson.NullableList instantiated with elem.
+func nullableListType(elem types.Type) types.Type {
+	pkg := types.NewPackage("github.com/gqlgo/gqlgenc/graphqljson", "graphqljson")
+	typeName := types.NewTypeName(0, pkg, "NullableList", nil)
+	named := types.NewNamed(typeName, types.NewStruct(nil, nil), nil)
+	named.SetTypeParams([]*types.TypeParam{
+		types.NewTypeParam(types.NewTypeName(0, pkg, "T", nil), types.Universe.Lookup("any").Type()),
+	})
+	pkg.Scope().Insert(typeName)
+
+	list, err := types.Instantiate(nil, named, []types.Type{elem}, false)
+	if err != nil {
+		panic(fmt.Sprintf("%+v", err))
+	}
+
+	return list
+}
+
 func (r *SourceGenerator) OperationArguments(variableDefinitions ast.VariableDefinitionList) []*Argument {
 	argumentTypes := make([]*Argument, 0, len(variableDefinitions))
 	for _, v := range variableDefinitions {
 		argumentTypes = append(argumentTypes, &Argument{
-			Variable: v.Variable,
-			Type:     r.binder.CopyModifiersFromAst(v.Type, r.Type(v.Type.Name())),
+			Variable:  v.Variable,
+			Type:      r.binder.CopyModifiersFromAst(v.Type, r.Type(v.Type.Name())),
+			Sensitive: v.Directives.ForName(parsequery.SensitiveDirective) != nil,
 		})
 	}
 
@@ -464,6 +728,88 @@ func (r *SourceGenerator) Type(typeName string) types.Type {
 	return goType
 }
 
+// schemaIndex returns the position of field in the fields of its type in the schema, -1 when the type does not
+// declare it, like __typename.
+func schemaIndex(field *ast.Field) int {
+	if field.ObjectDefinition == nil {
+		return -1
+	}
+
+	return slices.IndexFunc(field.ObjectDefinition.Fields, func(definition *ast.FieldDefinition) bool {
+		return definition.Name == field.Name
+	})
+}
+
+// isConditionalSelection reports whether a selection carries @skip or @include.
+func isConditionalSelection(directives ast.DirectiveList) bool {
+	return directives.ForName("skip") != nil || directives.ForName("include") != nil
+}
+
+// unknownTypeField returns a field capturing union or interface members that none of
+// the inline fragments in fields cover, or nil when capturing is disabled or fields
+// does not select both __typename and an inline fragment.
+func (r *SourceGenerator) unknownTypeField(fields ResponseFieldList) *ResponseField {
+	if r.generateConfig == nil || !r.generateConfig.CaptureUnknownTypes {
+		return nil
+	}
+
+	var (
+		hasTypename    bool
+		typeConditions []string
+	)
+
+	for _, field := range fields {
+		if field.Name == "__typename" {
+			hasTypename = true
+		}
+
+		for _, tag := range field.Tags {
+			if typeCondition, ok := strings.CutPrefix(tag, `graphql:"... on `); ok {
+				typeConditions = append(typeConditions, strings.TrimSuffix(typeCondition, `"`))
+			}
+		}
+	}
+
+	if !hasTypename || len(typeConditions) == 0 {
+		return nil
+	}
+
+	known := make(map[string]bool)
+
+	for _, typeCondition := range typeConditions {
+		def := r.cfg.Schema.Types[typeCondition]
+		if def == nil || !def.IsAbstractType() {
+			known[typeCondition] = true
+
+			continue
+		}
+
+		for _, possible := range r.cfg.Schema.GetPossibleTypes(def) {
+			known[possible.Name] = true
+		}
+	}
+
+	typenames := make([]string, 0, len(known))
+	for typename := range known {
+		typenames = append(typenames, typename)
+	}
+
+	sort.Strings(typenames)
+
+	unknownType, err := r.binder.FindTypeFromName("github.com/gqlgo/gqlgenc/graphqljson.Unknown")
+	if err != nil {
+		panic(fmt.Sprintf("%+v", err))
+	}
+
+	return &ResponseField{
+		Name:        "unknown",
+		Type:        types.NewPointer(unknownType),
+		Tags:        []string{`json:"-"`, `graphql:"-"`, fmt.Sprintf(`typenames:"%s"`, strings.Join(typenames, ","))},
+		SchemaIndex: math.MaxInt,
+		Order:       r.generateConfig.GetFieldOrder(),
+	}
+}
+
 func (r *SourceGenerator) expandFragmentFields(responseFields ResponseFieldList) ResponseFieldList {
 	result := make(ResponseFieldList, 0, len(responseFields))
 	for _, field := range responseFields {
@@ -7,6 +7,8 @@ import (
 	"github.com/99designs/gqlgen/codegen/config"
 
 	gqlgencConfig "github.com/gqlgo/gqlgenc/config"
+
+	"github.com/vektah/gqlparser/v2/ast"
 )
 
 func createTestStruct(fields []*types.Var, tags []string) *types.Struct {
@@ -386,3 +388,29 @@ func TestFragmentSpreadExpansionInInlineFragment(t *testing.T) {
 		t.Errorf("Expected to find 'name' field from fragment")
 	}
 }
+
+func TestUniqueGoFieldNames(t *testing.T) {
+	t.Parallel()
+
+	selectionSet := ast.SelectionSet{
+		&ast.Field{Alias: "user_one", Name: "user"},
+		&ast.Field{Alias: "userOne", Name: "user"},
+		&ast.FragmentSpread{Name: "UserFragment"},
+		&ast.Field{Alias: "id", Name: "id"},
+		&ast.Field{Alias: "id", Name: "id"},
+		&ast.Field{Alias: "UserOne", Name: "user"},
+	}
+
+	got := uniqueGoFieldNames(selectionSet)
+	want := []string{"", "UserOne2", "", "", "", "UserOne3"}
+
+	if len(got) != len(want) {
+		t.Fatalf("Expected %v, but got %v", want, got)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Expected %v, but got %v", want, got)
+		}
+	}
+}
@@ -4,51 +4,215 @@ import (
 	"bytes"
 	_ "embed" // used to load template file
 	"fmt"
+	"go/token"
 	"go/types"
+	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/99designs/gqlgen/codegen/config"
 	"github.com/99designs/gqlgen/codegen/templates"
 
 	gqlgencConfig "github.com/gqlgo/gqlgenc/config"
+
+	"github.com/vektah/gqlparser/v2/ast"
 )
 
 //go:embed template.gotpl
 var template string
 
-func RenderTemplate(cfg *config.Config, fragments []*Fragment, operations []*Operation, operationResponses []*OperationResponse, structSources []*StructSource, generateCfg *gqlgencConfig.GenerateConfig, client config.PackageConfig) error {
+//go:embed client_interface.gotpl
+var clientInterfaceTemplate string
+
+// RenderTemplate generates the client file. schemaHash is recorded in its header and in a SchemaHash constant
+// when generate.schemaHash is set.
+func RenderTemplate(cfg *config.Config, fragments []*Fragment, operations []*Operation, batches []*OperationBatch, operationResponses []*OperationResponse, structSources []*StructSource, generateCfg *gqlgencConfig.GenerateConfig, client config.PackageConfig, schemaHash string) error {
 	genGettersGenerator := &GenGettersGenerator{
-		ClientPackageName: client.Package,
+		ClientPackageName:         client.Package,
+		SkipFragmentSpreadGetters: generateCfg.ShouldSkipFragmentSpreadGetters(),
+		SkipInlineFragmentGetters: generateCfg.ShouldSkipInlineFragmentGetters(),
+		Receiver:                  generateCfg.GetGetterReceiver(),
+		Nil:                       generateCfg.GetGetterNil(),
 	}
 
-	err := templates.Render(templates.Options{
+	genErrors := genGettersGenerator.ErrorsFunc(fragments, structSources, operationResponses)
+
+	responseErrors := make(map[string]string, len(operationResponses))
+	for _, operationResponse := range operationResponses {
+		responseErrors[operationResponse.Name] = genErrors(operationResponse.Name, operationResponse.Type)
+	}
+
+	errorCodes, err := ErrorCodes(cfg.Schema, generateCfg.GetErrorCodeEnum())
+	if err != nil {
+		return fmt.Errorf("%s generating failed: %w", client.Filename, err)
+	}
+
+	domainTypes, err := loadDomainTypes(cfg, generateCfg.GetDomainTypes())
+	if err != nil {
+		return fmt.Errorf("%s generating failed: %w", client.Filename, err)
+	}
+
+	defaultClient, err := NewDefaultClient(generateCfg.GetDefaultClient())
+	if err != nil {
+		return fmt.Errorf("%s generating failed: %w", client.Filename, err)
+	}
+
+	endpointClients, err := NewEndpointClients(generateCfg.GetEndpointClients())
+	if err != nil {
+		return fmt.Errorf("%s generating failed: %w", client.Filename, err)
+	}
+
+	errorWrapping, err := NewErrorWrapping(cfg, generateCfg.GetErrorWrapping())
+	if err != nil {
+		return fmt.Errorf("%s generating failed: %w", client.Filename, err)
+	}
+
+	// the client interface is generated on its own when it has a package, which imports the client package
+	clientInterfaceName := generateCfg.GetClientInterfaceName()
+	if generateCfg.GetClientInterfacePackage() != nil {
+		clientInterfaceName = nil
+	}
+
+	funcs := map[string]any{
+		"genGetters":           genGettersGenerator.GenFunc(),
+		"genConversionGetters": genGettersGenerator.ConversionGettersFunc(fragments),
+		"genMatch":             genGettersGenerator.MatchFunc(cfg.Schema),
+		"genError":             genGettersGenerator.ErrorFunc(),
+		"genErrors":            genErrors,
+		"genDomainConversions": genGettersGenerator.DomainConversionsFunc(client.ImportPath(), domainTypes),
+		"wrapError":            errorWrapping.Wrap,
+		"hasErrors": func(name string) bool {
+			return responseErrors[name] != ""
+		},
+		"variableValue": func(variable *ast.VariableDefinition) string {
+			value := templates.ToGoPrivate(variable.Variable)
+
+			// custom scalars are encoded by the codec registered for them
+			if scalar := CustomScalar(cfg.Schema, variable.Type); scalar != "" && generateCfg.ShouldGenerateScalarCodecs() {
+				return fmt.Sprintf("clientv2.ScalarValue{Name: %q, Value: %s}", scalar, value)
+			}
+
+			return value
+		},
+	}
+
+	clientFragments := fragments
+	if generateCfg.ShouldSplitFragmentFiles() {
+		var fragmentFiles []*fragmentFile
+
+		fragmentFiles, structSources = splitFragmentFiles(client.Dir(), fragments, structSources)
+		clientFragments = nil
+
+		err = renderFragmentFiles(cfg, fragmentFiles, generateCfg, client, funcs)
+		if err != nil {
+			return err
+		}
+	}
+
+	packageDoc := "// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.\n"
+	if !generateCfg.ShouldRecordSchemaHash() {
+		schemaHash = ""
+	} else if schemaHash != "" {
+		packageDoc += "// Schema hash: " + schemaHash + "\n"
+	}
+
+	err = templates.Render(templates.Options{
 		PackageName: client.Package,
 		Filename:    client.Filename,
 		Template:    template,
 		Data: map[string]any{
-			"Fragment":            fragments,
+			"Fragment":            clientFragments,
 			"Operation":           operations,
+			"Batches":             batches,
 			"OperationResponse":   operationResponses,
 			"GenerateClient":      generateCfg.ShouldGenerateClient(),
 			"StructSources":       structSources,
-			"ClientInterfaceName": generateCfg.GetClientInterfaceName(),
+			"ClientInterfaceName": clientInterfaceName,
+			"UnionMatchers":       generateCfg.ShouldGenerateUnionMatchers(),
+			"OperationHooks":      generateCfg.ShouldGenerateOperationHooks(),
+			"OperationCacheKeys":  generateCfg.ShouldGenerateOperationCacheKeys(),
+			"RequestBuilders":     generateCfg.ShouldGenerateRequestBuilders(),
+			"OptimisticUpdates":   generateCfg.ShouldGenerateOptimisticUpdates(),
+			"ReturnErrorTypes":    generateCfg.ShouldReturnErrorTypes(),
+			"ErrorCodeEnum":       generateCfg.GetErrorCodeEnum(),
+			"ErrorCodes":          errorCodes,
+			"SchemaHash":          schemaHash,
+			"DefaultClient":       defaultClient,
+			"EndpointClients":     endpointClients,
+			"ErrorWrapping":       errorWrapping,
 		},
 		Packages:   cfg.Packages,
-		PackageDoc: "// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.\n",
-		Funcs: map[string]any{
-			"genGetters":             genGettersGenerator.GenFunc(),
-			"genConversionGetters": genGettersGenerator.ConversionGettersFunc(fragments),
-		},
+		PackageDoc: packageDoc,
+		Funcs:      funcs,
 	})
 	if err != nil {
 		return fmt.Errorf("%s generating failed: %w", client.Filename, err)
 	}
 
+	if generateCfg.ShouldGenerateTestSkeletons() && generateCfg.ShouldGenerateClient() {
+		err = renderTestSkeletons(cfg, operations, operationResponses, client)
+		if err != nil {
+			return err
+		}
+	}
+
+	if generateCfg.ShouldGenerateExamples() && generateCfg.ShouldGenerateClient() {
+		err = renderExamples(cfg, operations, client)
+		if err != nil {
+			return err
+		}
+	}
+
+	if interfacePackage := generateCfg.GetClientInterfacePackage(); interfacePackage != nil && generateCfg.ShouldGenerateClient() {
+		err = renderClientInterface(cfg, operations, batches, generateCfg, client, interfacePackage)
+		if err != nil {
+			return fmt.Errorf("%s generating failed: %w", interfacePackage.Filename, err)
+		}
+	}
+
+	return nil
+}
+
+// renderClientInterface generates the client interface into its own package, so the client package holding
+// the query types can be internal while other packages consume the client through the interface.
+func renderClientInterface(cfg *config.Config, operations []*Operation, batches []*OperationBatch, generateCfg *gqlgencConfig.GenerateConfig, client config.PackageConfig, interfacePackage *config.PackageConfig) error {
+	for _, operation := range operations {
+		if !token.IsExported(operation.ResponseStructName) {
+			return fmt.Errorf("%s must export its query types to be in the client interface", operation.Name)
+		}
+	}
+
+	err := templates.Render(templates.Options{
+		PackageName: interfacePackage.Package,
+		Filename:    interfacePackage.Filename,
+		Template:    clientInterfaceTemplate,
+		Data: map[string]any{
+			"Operation":           operations,
+			"Batches":             batches,
+			"ClientInterfaceName": *generateCfg.GetClientInterfaceName(),
+			"ClientImportPath":    client.ImportPath(),
+			"ClientPackage":       client.Package,
+		},
+		Packages:   cfg.Packages,
+		PackageDoc: "// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.\n",
+	})
+	if err != nil {
+		return fmt.Errorf("render: %w", err)
+	}
+
 	return nil
 }
 
 type GenGettersGenerator struct {
 	ClientPackageName string
+	// SkipFragmentSpreadGetters disables the conversion getters of fragments spread into a struct.
+	SkipFragmentSpreadGetters bool
+	// SkipInlineFragmentGetters disables the getters of inline fragment fields.
+	SkipInlineFragmentGetters bool
+	// Receiver is the receiver of the getters, pointer when empty.
+	Receiver gqlgencConfig.GetterReceiverMode
+	// Nil is what getters with pointer receivers do when called on nil, allocate when empty.
+	Nil gqlgencConfig.GetterNilMode
 }
 
 func (g *GenGettersGenerator) GenFunc() func(name string, p types.Type) string {
@@ -68,23 +232,78 @@ func (g *GenGettersGenerator) GenFunc() func(name string, p types.Type) string {
 		for i := range it.NumFields() {
 			field := it.Field(i)
 
-			returns := g.returnTypeName(field.Type(), false)
+			if g.SkipInlineFragmentGetters && strings.HasPrefix(reflect.StructTag(it.Tag(i)).Get("graphql"), "... on ") {
+				continue
+			}
 
-			buf.WriteString("func (t *" + name + ") Get" + field.Name() + "() " + returns + "{\n")
-			buf.WriteString("if t == nil {\n t = &" + name + "{}\n}\n")
+			if g.Receiver == gqlgencConfig.GetterReceiverValue {
+				g.writeGetter(&buf, name, "Get"+field.Name(), g.returnTypeName(field.Type(), true), "", "t."+field.Name())
+
+				continue
+			}
 
 			pointerOrNot := ""
 			if _, ok := field.Type().(*types.Named); ok {
 				pointerOrNot = "&"
 			}
 
-			buf.WriteString("return " + pointerOrNot + "t." + field.Name() + "\n}\n")
+			g.writeGetter(&buf, name, "Get"+field.Name(), g.returnTypeName(field.Type(), false), zeroValue(field.Type()), pointerOrNot+"t."+field.Name())
 		}
 
 		return buf.String()
 	}
 }
 
+// writeGetter writes the getter of the struct owner returning value, an expression of the receiver t, as returns.
+// zero is the zero value of returns, returned by getters with pointer receivers called on nil unless they
+// allocate a zero struct in place of the receiver.
+func (g *GenGettersGenerator) writeGetter(buf *bytes.Buffer, owner, getter, returns, zero, value string) {
+	if g.Receiver == gqlgencConfig.GetterReceiverValue {
+		buf.WriteString("func (t " + owner + ") " + getter + "() " + returns + " {\n")
+		buf.WriteString("return " + value + "\n}\n")
+
+		return
+	}
+
+	switch g.Nil {
+	case gqlgencConfig.GetterNilZero:
+		buf.WriteString("func (t *" + owner + ") " + getter + "() " + returns + " {\n")
+		buf.WriteString("if t == nil {\n return " + zero + "\n}\n")
+		buf.WriteString("return " + value + "\n}\n")
+	case gqlgencConfig.GetterNilPanic:
+		buf.WriteString("func (t *" + owner + ") " + getter + "() " + returns + " {\n")
+		buf.WriteString("if t == nil {\n panic(\"" + getter + " called on a nil *" + owner + "\")\n}\n")
+		buf.WriteString("return " + value + "\n}\n")
+	case gqlgencConfig.GetterNilOk:
+		buf.WriteString("func (t *" + owner + ") " + getter + "() (" + returns + ", bool) {\n")
+		buf.WriteString("if t == nil {\n return " + zero + ", false\n}\n")
+		buf.WriteString("return " + value + ", true\n}\n")
+	default:
+		buf.WriteString("func (t *" + owner + ") " + getter + "() " + returns + " {\n")
+		buf.WriteString("if t == nil {\n t = &" + owner + "{}\n}\n")
+		buf.WriteString("return " + value + "\n}\n")
+	}
+}
+
+// zeroValue returns the zero value of the type returnTypeName returns for t when it is not nested.
+func zeroValue(t types.Type) string {
+	switch it := t.(type) {
+	case *types.Basic:
+		switch {
+		case it.Info()&types.IsString != 0:
+			return `""`
+		case it.Info()&types.IsBoolean != 0:
+			return "false"
+		default:
+			return "0"
+		}
+	case *types.Alias:
+		return zeroValue(it.Underlying())
+	default:
+		return "nil"
+	}
+}
+
 func (g *GenGettersGenerator) returnTypeName(t types.Type, nested bool) string {
 	switch it := t.(type) {
 	case *types.Basic:
@@ -102,6 +321,16 @@ func (g *GenGettersGenerator) returnTypeName(t types.Type, nested bool) string {
 			name = namedTypeString(it)
 		}
 
+		// instantiated generic types, such as graphqljson.NullableList
+		if typeArgs := it.TypeArgs(); typeArgs.Len() > 0 {
+			args := make([]string, 0, typeArgs.Len())
+			for i := range typeArgs.Len() {
+				args = append(args, g.returnTypeName(typeArgs.At(i), true))
+			}
+
+			name = namedTypeString(it) + "[" + strings.Join(args, ", ") + "]"
+		}
+
 		if nested {
 			return name
 		}
@@ -128,29 +357,31 @@ func (g *GenGettersGenerator) ConversionGettersFunc(allFragments []*Fragment) fu
 	}
 
 	return func(ownerName string, spreads []*SpreadFragmentInfo) string {
-		if len(spreads) == 0 {
+		if len(spreads) == 0 || g.SkipFragmentSpreadGetters {
 			return ""
 		}
 
 		var buf bytes.Buffer
 
 		for _, spread := range spreads {
-			targetStruct := resolveTargetFragment(fragmentMap, spread)
+			targetTypeName := g.typeName(spread.Type)
+
+			targetStruct := resolveTargetFragment(fragmentMap, targetTypeName)
 			if targetStruct == nil {
 				continue
 			}
 
-			g.writeConversionGetter(&buf, ownerName, g.typeName(spread.Type), targetStruct)
+			g.writeConversionGetter(&buf, ownerName, targetTypeName, targetStruct)
 		}
 
 		return buf.String()
 	}
 }
 
-// resolveTargetFragment looks up a spread fragment's definition and returns its
-// underlying struct type, or nil if not found.
-func resolveTargetFragment(fragmentMap map[string]*Fragment, spread *SpreadFragmentInfo) *types.Struct {
-	targetFragment, ok := fragmentMap[spread.Name]
+// resolveTargetFragment looks up a spread fragment's definition by its Go type name and
+// returns its underlying struct type, or nil if not found.
+func resolveTargetFragment(fragmentMap map[string]*Fragment, typeName string) *types.Struct {
+	targetFragment, ok := fragmentMap[typeName]
 	if !ok {
 		return nil
 	}
@@ -166,11 +397,13 @@ func resolveTargetFragment(fragmentMap map[string]*Fragment, spread *SpreadFragm
 // writeConversionGetter writes a single conversion getter method that constructs
 // the target fragment type from the owner struct's fields.
 func (g *GenGettersGenerator) writeConversionGetter(buf *bytes.Buffer, ownerName string, targetTypeName string, targetStruct *types.Struct) {
-	buf.WriteString("func (t *" + ownerName + ") Get" + targetTypeName + "() *" + targetTypeName + " {\n")
-	buf.WriteString("if t == nil {\n t = &" + ownerName + "{}\n}\n")
-	buf.WriteString("return &" + targetTypeName + "{\n")
-	g.writeStructLiteral(buf, targetStruct, "t")
-	buf.WriteString("}\n}\n")
+	var literal bytes.Buffer
+
+	literal.WriteString("&" + targetTypeName + "{\n")
+	g.writeStructLiteral(&literal, targetStruct, "t")
+	literal.WriteString("}")
+
+	g.writeGetter(buf, ownerName, "Get"+targetTypeName, "*"+targetTypeName, "nil", literal.String())
 }
 
 // writeStructLiteral writes field assignments for a struct literal, delegating
@@ -197,6 +430,127 @@ func (g *GenGettersGenerator) writeFieldAssignment(buf *bytes.Buffer, field *typ
 	}
 }
 
+// matchCase is a single inline fragment branch of a generated Match method.
+type matchCase struct {
+	field     *types.Var
+	typeNames []string
+}
+
+// MatchFunc returns a template function that generates a Match method for structs
+// selecting __typename and inline fragments of a union or interface. Match takes one
+// callback per inline fragment plus onUnknown, so adding a fragment breaks compilation
+// at every call site until it is handled.
+func (g *GenGettersGenerator) MatchFunc(schema *ast.Schema) func(name string, p types.Type) string {
+	return func(name string, p types.Type) string {
+		it, ok := p.(*types.Struct)
+		if !ok {
+			return ""
+		}
+
+		var (
+			typenameField *types.Var
+			cases         []*matchCase
+		)
+
+		for i := range it.NumFields() {
+			tag := reflect.StructTag(it.Tag(i)).Get("graphql")
+			switch {
+			case tag == "__typename":
+				typenameField = it.Field(i)
+			case strings.HasPrefix(tag, "... on "):
+				cases = append(cases, &matchCase{
+					field:     it.Field(i),
+					typeNames: []string{strings.TrimPrefix(tag, "... on ")},
+				})
+			}
+		}
+
+		if typenameField == nil || len(cases) == 0 {
+			return ""
+		}
+
+		resolveMatchCaseTypeNames(schema, cases)
+
+		params := make([]string, 0, len(cases)+1)
+		for _, c := range cases {
+			params = append(params, "on"+c.field.Name()+" func("+g.returnTypeName(c.field.Type(), false)+")")
+		}
+
+		params = append(params, "onUnknown func(typename string)")
+
+		var buf bytes.Buffer
+
+		buf.WriteString("func (t *" + name + ") Match(" + strings.Join(params, ", ") + ") {\n")
+		buf.WriteString("if t == nil {\n t = &" + name + "{}\n}\n")
+
+		if _, ok := typenameField.Type().(*types.Pointer); ok {
+			buf.WriteString("var typename string\n")
+			buf.WriteString("if t." + typenameField.Name() + " != nil {\n typename = *t." + typenameField.Name() + "\n}\n")
+		} else {
+			buf.WriteString("typename := t." + typenameField.Name() + "\n")
+		}
+
+		buf.WriteString("switch typename {\n")
+
+		for _, c := range cases {
+			if len(c.typeNames) == 0 {
+				continue
+			}
+
+			quoted := make([]string, 0, len(c.typeNames))
+			for _, typeName := range c.typeNames {
+				quoted = append(quoted, fmt.Sprintf("%q", typeName))
+			}
+
+			addressOrNot := "&"
+			if _, ok := c.field.Type().(*types.Pointer); ok {
+				addressOrNot = ""
+			}
+
+			buf.WriteString("case " + strings.Join(quoted, ", ") + ":\n")
+			buf.WriteString("on" + c.field.Name() + "(" + addressOrNot + "t." + c.field.Name() + ")\n")
+		}
+
+		buf.WriteString("default:\n onUnknown(typename)\n}\n}\n")
+
+		return buf.String()
+	}
+}
+
+// resolveMatchCaseTypeNames expands type conditions on abstract types into their
+// possible object types. Fragments on object types take precedence, so an object type
+// is only dispatched to an abstract fragment when no fragment names it directly.
+func resolveMatchCaseTypeNames(schema *ast.Schema, cases []*matchCase) {
+	covered := make(map[string]bool)
+
+	for _, c := range cases {
+		if def := schema.Types[c.typeNames[0]]; def == nil || !def.IsAbstractType() {
+			covered[c.typeNames[0]] = true
+		}
+	}
+
+	for _, c := range cases {
+		def := schema.Types[c.typeNames[0]]
+		if def == nil || !def.IsAbstractType() {
+			continue
+		}
+
+		var typeNames []string
+
+		for _, possible := range schema.GetPossibleTypes(def) {
+			if covered[possible.Name] {
+				continue
+			}
+
+			covered[possible.Name] = true
+			typeNames = append(typeNames, possible.Name)
+		}
+
+		sort.Strings(typeNames)
+		c.typeNames = typeNames
+	}
+}
+
 // typeName extracts the short type name from a types.Type.
 func (g *GenGettersGenerator) typeName(t types.Type) string {
 	switch it := t.(type) {
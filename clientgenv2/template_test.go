@@ -1,8 +1,11 @@
 package clientgenv2
 
 import (
+	"bytes"
 	"go/types"
 	"testing"
+
+	"github.com/gqlgo/gqlgenc/config"
 )
 
 // TestReturnTypeName tests the returnTypeName function with various types.
@@ -64,3 +67,51 @@ func TestReturnTypeName(t *testing.T) {
 		})
 	}
 }
+
+// TestWriteGetter tests the getters written for each receiver and nil mode.
+func TestWriteGetter(t *testing.T) {
+	tests := []struct {
+		name     string
+		receiver config.GetterReceiverMode
+		nilMode  config.GetterNilMode
+		expected string
+	}{
+		{
+			name:     "Allocate",
+			expected: "func (t *User) GetName() string {\nif t == nil {\n t = &User{}\n}\nreturn t.Name\n}\n",
+		},
+		{
+			name:     "Zero",
+			nilMode:  config.GetterNilZero,
+			expected: "func (t *User) GetName() string {\nif t == nil {\n return \"\"\n}\nreturn t.Name\n}\n",
+		},
+		{
+			name:     "Panic",
+			nilMode:  config.GetterNilPanic,
+			expected: "func (t *User) GetName() string {\nif t == nil {\n panic(\"GetName called on a nil *User\")\n}\nreturn t.Name\n}\n",
+		},
+		{
+			name:     "Ok",
+			nilMode:  config.GetterNilOk,
+			expected: "func (t *User) GetName() (string, bool) {\nif t == nil {\n return \"\", false\n}\nreturn t.Name, true\n}\n",
+		},
+		{
+			name:     "Value",
+			receiver: config.GetterReceiverValue,
+			expected: "func (t User) GetName() string {\nreturn t.Name\n}\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := &GenGettersGenerator{Receiver: test.receiver, Nil: test.nilMode}
+
+			var buf bytes.Buffer
+			g.writeGetter(&buf, "User", "GetName", "string", zeroValue(types.Typ[types.String]), "t.Name")
+
+			if output := buf.String(); output != test.expected {
+				t.Errorf("Expected %q, but got %q", test.expected, output)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package clientgenv2
+
+import (
+	_ "embed" // used to load template file
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/types"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/codegen/templates"
+)
+
+//go:embed test_skeleton.gotpl
+var testSkeletonTemplate string
+
+// TestSkeletonSuffix ends the names of the test files scaffolded next to the client for each operation.
+const TestSkeletonSuffix = "_test.go"
+
+// renderTestSkeletons writes the test skeleton of every operation that has none yet in the client directory.
+// Skeletons are completed by hand, so existing ones are never written again.
+func renderTestSkeletons(cfg *config.Config, operations []*Operation, operationResponses []*OperationResponse, client config.PackageConfig) error {
+	responses := make(map[string]types.Type, len(operationResponses))
+	for _, operationResponse := range operationResponses {
+		responses[operationResponse.Name] = operationResponse.Type
+	}
+
+	for _, operation := range operations {
+		filename := filepath.Join(client.Dir(), snakeCase(templates.ToGo(operation.Name))+TestSkeletonSuffix)
+
+		_, err := os.Stat(filename)
+		if err == nil {
+			continue
+		}
+
+		if !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("%s: %w", filename, err)
+		}
+
+		response, err := skeletonResponse(responses[operation.ResponseStructName])
+		if err != nil {
+			return fmt.Errorf("%s generating failed: %w", filename, err)
+		}
+
+		err = templates.Render(templates.Options{
+			PackageName: client.Package,
+			Filename:    filename,
+			Template:    testSkeletonTemplate,
+			Data: map[string]any{
+				"Operation": operation,
+				"Response":  response,
+			},
+			Packages: cfg.Packages,
+		})
+		if err != nil {
+			return fmt.Errorf("%s generating failed: %w", filename, err)
+		}
+	}
+
+	return nil
+}
+
+// skeletonResponse returns the body of a response with null for every root field of the response type t.
+func skeletonResponse(t types.Type) (string, error) {
+	data := make(map[string]any)
+
+	if s, ok := t.(*types.Struct); ok {
+		for i := range s.NumFields() {
+			name, _, _ := strings.Cut(reflect.StructTag(s.Tag(i)).Get("json"), ",")
+			if name != "" && name != "-" {
+				data[name] = nil
+			}
+		}
+	}
+
+	response, err := json.Marshal(map[string]any{"data": data})
+	if err != nil {
+		return "", fmt.Errorf("encode response: %w", err)
+	}
+
+	return string(response), nil
+}
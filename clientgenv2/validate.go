@@ -0,0 +1,348 @@
+package clientgenv2
+
+import (
+	"bytes"
+	_ "embed" // used to load template file
+	"fmt"
+	"go/types"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/codegen/templates"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+//go:embed validate.gotpl
+var validateTemplate string
+
+// ValidationFilename is the file generated next to the models with the Validate methods of the input models.
+const ValidationFilename = "validate_gen.go"
+
+// ValidatedInput is an input model whose fields, or the fields of its nested inputs, declare constraints.
+type ValidatedInput struct {
+	Name        string
+	GraphQLName string
+	// Body is the statements appending the violations to errs.
+	Body     string
+	Patterns []*ValidationPattern
+}
+
+// ValidationPattern is a regular expression a string field must match.
+type ValidationPattern struct {
+	Name string
+	Expr string
+}
+
+// fieldConstraints are the constraints declared on an input field by @constraint, @length or @range.
+type fieldConstraints struct {
+	minLength, maxLength string
+	min, max             string
+	pattern              string
+	// patternVar is the package-level variable holding the compiled pattern.
+	patternVar string
+}
+
+func (c *fieldConstraints) isEmpty() bool {
+	return *c == fieldConstraints{}
+}
+
+// newFieldConstraints reads the constraints of the @constraint, @length and @range directives of a field.
+func newFieldConstraints(directives ast.DirectiveList) *fieldConstraints {
+	c := &fieldConstraints{}
+
+	argument := func(directive *ast.Directive, name string) string {
+		if arg := directive.Arguments.ForName(name); arg != nil && arg.Value != nil && arg.Value.Kind != ast.NullValue {
+			return arg.Value.Raw
+		}
+
+		return ""
+	}
+
+	for _, directive := range directives {
+		switch directive.Name {
+		case "constraint":
+			c.minLength = argument(directive, "minLength")
+			c.maxLength = argument(directive, "maxLength")
+			c.min = argument(directive, "min")
+			c.max = argument(directive, "max")
+			c.pattern = argument(directive, "pattern")
+		case "length":
+			c.minLength = argument(directive, "min")
+			c.maxLength = argument(directive, "max")
+		case "range":
+			c.min = argument(directive, "min")
+			c.max = argument(directive, "max")
+		}
+	}
+
+	return c
+}
+
+// validationGenerator generates the Validate methods of the input models of the model package.
+type validationGenerator struct {
+	r           *SourceGenerator
+	modelPrefix string
+	// validated memoizes whether an input, by GraphQL name, has a Validate method.
+	validated map[string]bool
+	inputs    map[string]*ValidatedInput
+}
+
+// ValidatedInputs returns the input models generated in the model package that declare constraints, sorted by name.
+func (r *SourceGenerator) ValidatedInputs() ([]*ValidatedInput, error) {
+	if !r.cfg.Model.IsDefined() {
+		return nil, nil
+	}
+
+	g := &validationGenerator{
+		r:           r,
+		modelPrefix: r.cfg.Model.ImportPath() + ".",
+		validated:   make(map[string]bool),
+		inputs:      make(map[string]*ValidatedInput),
+	}
+
+	for _, def := range r.cfg.Schema.Types {
+		if def.Kind != ast.InputObject {
+			continue
+		}
+
+		_, err := g.validates(def.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	inputs := make([]*ValidatedInput, 0, len(g.inputs))
+	for _, input := range g.inputs {
+		inputs = append(inputs, input)
+	}
+
+	sort.Slice(inputs, func(i, j int) bool { return inputs[i].Name < inputs[j].Name })
+
+	return inputs, nil
+}
+
+// validates reports whether the input named name gets a Validate method, generating it on first use.
+func (g *validationGenerator) validates(name string) (bool, error) {
+	if validated, ok := g.validated[name]; ok {
+		return validated, nil
+	}
+
+	// input types can be recursive, which do not validate until proven otherwise
+	g.validated[name] = false
+
+	def := g.r.cfg.Schema.Types[name]
+	if def == nil || def.Kind != ast.InputObject || len(g.r.cfg.Models[name].Model) == 0 {
+		return false, nil
+	}
+
+	model := g.r.cfg.Models[name].Model[0]
+	if !strings.HasPrefix(model, g.modelPrefix) {
+		return false, nil
+	}
+
+	goType, err := g.r.binder.FindTypeFromName(model)
+	if err != nil {
+		return false, nil
+	}
+
+	it, ok := goType.Underlying().(*types.Struct)
+	if !ok {
+		return false, nil
+	}
+
+	input := &ValidatedInput{
+		Name:        strings.TrimPrefix(model, g.modelPrefix),
+		GraphQLName: name,
+	}
+
+	var body bytes.Buffer
+
+	for i := range it.NumFields() {
+		field := it.Field(i)
+
+		jsonName, _, _ := strings.Cut(reflect.StructTag(it.Tag(i)).Get("json"), ",")
+
+		fieldDef := def.Fields.ForName(jsonName)
+		if fieldDef == nil {
+			continue
+		}
+
+		constraints := newFieldConstraints(fieldDef.Directives)
+
+		nested, err := g.validates(fieldDef.Type.Name())
+		if err != nil {
+			return false, err
+		}
+
+		if constraints.isEmpty() && !nested {
+			continue
+		}
+
+		if constraints.pattern != "" {
+			if _, err := regexp.Compile(constraints.pattern); err != nil {
+				return false, fmt.Errorf("%s.%s: invalid pattern: %w", name, fieldDef.Name, err)
+			}
+
+			constraints.patternVar = "validate" + input.Name + field.Name() + "Pattern"
+			input.Patterns = append(input.Patterns, &ValidationPattern{
+				Name: constraints.patternVar,
+				Expr: constraints.pattern,
+			})
+		}
+
+		body.WriteString(g.check("i."+field.Name(), field.Type(), fieldDef.Name, constraints, nested, 0))
+	}
+
+	if body.Len() == 0 {
+		return false, nil
+	}
+
+	input.Body = body.String()
+	g.validated[name] = true
+	g.inputs[name] = input
+
+	return true, nil
+}
+
+// check returns the statements appending the violations of the value expr of type t to errs.
+func (g *validationGenerator) check(expr string, t types.Type, path string, constraints *fieldConstraints, nested bool, depth int) string {
+	value := fmt.Sprintf("v%d", depth)
+
+	switch typ := t.(type) {
+	case *types.Pointer:
+		// Validate methods are nil-safe
+		if _, ok := typ.Elem().Underlying().(*types.Struct); ok {
+			return g.check(expr, typ.Elem(), path, constraints, nested, depth)
+		}
+
+		inner := g.check("*"+expr, typ.Elem(), path, constraints, nested, depth)
+		if inner == "" {
+			return ""
+		}
+
+		return "if " + expr + " != nil {\n" + inner + "}\n"
+	case *types.Slice:
+		inner := g.check(value, typ.Elem(), path, constraints, nested, depth+1)
+		if inner == "" {
+			return ""
+		}
+
+		return "for _, " + value + " := range " + expr + " {\n" + inner + "}\n"
+	case *types.Named:
+		if isOmittable(typ) {
+			inner := g.check(value, typ.TypeArgs().At(0), path, constraints, nested, depth+1)
+			if inner == "" {
+				return ""
+			}
+
+			return "if " + value + ", ok := " + expr + ".ValueOK(); ok {\n" + inner + "}\n"
+		}
+
+		if _, ok := typ.Underlying().(*types.Struct); ok {
+			if !nested {
+				return ""
+			}
+
+			return "if err := " + expr + ".Validate(); err != nil {\n errs = append(errs, fmt.Errorf(\"" + path + ": %w\", err))\n}\n"
+		}
+
+		return checkBasic(expr, typ.Underlying(), path, constraints)
+	default:
+		return checkBasic(expr, t, path, constraints)
+	}
+}
+
+// checkBasic returns the statements checking the constraints of a string or number.
+func checkBasic(expr string, t types.Type, path string, constraints *fieldConstraints) string {
+	basic, ok := t.(*types.Basic)
+	if !ok {
+		return ""
+	}
+
+	var buf bytes.Buffer
+
+	violation := func(condition, message string) {
+		buf.WriteString("if " + condition + " {\n errs = append(errs, errors.New(" + strconv.Quote(path+": "+message) + "))\n}\n")
+	}
+
+	switch {
+	case basic.Info()&types.IsString != 0:
+		if constraints.minLength != "" {
+			violation("utf8.RuneCountInString(string("+expr+")) < "+constraints.minLength, "length must be at least "+constraints.minLength)
+		}
+
+		if constraints.maxLength != "" {
+			violation("utf8.RuneCountInString(string("+expr+")) > "+constraints.maxLength, "length must be at most "+constraints.maxLength)
+		}
+
+		if constraints.pattern != "" {
+			violation("!"+constraints.patternVar+".MatchString(string("+expr+"))", "must match "+constraints.pattern)
+		}
+	case basic.Info()&types.IsNumeric != 0:
+		number := expr
+		if basic.Info()&types.IsInteger != 0 && strings.ContainsAny(constraints.min+constraints.max, ".eE") {
+			number = "float64(" + expr + ")"
+		}
+
+		if constraints.min != "" {
+			violation(number+" < "+constraints.min, "must be at least "+constraints.min)
+		}
+
+		if constraints.max != "" {
+			violation(number+" > "+constraints.max, "must be at most "+constraints.max)
+		}
+	}
+
+	return buf.String()
+}
+
+// markValidatedArguments marks the arguments of operations whose type is one of inputs, or a pointer to it.
+func markValidatedArguments(operations []*Operation, modelImportPath string, inputs []*ValidatedInput) {
+	names := make(map[string]bool, len(inputs))
+	for _, input := range inputs {
+		names[input.Name] = true
+	}
+
+	for _, operation := range operations {
+		for _, arg := range operation.Args {
+			t := arg.Type
+			if pointer, ok := t.(*types.Pointer); ok {
+				t = pointer.Elem()
+			}
+
+			named, ok := t.(*types.Named)
+			if !ok || named.Obj().Pkg() == nil || named.Obj().Pkg().Path() != modelImportPath {
+				continue
+			}
+
+			arg.Validate = names[named.Obj().Name()]
+		}
+	}
+}
+
+// RenderValidation generates the Validate methods of inputs next to the models.
+func RenderValidation(cfg *config.Config, inputs []*ValidatedInput) error {
+	filename := filepath.Join(cfg.Model.Dir(), ValidationFilename)
+
+	err := templates.Render(templates.Options{
+		PackageName: cfg.Model.Package,
+		Filename:    filename,
+		Template:    validateTemplate,
+		Data: map[string]any{
+			"Inputs": inputs,
+		},
+		Packages:   cfg.Packages,
+		PackageDoc: "// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.\n",
+	})
+	if err != nil {
+		return fmt.Errorf("%s generating failed: %w", filename, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package clientv2
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrOperationNotAllowed is returned by Post when the document is not in the client's OperationAllowlist.
+var ErrOperationNotAllowed = errors.New("operation is not in the allowlist")
+
+// OperationAllowlist is the set of documents a client may send, identified by their SHA-256 hash.
+type OperationAllowlist struct {
+	hashes map[string]struct{}
+}
+
+// NewOperationAllowlist returns an allowlist of documents, e.g. the keys of the generated DocumentOperationNames.
+func NewOperationAllowlist(documents ...string) *OperationAllowlist {
+	a := &OperationAllowlist{hashes: make(map[string]struct{}, len(documents))}
+	for _, document := range documents {
+		a.hashes[documentHash(document)] = struct{}{}
+	}
+
+	return a
+}
+
+// ParseOperationManifest parses a persisted operation manifest, a JSON object mapping the
+// hex encoded SHA-256 hash of each document to the document itself.
+func ParseOperationManifest(data []byte) (*OperationAllowlist, error) {
+	var manifest map[string]string
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("parse operation manifest: %w", err)
+	}
+
+	a := &OperationAllowlist{hashes: make(map[string]struct{}, len(manifest))}
+
+	for hash, document := range manifest {
+		if documentHash(document) != hash {
+			return nil, fmt.Errorf("operation manifest: hash %s does not match its document", hash)
+		}
+
+		a.hashes[hash] = struct{}{}
+	}
+
+	return a, nil
+}
+
+// Allows reports whether document is in the allowlist.
+func (a *OperationAllowlist) Allows(document string) bool {
+	_, ok := a.hashes[documentHash(document)]
+
+	return ok
+}
+
+func documentHash(document string) string {
+	sum := sha256.Sum256([]byte(document))
+
+	return hex.EncodeToString(sum[:])
+}
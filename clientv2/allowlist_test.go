@@ -0,0 +1,51 @@
+package clientv2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationAllowlist(t *testing.T) {
+	t.Parallel()
+
+	const (
+		allowed   = "query GetSomething { something }"
+		forbidden = "query GetOther { other }"
+	)
+
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		_, _ = w.Write([]byte(validData))
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(http.DefaultClient, server.URL, &Options{OperationAllowlist: NewOperationAllowlist(allowed)})
+
+	var res fakeRes
+	require.NoError(t, c.Post(context.Background(), "GetSomething", allowed, &res, nil))
+
+	err := c.Post(context.Background(), "GetOther", forbidden, &res, nil)
+	require.ErrorIs(t, err, ErrOperationNotAllowed)
+	require.Equal(t, int32(1), requests.Load(), "a forbidden operation must not be sent")
+}
+
+func TestParseOperationManifest(t *testing.T) {
+	t.Parallel()
+
+	document := "query GetSomething { something }"
+
+	allowlist, err := ParseOperationManifest([]byte(`{"` + documentHash(document) + `": "` + document + `"}`))
+	require.NoError(t, err)
+	require.True(t, allowlist.Allows(document))
+	require.False(t, allowlist.Allows("query GetOther { other }"))
+
+	_, err = ParseOperationManifest([]byte(`{"0000": "` + document + `"}`))
+	require.ErrorContains(t, err, "does not match")
+}
@@ -0,0 +1,43 @@
+package clientv2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// NewBearerTokenInterceptor returns an interceptor that sends the token returned by token in the Authorization
+// header of every request as a bearer token. token is called for each request, so it can refresh expired tokens;
+// its error fails the request before it is sent, and an empty token sends no Authorization header.
+func NewBearerTokenInterceptor(token func(ctx context.Context) (string, error)) RequestInterceptor {
+	return func(ctx context.Context, req *http.Request, gqlInfo *GQLRequestInfo, res any, next RequestInterceptorFunc) error {
+		t, err := token(ctx)
+		if err != nil {
+			return fmt.Errorf("bearer token: %w", err)
+		}
+
+		if t != "" {
+			req.Header.Set("Authorization", "Bearer "+t)
+		}
+
+		return next(ctx, req, gqlInfo, res)
+	}
+}
+
+// NewBasicAuthInterceptor returns an interceptor that authenticates every request with HTTP basic authentication.
+func NewBasicAuthInterceptor(username, password string) RequestInterceptor {
+	return func(ctx context.Context, req *http.Request, gqlInfo *GQLRequestInfo, res any, next RequestInterceptorFunc) error {
+		req.SetBasicAuth(username, password)
+
+		return next(ctx, req, gqlInfo, res)
+	}
+}
+
+// NewAPIKeyInterceptor returns an interceptor that sends key in the header of every request, like X-API-Key.
+func NewAPIKeyInterceptor(header, key string) RequestInterceptor {
+	return func(ctx context.Context, req *http.Request, gqlInfo *GQLRequestInfo, res any, next RequestInterceptorFunc) error {
+		req.Header.Set(header, key)
+
+		return next(ctx, req, gqlInfo, res)
+	}
+}
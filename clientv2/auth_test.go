@@ -0,0 +1,104 @@
+package clientv2
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthInterceptors(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu      sync.Mutex
+		headers []http.Header
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		headers = append(headers, r.Header.Clone())
+		mu.Unlock()
+
+		_, _ = w.Write([]byte(validData))
+	}))
+	t.Cleanup(server.Close)
+
+	post := func(t *testing.T, interceptors ...RequestInterceptor) http.Header {
+		t.Helper()
+
+		mu.Lock()
+		headers = nil
+		mu.Unlock()
+
+		var res fakeRes
+
+		c := NewClient(http.DefaultClient, server.URL, nil, interceptors...)
+		require.NoError(t, c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil))
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		require.Len(t, headers, 1)
+
+		return headers[0]
+	}
+
+	t.Run("bearer token", func(t *testing.T) {
+		tokens := []string{"first", "refreshed"}
+		interceptor := NewBearerTokenInterceptor(func(ctx context.Context) (string, error) {
+			token := tokens[0]
+			tokens = tokens[1:]
+
+			return token, nil
+		})
+
+		require.Equal(t, "Bearer first", post(t, interceptor).Get("Authorization"))
+		require.Equal(t, "Bearer refreshed", post(t, interceptor).Get("Authorization"))
+	})
+
+	t.Run("empty bearer token", func(t *testing.T) {
+		header := post(t, NewBearerTokenInterceptor(func(ctx context.Context) (string, error) {
+			return "", nil
+		}))
+		require.NotContains(t, header, "Authorization")
+	})
+
+	t.Run("basic auth and API key", func(t *testing.T) {
+		header := post(t, NewBasicAuthInterceptor("user", "secret"), NewAPIKeyInterceptor("X-API-Key", "key"))
+
+		req := &http.Request{Header: header}
+		username, password, ok := req.BasicAuth()
+		require.True(t, ok)
+		require.Equal(t, "user", username)
+		require.Equal(t, "secret", password)
+		require.Equal(t, "key", header.Get("X-API-Key"))
+	})
+}
+
+func TestNewBearerTokenInterceptor_error(t *testing.T) {
+	t.Parallel()
+
+	var sent bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent = true
+	}))
+	t.Cleanup(server.Close)
+
+	errExpired := errors.New("refresh token expired")
+
+	c := NewClient(http.DefaultClient, server.URL, nil, NewBearerTokenInterceptor(func(ctx context.Context) (string, error) {
+		return "", errExpired
+	}))
+
+	var res fakeRes
+
+	err := c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil)
+	require.ErrorIs(t, err, errExpired)
+	require.False(t, sent)
+}
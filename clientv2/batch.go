@@ -0,0 +1,116 @@
+package clientv2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/gqlgo/gqlgenc/graphqljson"
+)
+
+// batchField is a root field of the data of a batch.
+type batchField struct {
+	key   string
+	value json.RawMessage
+}
+
+// batchData keeps the root fields of the data of a batch in order, for PostBatch to split them between the
+// results of its operations.
+type batchData struct {
+	fields []batchField
+}
+
+// parse keeps the root fields of data, which is null when the batch failed as a whole.
+func (d *batchData) parse(data json.RawMessage) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("failed to decode batch data: %w", err)
+	}
+
+	if token == nil {
+		return nil
+	}
+
+	if token != json.Delim('{') {
+		return fmt.Errorf("failed to decode batch data: want an object, got %v", token)
+	}
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return fmt.Errorf("failed to decode batch data: %w", err)
+		}
+
+		key, _ := token.(string)
+
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return fmt.Errorf("failed to decode batch data %s: %w", key, err)
+		}
+
+		d.fields = append(d.fields, batchField{key: key, value: value})
+	}
+
+	return nil
+}
+
+// object returns the fields of d whose key starts with prefix as an object, their prefix removed.
+func (d *batchData) object(prefix string) json.RawMessage {
+	var b bytes.Buffer
+
+	b.WriteByte('{')
+
+	for _, field := range d.fields {
+		key, ok := strings.CutPrefix(field.key, prefix)
+		if !ok {
+			continue
+		}
+
+		if b.Len() > 1 {
+			b.WriteByte(',')
+		}
+
+		quoted, _ := json.Marshal(key)
+		b.Write(quoted)
+		b.WriteByte(':')
+		b.Write(field.value)
+	}
+
+	b.WriteByte('}')
+
+	return b.Bytes()
+}
+
+// PostBatch sends query, which merges the root fields of several operations aliased with a prefix per operation,
+// and decodes the fields of each prefix of results, the prefix removed, into its result. Generated clients use
+// it for the batches of generate.batches.
+func (c *Client) PostBatch(ctx context.Context, operationName, query string, results map[string]any, variables map[string]any, interceptors ...RequestInterceptor) error {
+	var raw rawData
+
+	err := c.Post(ctx, operationName, query, &raw, variables, interceptors...)
+	if err != nil && (!c.ParseDataWhenErrors || raw.data == nil) {
+		return err
+	}
+
+	var data batchData
+	if errData := data.parse(raw.data); errData != nil {
+		if err != nil {
+			return err
+		}
+
+		return errData
+	}
+
+	for prefix, result := range results {
+		errData := graphqljson.UnmarshalData(data.object(prefix), result, c.decodeOptions()...)
+		if errData != nil && err == nil {
+			return fmt.Errorf("failed to decode data of %s into response: %w", strings.TrimSuffix(prefix, "_"), errData)
+		}
+	}
+
+	return err
+}
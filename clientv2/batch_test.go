@@ -0,0 +1,79 @@
+package clientv2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_PostBatch(t *testing.T) {
+	t.Parallel()
+
+	type user struct {
+		User struct {
+			Name string `json:"name"`
+		} `json:"user"`
+	}
+
+	type posts struct {
+		Posts []struct {
+			Title string `json:"title"`
+		} `json:"posts"`
+		Count int `json:"count"`
+	}
+
+	t.Run("splits the data between the operations", func(t *testing.T) {
+		t.Parallel()
+
+		var body map[string]any
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			_, _ = w.Write([]byte(`{"data":{"GetUser_user":{"name":"alice"},"ListPosts_posts":[{"title":"hello"}],"ListPosts_count":1}}`))
+		}))
+		t.Cleanup(server.Close)
+
+		c := NewClient(http.DefaultClient, server.URL, nil)
+
+		var u user
+
+		var p posts
+
+		err := c.PostBatch(context.Background(), "Dashboard", "query Dashboard { ... }", map[string]any{
+			"GetUser_":   &u,
+			"ListPosts_": &p,
+		}, map[string]any{"GetUser_id": "1"})
+		require.NoError(t, err)
+		require.Equal(t, "alice", u.User.Name)
+		require.Len(t, p.Posts, 1)
+		require.Equal(t, "hello", p.Posts[0].Title)
+		require.Equal(t, 1, p.Count)
+		require.Equal(t, "Dashboard", body["operationName"])
+		require.Equal(t, map[string]any{"GetUser_id": "1"}, body["variables"])
+	})
+
+	t.Run("graphql errors", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"data":{"GetUser_user":{"name":"alice"},"ListPosts_posts":null,"ListPosts_count":0},"errors":[{"message":"posts failed","path":["ListPosts_posts"]}]}`))
+		}))
+		t.Cleanup(server.Close)
+
+		var u user
+
+		c := NewClient(http.DefaultClient, server.URL, nil)
+		err := c.PostBatch(context.Background(), "Dashboard", "query Dashboard { ... }", map[string]any{"GetUser_": &u, "ListPosts_": &posts{}}, nil)
+		require.ErrorContains(t, err, "posts failed")
+		require.Empty(t, u.User.Name)
+
+		c = NewClient(http.DefaultClient, server.URL, &Options{ParseDataAlongWithErrors: true})
+		err = c.PostBatch(context.Background(), "Dashboard", "query Dashboard { ... }", map[string]any{"GetUser_": &u, "ListPosts_": &posts{}}, nil)
+		require.ErrorContains(t, err, "posts failed")
+		require.Equal(t, "alice", u.User.Name)
+	})
+}
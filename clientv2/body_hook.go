@@ -0,0 +1,8 @@
+package clientv2
+
+import "net/http"
+
+// RequestBodyHook is called with every request once its body is serialized, and with the exact bytes of
+// the body, so signatures of the body such as HMACs can be attached as headers without encoding it again.
+// It runs before the interceptors of the client. Returning an error aborts the request.
+type RequestBodyHook func(req *http.Request, body []byte) error
@@ -0,0 +1,73 @@
+package clientv2
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+func TestRequestBodyHook(t *testing.T) {
+	t.Parallel()
+
+	key := []byte("secret")
+
+	sign := func(body []byte) string {
+		mac := hmac.New(sha256.New, key)
+		mac.Write(body)
+
+		return hex.EncodeToString(mac.Sum(nil))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil || r.Header.Get("X-Signature") != sign(body) {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(validData))
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(http.DefaultClient, server.URL, &Options{
+		RequestBodyHook: func(req *http.Request, body []byte) error {
+			req.Header.Set("X-Signature", sign(body))
+
+			return nil
+		},
+	})
+
+	var res fakeRes
+	require.NoError(t, c.Post(context.Background(), "GetSomething", "query GetSomething($id: ID!) { something(id: $id) }", &res, map[string]any{"id": "1"}))
+
+	vars := map[string]any{"file": graphql.Upload{Filename: "a.txt", File: strings.NewReader("a")}}
+	require.NoError(t, c.Post(context.Background(), "Upload", "mutation Upload($file: Upload!) { upload(file: $file) }", &res, vars))
+}
+
+func TestRequestBodyHook_error(t *testing.T) {
+	t.Parallel()
+
+	errNoKey := errors.New("no signing key")
+
+	c := NewClient(http.DefaultClient, "http://localhost", &Options{
+		RequestBodyHook: func(*http.Request, []byte) error {
+			return errNoKey
+		},
+	})
+
+	var res fakeRes
+	err := c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil)
+	require.ErrorIs(t, err, errNoKey)
+}
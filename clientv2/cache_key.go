@@ -0,0 +1,24 @@
+package clientv2
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// CacheKey returns a stable key for the result of an operation called with vars, to store results
+// in external caches. Keys have the form "<operationName>:<document hash>:<variables hash>", so they
+// change when the document changes and are equal across services generating the same document.
+// Variables are normalized by MarshalJSON, which orders map keys and honors gqlgen marshalers.
+func CacheKey(ctx context.Context, operationName, document string, vars map[string]any) (string, error) {
+	encoded, err := MarshalJSON(ctx, vars)
+	if err != nil {
+		return "", fmt.Errorf("encode variables: %w", err)
+	}
+
+	documentSum := sha256.Sum256([]byte(document))
+	varsSum := sha256.Sum256(encoded)
+
+	return operationName + ":" + hex.EncodeToString(documentSum[:8]) + ":" + hex.EncodeToString(varsSum[:]), nil
+}
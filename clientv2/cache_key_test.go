@@ -0,0 +1,40 @@
+package clientv2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheKey(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	document := "query GetUser($id: ID!, $filter: Filter) { user(id: $id, filter: $filter) { id } }"
+
+	key, err := CacheKey(ctx, "GetUser", document, map[string]any{
+		"id":     "1",
+		"filter": map[string]any{"b": 2, "a": 1},
+	})
+	require.NoError(t, err)
+	require.Regexp(t, `^GetUser:[0-9a-f]{16}:[0-9a-f]{64}$`, key)
+
+	same, err := CacheKey(ctx, "GetUser", document, map[string]any{
+		"filter": map[string]any{"a": 1, "b": 2},
+		"id":     "1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, key, same, "keys must not depend on map order")
+
+	otherVars, err := CacheKey(ctx, "GetUser", document, map[string]any{"id": "2"})
+	require.NoError(t, err)
+	require.NotEqual(t, key, otherVars)
+
+	otherDocument, err := CacheKey(ctx, "GetUser", document+" ", map[string]any{
+		"id":     "1",
+		"filter": map[string]any{"b": 2, "a": 1},
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, key, otherDocument)
+}
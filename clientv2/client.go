@@ -2,19 +2,20 @@ package clientv2
 
 import (
 	"bytes"
-	"compress/gzip"
 	"context"
 	"encoding"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"mime/multipart"
 	"net/http"
 	"reflect"
 	"slices"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/99designs/gqlgen/graphql"
 
@@ -30,6 +31,8 @@ type HttpClient interface {
 
 type GQLRequestInfo struct {
 	Request *Request
+	// Response is set once the server responded, so interceptors can inspect it after calling next.
+	Response *ResponseMetadata
 }
 
 func NewGQLRequestInfo(r *Request) *GQLRequestInfo {
@@ -82,7 +85,13 @@ func UnsafeChainInterceptor(interceptors ...RequestInterceptor) RequestIntercept
 	}
 }
 
-// Client is the http client wrapper
+// Client is the http client wrapper.
+//
+// A Client is safe for concurrent use by multiple goroutines, and so are the clients generated around it: every
+// operation builds its own request and decodes into its own response, and the state shared between operations,
+// such as the NormalizedCache, sessions or ETags, is guarded. Its fields are set by NewClient and must not be
+// modified once it is in use. Requests reuse the connections of the HttpClient, since response bodies are drained
+// and closed even when they are not decoded.
 type Client struct {
 	Client                     HttpClient
 	BaseURL                    string
@@ -90,6 +99,25 @@ type Client struct {
 	CustomDo                   RequestInterceptorFunc
 	ParseDataWhenErrors        bool
 	IsUnsafeRequestInterceptor bool
+	OperationHook              OperationHook
+	OperationAllowlist         *OperationAllowlist
+	RedirectPolicy             RedirectPolicy
+	VariablesMarshaler         VariablesMarshaler
+	ContentType                RequestContentType
+	OperationNamePolicy        OperationNamePolicy
+	Method                     string
+	EndpointParams             map[string]EndpointParam
+	DecodeOptions              []graphqljson.Option
+	ContentDecoders            map[string]ContentDecoder
+	AllowAnyContentType        bool
+	RequestFieldNames          RequestFieldNames
+	ClientFieldResolvers       map[string]ClientFieldResolver
+	ScalarCodecs               map[string]ScalarCodec
+	ContextExtensions          map[string]ContextExtension
+	RequestBodyHook            RequestBodyHook
+	Uploader                   Uploader
+	ResponseReadLimit          int64
+	ResponseDrainLimit         int64
 }
 
 // Request represents an outgoing GraphQL request
@@ -97,6 +125,8 @@ type Request struct {
 	Query         string         `json:"query"`
 	Variables     map[string]any `json:"variables,omitempty"`
 	OperationName string         `json:"operationName,omitempty"`
+	// Extensions is the extensions object of the request, holding the values of the ContextExtensions of the client.
+	Extensions map[string]any `json:"extensions,omitempty"`
 }
 
 // NewClient creates a new http client wrapper
@@ -109,9 +139,7 @@ func NewClient(client HttpClient, baseURL string, options *Options, interceptors
 		}}, interceptors...)...),
 	}
 
-	if options != nil {
-		c.ParseDataWhenErrors = options.ParseDataAlongWithErrors
-	}
+	c.applyOptions(options)
 
 	return c
 }
@@ -126,9 +154,7 @@ func NewClientWithUnsafeRequestInterceptor(client HttpClient, baseURL string, op
 		IsUnsafeRequestInterceptor: true,
 	}
 
-	if options != nil {
-		c.ParseDataWhenErrors = options.ParseDataAlongWithErrors
-	}
+	c.applyOptions(options)
 
 	return c
 }
@@ -138,6 +164,115 @@ type Options struct {
 	// ParseDataAlongWithErrors is a flag that indicates whether the client should try to parse and return the data along with error
 	// when error appeared. So in the end you'll get list of gql errors and data.
 	ParseDataAlongWithErrors bool
+
+	// OperationHook is called around every operation of a client generated with generate.operationHooks.
+	OperationHook OperationHook
+
+	// OperationAllowlist makes Post fail with ErrOperationNotAllowed, without sending anything,
+	// for documents that are not in the allowlist.
+	OperationAllowlist *OperationAllowlist
+
+	// RedirectPolicy controls whether redirects of the endpoint are followed, they are denied by default.
+	RedirectPolicy RedirectPolicy
+
+	// VariablesMarshaler replaces the encoding of operation variables, nil uses DefaultVariablesMarshaler.
+	VariablesMarshaler VariablesMarshaler
+
+	// ContentType selects how operations are encoded, ContentTypeJSON by default.
+	ContentType RequestContentType
+
+	// OperationNamePolicy selects how the operationName of requests is filled, OperationNameGiven by default.
+	// WithOperationName overrides it for an operation.
+	OperationNamePolicy OperationNamePolicy
+
+	// Method is the HTTP method of requests, POST when empty, for gateways expecting another one.
+	// WithHTTPMethod overrides it for an operation.
+	Method string
+
+	// EndpointParams read from the context of operations the values of the {name} placeholders of the base URL,
+	// such as /graphql/{tenant} for per-tenant paths, keyed by name. WithEndpointParams gives them per call.
+	EndpointParams map[string]EndpointParam
+
+	// DecodeOptions configure how the data of responses is decoded, for example
+	// graphqljson.WithDuplicateKeyPolicy to reject objects having the same key twice.
+	// Limits such as graphqljson.WithMaxDepth apply to whole responses, and to each
+	// element of the lists decoded by PostStream.
+	DecodeOptions []graphqljson.Option
+
+	// ContentDecoders decode the Content-Encodings of responses in addition to DefaultContentDecoders,
+	// when the HttpClient does not decompress them itself.
+	ContentDecoders map[string]ContentDecoder
+
+	// AllowAnyContentType accepts successful responses whose Content-Type is not JSON, which fail
+	// with ErrUnexpectedContentType by default.
+	AllowAnyContentType bool
+
+	// RequestFieldNames renames the fields of request bodies for servers not using the standard ones.
+	RequestFieldNames RequestFieldNames
+
+	// ClientFieldResolvers fill the fields marked with @client in queries, keyed by "<Type>.<field>".
+	ClientFieldResolvers map[string]ClientFieldResolver
+
+	// ScalarCodecs encode and decode the custom scalars of clients generated with generate.scalarCodecs,
+	// keyed by the name of the scalar.
+	ScalarCodecs map[string]ScalarCodec
+
+	// Transport sends the requests in place of the transport of the *http.Client passed to NewClient, which keeps
+	// its Timeout, CheckRedirect and Jar, for middleware handed out as http.RoundTripper. With a nil HttpClient,
+	// an http.Client using Transport is used. It is ignored for other HttpClient implementations.
+	Transport http.RoundTripper
+
+	// ContextExtensions add to the extensions object of requests the values they read from the context of the
+	// operation, keyed by extension name, for gateways expecting client metadata such as trace ids or feature
+	// flags there.
+	ContextExtensions map[string]ContextExtension
+
+	// RequestBodyHook is called with every request and the bytes of its serialized body, to sign it.
+	RequestBodyHook RequestBodyHook
+
+	// Uploader uploads the files of Upload variables before their operation is sent, which sends the values it
+	// returns in their place instead of a multipart request.
+	Uploader Uploader
+
+	// ResponseReadLimit is the maximum number of bytes of a decoded response body, beyond which the operation
+	// fails with ErrResponseTooLarge. Bodies are read whole when it is 0.
+	ResponseReadLimit int64
+
+	// ResponseDrainLimit is the maximum number of bytes left unread in a response body, such as after an error,
+	// that are read and discarded before closing it so its connection can be reused. It is 64 KiB when 0, and
+	// bodies are closed without being drained when it is negative.
+	ResponseDrainLimit int64
+}
+
+func (c *Client) applyOptions(options *Options) {
+	if options == nil {
+		return
+	}
+
+	c.ParseDataWhenErrors = options.ParseDataAlongWithErrors
+	c.OperationHook = options.OperationHook
+	c.OperationAllowlist = options.OperationAllowlist
+	c.RedirectPolicy = options.RedirectPolicy
+	c.VariablesMarshaler = options.VariablesMarshaler
+	c.ContentType = options.ContentType
+	c.OperationNamePolicy = options.OperationNamePolicy
+	c.Method = options.Method
+	c.EndpointParams = options.EndpointParams
+	c.DecodeOptions = options.DecodeOptions
+	c.ContentDecoders = options.ContentDecoders
+	c.AllowAnyContentType = options.AllowAnyContentType
+	c.RequestFieldNames = options.RequestFieldNames
+	c.ClientFieldResolvers = options.ClientFieldResolvers
+	c.ScalarCodecs = options.ScalarCodecs
+	c.ContextExtensions = options.ContextExtensions
+	c.RequestBodyHook = options.RequestBodyHook
+	c.Uploader = options.Uploader
+	c.ResponseReadLimit = options.ResponseReadLimit
+	c.ResponseDrainLimit = options.ResponseDrainLimit
+
+	if options.Transport != nil {
+		c.Client = withTransport(c.Client, options.Transport)
+	}
 }
 
 // GqlErrorList is the struct of a standard graphql error response
@@ -149,10 +284,65 @@ func (e *GqlErrorList) Error() string {
 	return e.Errors.Error()
 }
 
-// HTTPError is the error when a GqlErrorList cannot be parsed
+// HTTPError is the error of a response whose http status code is not OK
 type HTTPError struct {
-	Code    int    `json:"code"`
+	Code int `json:"code"`
+	// Message is "Response body " followed by the whole response body.
 	Message string `json:"message"`
+	// ContentType is the Content-Type of the response, telling apart the HTML pages of proxies from GraphQL servers.
+	ContentType string `json:"contentType,omitempty"`
+	// Body is the response body with its whitespace collapsed, truncated to httpErrorBodyLimit bytes.
+	Body string `json:"body,omitempty"`
+	// Header is the header of the response.
+	Header http.Header `json:"-"`
+	// URL is the URL the request was sent to, after endpoint templating and redirects, without its query.
+	URL string `json:"url,omitempty"`
+}
+
+// httpErrorBodyLimit is the number of bytes of a response body kept in an HTTPError.
+const httpErrorBodyLimit = 512
+
+func newHTTPError(code int, header http.Header, body []byte) *HTTPError {
+	return &HTTPError{
+		Code:        code,
+		Message:     fmt.Sprintf("Response body %s", string(body)),
+		ContentType: header.Get("Content-Type"),
+		Body:        bodySnippet(body),
+		Header:      header,
+	}
+}
+
+// bodySnippet returns body with its whitespace collapsed, truncated to httpErrorBodyLimit bytes.
+func bodySnippet(body []byte) string {
+	snippet := strings.Join(strings.Fields(string(body)), " ")
+	if len(snippet) <= httpErrorBodyLimit {
+		return snippet
+	}
+
+	cut := httpErrorBodyLimit
+	// do not cut a rune in half
+	for cut > 0 && !utf8.RuneStart(snippet[cut]) {
+		cut--
+	}
+
+	return snippet[:cut] + "..."
+}
+
+func (e *HTTPError) Error() string {
+	msg := fmt.Sprintf("http status %d %s", e.Code, http.StatusText(e.Code))
+	if e.ContentType != "" {
+		msg += " (" + e.ContentType + ")"
+	}
+
+	if e.URL != "" {
+		msg += " from " + e.URL
+	}
+
+	if e.Body != "" {
+		msg += ": " + e.Body
+	}
+
+	return msg
 }
 
 // ErrorResponse represent an handled error
@@ -168,6 +358,15 @@ func (er *ErrorResponse) HasErrors() bool {
 	return er.NetworkError != nil || er.GqlErrors != nil
 }
 
+// Unwrap returns the NetworkError, so that errors.As finds the *HTTPError of a response whose http status code is not OK.
+func (er *ErrorResponse) Unwrap() error {
+	if er.NetworkError == nil {
+		return nil
+	}
+
+	return er.NetworkError
+}
+
 func (er *ErrorResponse) Error() string {
 	content, err := json.Marshal(er)
 	if err != nil {
@@ -198,26 +397,89 @@ type header struct {
 
 // Post support send multipart form with files https://gqlgen.com/reference/file-upload/ https://github.com/jaydenseric/graphql-multipart-request-spec
 func (c *Client) Post(ctx context.Context, operationName, query string, respData any, vars map[string]any, interceptors ...RequestInterceptor) error {
+	req, r, err := c.newRequest(ctx, operationName, query, vars)
+	if err != nil {
+		return err
+	}
+
+	gqlInfo := NewGQLRequestInfo(r)
+	ctx = withDecodeOptions(ctx, c.decodeOptions())
+
+	f := ChainInterceptor(append([]RequestInterceptor{c.RequestInterceptor}, interceptors...)...)
+	if c.IsUnsafeRequestInterceptor {
+		f = UnsafeChainInterceptor(append([]RequestInterceptor{c.RequestInterceptor}, interceptors...)...)
+	}
+
+	// if custom do is set, use it instead of the default one
+	if c.CustomDo != nil {
+		return f(ctx, req, gqlInfo, respData, c.CustomDo)
+	}
+
+	return f(ctx, req, gqlInfo, respData, c.do)
+}
+
+// NewRequest builds the HTTP request Post sends for an operation, with its encoded body and headers,
+// for callers sending operations through their own HTTP stack, for example to sign or batch them.
+// The interceptors of the client are not applied, and the response is left to the caller.
+func (c *Client) NewRequest(ctx context.Context, operationName, query string, vars map[string]any) (*http.Request, error) {
+	req, _, err := c.newRequest(ctx, operationName, query, vars)
+
+	return req, err
+}
+
+// newRequest builds the HTTP request of an operation, returning the GraphQL request it encodes too.
+func (c *Client) newRequest(ctx context.Context, operationName, query string, vars map[string]any) (*http.Request, *Request, error) {
+	if c.OperationAllowlist != nil && !c.OperationAllowlist.Allows(query) {
+		return nil, nil, fmt.Errorf("%s: %w", operationName, ErrOperationNotAllowed)
+	}
+
+	if c.Uploader != nil {
+		var err error
+
+		vars, err = c.uploadVariables(ctx, vars)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: upload: %w", operationName, err)
+		}
+	}
+
 	multipartFilesGroups, mapping, vars := parseMultipartFiles(vars)
 
 	r := &Request{
 		Query:         query,
 		Variables:     vars,
-		OperationName: operationName,
+		OperationName: c.sentOperationName(ctx, operationName, query),
+		Extensions:    c.contextExtensions(ctx),
+	}
+
+	requestURL, err := c.endpointURL(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", operationName, err)
 	}
 
-	gqlInfo := NewGQLRequestInfo(r)
 	body := new(bytes.Buffer)
 
 	var headers []header
 
-	if len(multipartFilesGroups) > 0 {
+	switch {
+	case len(multipartFilesGroups) > 0 && c.ContentType == ContentTypeGraphQL:
+		return nil, nil, fmt.Errorf("%s: %w", operationName, ErrUploadNeedsJSON)
+	case len(multipartFilesGroups) > 0:
+		var operations any = r
+		if c.VariablesMarshaler != nil || !c.RequestFieldNames.isStandard() {
+			encoded, err := c.encodeRequest(ctx, r)
+			if err != nil {
+				return nil, nil, fmt.Errorf("encode: %w", err)
+			}
+
+			operations = json.RawMessage(encoded)
+		}
+
 		contentType, err := prepareMultipartFormBody(
 			body,
 			[]FormField{
 				{
 					Name:  "operations",
-					Value: r,
+					Value: operations,
 				},
 				{
 					Name:  "map",
@@ -227,14 +489,24 @@ func (c *Client) Post(ctx context.Context, operationName, query string, respData
 			multipartFilesGroups,
 		)
 		if err != nil {
-			return fmt.Errorf("failed to prepare form body: %w", err)
+			return nil, nil, fmt.Errorf("failed to prepare form body: %w", err)
 		}
 
 		headers = append(headers, header{key: "Content-Type", value: contentType})
-	} else {
-		requestBody, err := MarshalJSON(ctx, r)
+	case c.ContentType == ContentTypeGraphQL:
+		requestURL, err = c.graphQLContentTypeURL(ctx, requestURL, r)
+		if err != nil {
+			return nil, nil, fmt.Errorf("encode: %w", err)
+		}
+
+		body = bytes.NewBufferString(query)
+
+		headers = append(headers, header{key: "Content-Type", value: "application/graphql; charset=utf-8"})
+		headers = append(headers, header{key: "Accept", value: "application/json; charset=utf-8"})
+	default:
+		requestBody, err := c.encodeRequest(ctx, r)
 		if err != nil {
-			return fmt.Errorf("encode: %w", err)
+			return nil, nil, fmt.Errorf("encode: %w", err)
 		}
 
 		body = bytes.NewBuffer(requestBody)
@@ -243,26 +515,22 @@ func (c *Client) Post(ctx context.Context, operationName, query string, respData
 		headers = append(headers, header{key: "Accept", value: "application/json; charset=utf-8"})
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.BaseURL, body)
+	req, err := http.NewRequestWithContext(ctx, c.httpMethod(ctx), requestURL, body)
 	if err != nil {
-		return fmt.Errorf("create request struct failed: %w", err)
+		return nil, nil, fmt.Errorf("create request struct failed: %w", err)
 	}
 
 	for _, h := range headers {
 		req.Header.Set(h.key, h.value)
 	}
 
-	f := ChainInterceptor(append([]RequestInterceptor{c.RequestInterceptor}, interceptors...)...)
-	if c.IsUnsafeRequestInterceptor {
-		f = UnsafeChainInterceptor(append([]RequestInterceptor{c.RequestInterceptor}, interceptors...)...)
-	}
-
-	// if custom do is set, use it instead of the default one
-	if c.CustomDo != nil {
-		return f(ctx, req, gqlInfo, respData, c.CustomDo)
+	if c.RequestBodyHook != nil {
+		if err := c.RequestBodyHook(req, body.Bytes()); err != nil {
+			return nil, nil, fmt.Errorf("%s: request body hook: %w", operationName, err)
+		}
 	}
 
-	return f(ctx, req, gqlInfo, respData, c.do)
+	return req, r, nil
 }
 
 func parseMultipartFiles(
@@ -274,11 +542,14 @@ func parseMultipartFiles(
 		i                    = 0
 	)
 
+	// the variables of the caller are not modified, they may be shared by concurrent operations
+	sent := maps.Clone(vars)
+
 	for k, v := range vars {
 		switch item := v.(type) {
 		case graphql.Upload:
 			iStr := strconv.Itoa(i)
-			vars[k] = nil
+			sent[k] = nil
 			mapping[iStr] = []string{fmt.Sprintf("variables.%s", k)}
 
 			multipartFilesGroups = append(multipartFilesGroups, MultipartFilesGroup{
@@ -298,7 +569,7 @@ func parseMultipartFiles(
 			}
 
 			iStr := strconv.Itoa(i)
-			vars[k] = nil
+			sent[k] = nil
 			mapping[iStr] = []string{fmt.Sprintf("variables.%s", k)}
 
 			multipartFilesGroups = append(multipartFilesGroups, MultipartFilesGroup{
@@ -312,7 +583,7 @@ func parseMultipartFiles(
 
 			i++
 		case []*graphql.Upload:
-			vars[k] = make([]struct{}, len(item))
+			sent[k] = make([]struct{}, len(item))
 
 			groupFiles := make([]MultipartFile, 0, len(item))
 
@@ -335,13 +606,13 @@ func parseMultipartFiles(
 		}
 	}
 
-	return multipartFilesGroups, mapping, vars
+	return multipartFilesGroups, mapping, sent
 }
 
 func prepareMultipartFormBody(
-	buffer *bytes.Buffer, formFields []FormField, files []MultipartFilesGroup,
+	w io.Writer, formFields []FormField, files []MultipartFilesGroup,
 ) (string, error) {
-	writer := multipart.NewWriter(buffer)
+	writer := multipart.NewWriter(w)
 	defer writer.Close()
 
 	// form fields
@@ -380,18 +651,46 @@ func prepareMultipartFormBody(
 	return writer.FormDataContentType(), nil
 }
 
-func (c *Client) do(_ context.Context, req *http.Request, _ *GQLRequestInfo, res any) error {
-	resp, err := c.Client.Do(req)
+// drainLimit is the number of bytes of an unread response body drained so its connection can be reused.
+const drainLimit = 64 << 10
+
+// drainAndClose reads what is left of body, up to drainLimit, and closes it, so the HttpClient can reuse
+// the connection of the response.
+func drainAndClose(body io.ReadCloser) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(body, drainLimit))
+	_ = body.Close()
+}
+
+func (c *Client) do(ctx context.Context, req *http.Request, gqlInfo *GQLRequestInfo, res any) error {
+	resp, err := c.sendRequest(req)
 	if err != nil {
 		return fmt.Errorf("request failed: %w", err)
 	}
-	defer resp.Body.Close()
+	defer c.closeBody(ctx, resp.Body)
 
-	if resp.Header.Get("Content-Encoding") == "gzip" {
-		resp.Body, err = gzip.NewReader(resp.Body)
-		if err != nil {
-			return fmt.Errorf("gzip decode failed: %w", err)
+	recordResponseMetadata(ctx, gqlInfo, resp)
+
+	err = c.decodeContent(resp)
+	if err != nil {
+		return err
+	}
+
+	c.limitBody(resp)
+
+	err = c.checkContentType(resp)
+	if err != nil {
+		return err
+	}
+
+	if stream, ok := res.(*listStream); ok {
+		err = c.streamResponse(resp.Body, resp.StatusCode, resp.Header, stream)
+		setHTTPErrorURL(err, resp)
+
+		if redirectedTo := redirectedURL(req, resp); err != nil && redirectedTo != "" {
+			return fmt.Errorf("after redirect to %s: %w", redirectedTo, err)
 		}
+
+		return err
 	}
 
 	body, err := io.ReadAll(resp.Body)
@@ -399,18 +698,38 @@ func (c *Client) do(_ context.Context, req *http.Request, _ *GQLRequestInfo, res
 		return fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	return c.parseResponse(body, resp.StatusCode, res)
+	recordResponseExtensions(ctx, gqlInfo, body)
+	recordRawBodies(ctx, req, body)
+
+	err = c.parseResponse(body, resp.StatusCode, resp.Header, res)
+	setHTTPErrorURL(err, resp)
+	if redirectedTo := redirectedURL(req, resp); err != nil && redirectedTo != "" {
+		return fmt.Errorf("after redirect to %s: %w", redirectedTo, err)
+	}
+
+	return err
 }
 
-func (c *Client) parseResponse(body []byte, httpCode int, result any) error {
+// setHTTPErrorURL sets the URL of the *HTTPError of err, if any, to the URL resp answers, without its query, which
+// may hold the variables of the operation.
+func setHTTPErrorURL(err error, resp *http.Response) {
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || resp.Request == nil || resp.Request.URL == nil {
+		return
+	}
+
+	u := *resp.Request.URL
+	u.RawQuery = ""
+	u.User = nil
+	httpErr.URL = u.String()
+}
+
+func (c *Client) parseResponse(body []byte, httpCode int, header http.Header, result any) error {
 	errResponse := &ErrorResponse{}
 
 	isOKCode := httpCode < 200 || 299 < httpCode
 	if isOKCode {
-		errResponse.NetworkError = &HTTPError{
-			Code:    httpCode,
-			Message: fmt.Sprintf("Response body %s", string(body)),
-		}
+		errResponse.NetworkError = newHTTPError(httpCode, header, body)
 	}
 
 	// some servers return a graphql error with a non OK http code, try anyway to parse the body
@@ -437,10 +756,33 @@ type response struct {
 	Errors json.RawMessage `json:"errors"`
 }
 
+// rawData receives the data of a response as it is, for the methods of Client decoding it on their own, like
+// Introspect and PostBatch.
+type rawData struct {
+	data json.RawMessage
+}
+
+// decodeData decodes data into res with graphqljson, or keeps it as it is when res is a *rawData.
+func decodeData(data json.RawMessage, res any, options ...graphqljson.Option) error {
+	if raw, ok := res.(*rawData); ok {
+		raw.data = data
+
+		return nil
+	}
+
+	return graphqljson.UnmarshalData(data, res, options...)
+}
+
 func (c *Client) unmarshal(data []byte, res any) error {
+	// the limits of the decode options apply to the whole response, errors and extensions included
+	err := graphqljson.CheckLimits(data, c.DecodeOptions...)
+	if err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
 	resp := response{}
 
-	err := json.Unmarshal(data, &resp)
+	err = json.Unmarshal(data, &resp)
 	if err != nil {
 		return fmt.Errorf("failed to decode data %s: %w", string(data), err)
 	}
@@ -460,8 +802,7 @@ func (c *Client) unmarshal(data []byte, res any) error {
 		}
 	}
 
-	errData := graphqljson.UnmarshalData(resp.Data, res)
-	if errData != nil {
+	if errData := decodeData(resp.Data, res, c.decodeOptions()...); errData != nil {
 		// if ParseDataWhenErrors is true, and we failed to unmarshal data, return the actual error
 		if c.ParseDataWhenErrors {
 			return err
@@ -473,13 +814,19 @@ func (c *Client) unmarshal(data []byte, res any) error {
 	return err
 }
 
-func MarshalJSON(_ context.Context, v any) ([]byte, error) {
-	encoder := &Encoder{}
+// MarshalJSON encodes v, honoring gqlgen marshalers, omittable fields and the scalar codecs of ctx. The encoding is
+// deterministic, so it can be logged, hashed or used in cache keys: the keys of objects are sorted and numbers are
+// written in the shortest form that reads back as the same value, like encoding/json.
+func MarshalJSON(ctx context.Context, v any) ([]byte, error) {
+	encoder := &Encoder{scalarCodecs: scalarCodecs(ctx)}
 	return encoder.Encode(reflect.ValueOf(v))
 }
 
 // Encoder is a struct for encoding GraphQL requests to JSON
-type Encoder struct{}
+type Encoder struct {
+	// scalarCodecs encode the ScalarValues and the fields tagged with a custom scalar.
+	scalarCodecs map[string]ScalarCodec
+}
 
 // fieldInfo holds field information of a struct
 type fieldInfo struct {
@@ -487,6 +834,7 @@ type fieldInfo struct {
 	jsonName  string       // field name in JSON
 	omitempty bool         // omitempty flag
 	omitzero  bool         // omitzero flag
+	scalar    string       // custom scalar of the field
 	typ       reflect.Type // field type
 }
 
@@ -497,6 +845,10 @@ func (e *Encoder) Encode(v reflect.Value) ([]byte, error) {
 	}
 
 	vi := v.Interface()
+	if scalar, ok := vi.(ScalarValue); ok {
+		return e.encodeScalar(scalar.Name, reflect.ValueOf(scalar.Value))
+	}
+
 	if marshaler, ok := vi.(graphql.ContextMarshaler); ok {
 		return e.encodeGQLContextMarshaler(context.Background(), marshaler)
 	}
@@ -611,9 +963,13 @@ func (e *Encoder) encodeUint(v reflect.Value) ([]byte, error) {
 	return fmt.Appendf(nil, "%d", v.Uint()), nil
 }
 
-// encodeFloat encodes a floating-point value
+// encodeFloat encodes a floating-point value like encoding/json, in the shortest form that reads back as the same value
 func (e *Encoder) encodeFloat(v reflect.Value) ([]byte, error) {
-	return fmt.Appendf(nil, "%f", v.Float()), nil
+	if v.Kind() == reflect.Float32 {
+		return json.Marshal(float32(v.Float()))
+	}
+
+	return json.Marshal(v.Float())
 }
 
 // encodeString encodes a string value
@@ -730,7 +1086,7 @@ func (e *Encoder) encodeStruct(v reflect.Value) ([]byte, error) {
 			continue
 		}
 
-		encodedValue, err := e.Encode(fieldValue)
+		encodedValue, err := e.encodeScalar(field.scalar, fieldValue)
 		if err != nil {
 			return nil, err
 		}
@@ -842,6 +1198,7 @@ func (e *Encoder) prepareFields(t reflect.Type) []fieldInfo {
 		fi := fieldInfo{
 			name:     f.Name,
 			jsonName: f.Name,
+			scalar:   f.Tag.Get(scalarTag),
 			typ:      f.Type,
 		}
 
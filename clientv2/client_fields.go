@@ -0,0 +1,112 @@
+package clientv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrNoClientFieldResolver is returned when a response selects a field marked with @client
+// that no ClientFieldResolver of the client resolves.
+var ErrNoClientFieldResolver = errors.New("no resolver for client field")
+
+// ClientFieldResolver computes the value of a field marked with @client in a query. object is a pointer
+// to the generated struct holding the field, whose other fields are already decoded from the response.
+// A nil value leaves the field zero.
+type ClientFieldResolver func(ctx context.Context, object any) (any, error)
+
+// clientFieldTag is the struct tag naming the resolver of a generated field, "<Type>.<field>".
+const clientFieldTag = "client"
+
+// ResolveClientFields sets the fields of res marked with @client, which are never sent to the server,
+// with the ClientFieldResolvers of the client keyed by "<Type>.<field>". Nested objects are resolved
+// before the objects holding them. Generated operation methods call it after decoding their response;
+// the elements yielded by PostStream are not resolved.
+func (c *Client) ResolveClientFields(ctx context.Context, res any) error {
+	return c.resolveClientFields(ctx, reflect.ValueOf(res))
+}
+
+func (c *Client) resolveClientFields(ctx context.Context, v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+
+		return c.resolveClientFields(ctx, v.Elem())
+	case reflect.Slice, reflect.Array:
+		for i := range v.Len() {
+			if err := c.resolveClientFields(ctx, v.Index(i)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case reflect.Struct:
+	default:
+		return nil
+	}
+
+	t := v.Type()
+	for i := range t.NumField() {
+		if t.Field(i).IsExported() {
+			if err := c.resolveClientFields(ctx, v.Field(i)); err != nil {
+				return err
+			}
+		}
+	}
+
+	for i := range t.NumField() {
+		field := t.Field(i)
+
+		name, ok := field.Tag.Lookup(clientFieldTag)
+		if !ok {
+			continue
+		}
+
+		resolver, ok := c.ClientFieldResolvers[name]
+		if !ok {
+			return fmt.Errorf("%w %s", ErrNoClientFieldResolver, name)
+		}
+
+		if !v.CanAddr() {
+			return fmt.Errorf("client field %s: response must be passed by pointer", name)
+		}
+
+		value, err := resolver(ctx, v.Addr().Interface())
+		if err != nil {
+			return fmt.Errorf("client field %s: %w", name, err)
+		}
+
+		if err := setClientField(v.Field(i), value); err != nil {
+			return fmt.Errorf("client field %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// setClientField sets field to value, or to a pointer to a copy of value when field is a pointer to its type.
+func setClientField(field reflect.Value, value any) error {
+	if value == nil {
+		field.SetZero()
+
+		return nil
+	}
+
+	rv := reflect.ValueOf(value)
+
+	switch {
+	case rv.Type().AssignableTo(field.Type()):
+		field.Set(rv)
+	case field.Kind() == reflect.Pointer && rv.Type().AssignableTo(field.Type().Elem()):
+		ptr := reflect.New(field.Type().Elem())
+		ptr.Elem().Set(rv)
+		field.Set(ptr)
+	default:
+		return fmt.Errorf("resolver returned %s, expected %s", rv.Type(), field.Type())
+	}
+
+	return nil
+}
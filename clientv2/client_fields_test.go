@@ -0,0 +1,105 @@
+package clientv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type clientFieldsUser struct {
+	FirstName string  `json:"firstName" graphql:"firstName"`
+	LastName  string  `json:"lastName" graphql:"lastName"`
+	FullName  string  `json:"fullName" graphql:"fullName" client:"User.fullName"`
+	Initials  *string `json:"initials" graphql:"initials" client:"User.initials"`
+}
+
+type clientFieldsRes struct {
+	Viewer  *clientFieldsUser  `json:"viewer" graphql:"viewer"`
+	Friends []clientFieldsUser `json:"friends" graphql:"friends"`
+	Count   int                `json:"count" graphql:"count" client:"Query.count"`
+}
+
+func TestClient_ResolveClientFields(t *testing.T) {
+	t.Parallel()
+
+	resolvers := map[string]ClientFieldResolver{
+		"User.fullName": func(_ context.Context, object any) (any, error) {
+			user := object.(*clientFieldsUser)
+
+			return user.FirstName + " " + user.LastName, nil
+		},
+		"User.initials": func(_ context.Context, object any) (any, error) {
+			user := object.(*clientFieldsUser)
+			if user.FirstName == "" {
+				return nil, nil
+			}
+
+			return user.FirstName[:1] + user.LastName[:1], nil
+		},
+		"Query.count": func(_ context.Context, object any) (any, error) {
+			res := object.(*clientFieldsRes)
+
+			// nested objects are resolved first
+			if res.Viewer != nil && res.Viewer.FullName == "" {
+				return nil, errors.New("viewer not resolved")
+			}
+
+			return len(res.Friends), nil
+		},
+	}
+
+	t.Run("resolves nested fields", func(t *testing.T) {
+		t.Parallel()
+
+		c := &Client{ClientFieldResolvers: resolvers}
+		res := &clientFieldsRes{
+			Viewer:  &clientFieldsUser{FirstName: "Ada", LastName: "Lovelace"},
+			Friends: []clientFieldsUser{{FirstName: "Charles", LastName: "Babbage"}, {}},
+		}
+
+		require.NoError(t, c.ResolveClientFields(context.Background(), res))
+
+		initials := "AL"
+		require.Equal(t, &clientFieldsUser{FirstName: "Ada", LastName: "Lovelace", FullName: "Ada Lovelace", Initials: &initials}, res.Viewer)
+		require.Equal(t, "Charles Babbage", res.Friends[0].FullName)
+		require.Nil(t, res.Friends[1].Initials)
+		require.Equal(t, 2, res.Count)
+	})
+
+	t.Run("missing resolver", func(t *testing.T) {
+		t.Parallel()
+
+		c := &Client{}
+
+		err := c.ResolveClientFields(context.Background(), &clientFieldsRes{})
+		require.ErrorIs(t, err, ErrNoClientFieldResolver)
+		require.ErrorContains(t, err, "Query.count")
+	})
+
+	t.Run("resolver error", func(t *testing.T) {
+		t.Parallel()
+
+		c := &Client{ClientFieldResolvers: map[string]ClientFieldResolver{
+			"Query.count": func(context.Context, any) (any, error) {
+				return nil, fmt.Errorf("offline")
+			},
+		}}
+
+		require.ErrorContains(t, c.ResolveClientFields(context.Background(), &clientFieldsRes{}), "client field Query.count: offline")
+	})
+
+	t.Run("unassignable value", func(t *testing.T) {
+		t.Parallel()
+
+		c := &Client{ClientFieldResolvers: map[string]ClientFieldResolver{
+			"Query.count": func(context.Context, any) (any, error) {
+				return "two", nil
+			},
+		}}
+
+		require.ErrorContains(t, c.ResolveClientFields(context.Background(), &clientFieldsRes{}), "resolver returned string, expected int")
+	})
+}
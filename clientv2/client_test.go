@@ -7,9 +7,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -19,6 +22,8 @@ import (
 
 	"github.com/99designs/gqlgen/graphql"
 
+	"github.com/gqlgo/gqlgenc/graphqljson"
+
 	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -37,6 +42,18 @@ type fakeRes struct {
 	Something string `json:"something"`
 }
 
+// selfDecodingRes records whether its own UnmarshalJSON is called, to check it is decoded by graphqljson instead.
+type selfDecodingRes struct {
+	Something   string `json:"something"`
+	selfDecoded bool
+}
+
+func (r *selfDecodingRes) UnmarshalJSON([]byte) error {
+	r.selfDecoded = true
+
+	return nil
+}
+
 func TestUnmarshal(t *testing.T) {
 	t.Parallel()
 	t.Run("single error", func(t *testing.T) {
@@ -209,6 +226,25 @@ func TestUnmarshal(t *testing.T) {
 		require.Equal(t, res, expected)
 	})
 
+	t.Run("json unmarshaler", func(t *testing.T) {
+		t.Parallel()
+
+		res := &selfDecodingRes{}
+		c := &Client{}
+		require.NoError(t, c.unmarshal([]byte(validData), res))
+		require.Equal(t, "some data", res.Something)
+		require.False(t, res.selfDecoded)
+	})
+
+	t.Run("raw data", func(t *testing.T) {
+		t.Parallel()
+
+		res := &rawData{}
+		c := &Client{}
+		require.NoError(t, c.unmarshal([]byte(validData), res))
+		require.JSONEq(t, `{"something": "some data"}`, string(res.data))
+	})
+
 	t.Run("bad data format", func(t *testing.T) {
 		t.Parallel()
 
@@ -235,7 +271,7 @@ func TestParseResponse(t *testing.T) {
 
 		r := &fakeRes{}
 		c := &Client{}
-		err := c.parseResponse([]byte(qqlSingleErr), 200, r)
+		err := c.parseResponse([]byte(qqlSingleErr), 200, nil, r)
 
 		expectedType := &ErrorResponse{}
 		require.IsType(t, expectedType, err)
@@ -251,7 +287,7 @@ func TestParseResponse(t *testing.T) {
 
 		r := &fakeRes{}
 		c := &Client{}
-		err := c.parseResponse([]byte(withBadErrorsFormat), 200, r)
+		err := c.parseResponse([]byte(withBadErrorsFormat), 200, nil, r)
 
 		expectedType := fmt.Errorf("%w", errors.New("some"))
 		require.IsType(t, expectedType, err)
@@ -262,7 +298,7 @@ func TestParseResponse(t *testing.T) {
 
 		r := &fakeRes{}
 		c := &Client{}
-		err := c.parseResponse([]byte(qqlSingleErr), 400, r)
+		err := c.parseResponse([]byte(qqlSingleErr), 400, nil, r)
 
 		expectedType := &ErrorResponse{}
 		require.IsType(t, expectedType, err)
@@ -279,7 +315,7 @@ func TestParseResponse(t *testing.T) {
 
 		r := &fakeRes{}
 		c := &Client{}
-		err := c.parseResponse([]byte(invalidJSON), 500, r)
+		err := c.parseResponse([]byte(invalidJSON), 500, nil, r)
 
 		expectedType := &ErrorResponse{}
 		require.IsType(t, expectedType, err)
@@ -295,10 +331,38 @@ func TestParseResponse(t *testing.T) {
 
 		r := &fakeRes{}
 		c := &Client{}
-		err := c.parseResponse([]byte(validData), 200, r)
+		err := c.parseResponse([]byte(validData), 200, nil, r)
 
 		require.Nil(t, err)
 	})
+
+	t.Run("limits of decode options", func(t *testing.T) {
+		t.Parallel()
+
+		r := &fakeRes{}
+		c := &Client{DecodeOptions: []graphqljson.Option{graphqljson.WithMaxDepth(2)}}
+		err := c.parseResponse([]byte(`{"errors":[{"message":"deep","extensions":{"a":{}}}]}`), 200, nil, r)
+
+		require.ErrorIs(t, err, graphqljson.ErrLimitExceeded)
+	})
+}
+
+func FuzzParseResponse(f *testing.F) {
+	for _, body := range []string{qqlSingleErr, withBadErrorsFormat, invalidJSON, validData, `{"data":{"something":"`, `[[[[`} {
+		f.Add([]byte(body), 200)
+	}
+
+	f.Add([]byte(qqlSingleErr), 400)
+
+	c := &Client{
+		ParseDataWhenErrors: true,
+		DecodeOptions:       []graphqljson.Option{graphqljson.WithMaxDepth(32), graphqljson.WithMaxStringLength(1024)},
+	}
+
+	f.Fuzz(func(t *testing.T, body []byte, httpCode int) {
+		// must not panic, whatever the server responds
+		_ = c.parseResponse(body, httpCode, nil, &fakeRes{})
+	})
 }
 
 func TestChainInterceptor(t *testing.T) {
@@ -926,6 +990,35 @@ func TestMarshalJSON(t *testing.T) {
 	}
 }
 
+func TestMarshalJSON_deterministic(t *testing.T) {
+	t.Parallel()
+
+	type Input struct {
+		Zeta  float64 `json:"zeta"`
+		Alpha float32 `json:"alpha"`
+	}
+
+	vars := map[string]any{
+		"small":  1e-7,
+		"tenth":  0.1,
+		"large":  1e21,
+		"whole":  float64(3),
+		"input":  Input{Zeta: 2.5, Alpha: 0.1},
+		"nested": map[string]any{"b": 1, "a": []any{1.5, "x"}},
+	}
+
+	want := `{"input":{"alpha":0.1,"zeta":2.5},"large":1e+21,"nested":{"a":[1.5,"x"],"b":1},"small":1e-7,"tenth":0.1,"whole":3}`
+
+	for range 10 {
+		got, err := MarshalJSON(context.Background(), vars)
+		require.NoError(t, err)
+		require.Equal(t, want, string(got))
+	}
+
+	_, err := MarshalJSON(context.Background(), map[string]any{"nan": math.NaN()})
+	require.Error(t, err)
+}
+
 func TestMarshalOmittableJSON(t *testing.T) {
 	t.Parallel()
 
@@ -1874,3 +1967,70 @@ func Test_isZeroValue(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_decodeOptions(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"something":"first","something":"last"}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(http.DefaultClient, server.URL, &Options{
+		DecodeOptions: []graphqljson.Option{graphqljson.WithDuplicateKeyPolicy(graphqljson.DuplicateKeyError)},
+	})
+
+	var res fakeRes
+	err := c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil)
+	require.ErrorIs(t, err, graphqljson.ErrDuplicateKey)
+}
+
+func TestClient_httpError(t *testing.T) {
+	t.Parallel()
+
+	page := "<html>\n  <head><title>502 Bad Gateway</title></head>\n  <body>" + strings.Repeat("é", 600) + "</body>\n</html>"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Header().Set("X-Proxy", "edge")
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = w.Write([]byte(page))
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(http.DefaultClient, server.URL, nil)
+
+	var res fakeRes
+	err := c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil)
+
+	var httpErr *HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	require.Equal(t, http.StatusBadGateway, httpErr.Code)
+	require.Equal(t, "text/html", httpErr.ContentType)
+	require.Equal(t, "edge", httpErr.Header.Get("X-Proxy"))
+	require.True(t, strings.HasPrefix(httpErr.Body, "<html> <head><title>502 Bad Gateway</title></head> <body>éé"))
+	require.True(t, strings.HasSuffix(httpErr.Body, "é..."))
+	require.LessOrEqual(t, len(httpErr.Body), httpErrorBodyLimit+len("..."))
+	require.Equal(t, "Response body "+page, httpErr.Message)
+	require.Equal(t, server.URL, httpErr.URL)
+	require.Equal(t, "http status 502 Bad Gateway (text/html) from "+server.URL+": "+httpErr.Body, httpErr.Error())
+}
+
+func TestClient_NewRequest(t *testing.T) {
+	t.Parallel()
+
+	c := NewClient(http.DefaultClient, "https://example.com/graphql", &Options{OperationAllowlist: NewOperationAllowlist("query GetSomething { something }")})
+
+	req, err := c.NewRequest(context.Background(), "GetSomething", "query GetSomething { something }", map[string]any{"id": "1"})
+	require.NoError(t, err)
+	require.Equal(t, http.MethodPost, req.Method)
+	require.Equal(t, "https://example.com/graphql", req.URL.String())
+	require.Equal(t, "application/json; charset=utf-8", req.Header.Get("Content-Type"))
+
+	body, err := io.ReadAll(req.Body)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"query":"query GetSomething { something }","variables":{"id":"1"},"operationName":"GetSomething"}`, string(body))
+
+	_, err = c.NewRequest(context.Background(), "GetOther", "query GetOther { other }", nil)
+	require.ErrorIs(t, err, ErrOperationNotAllowed)
+}
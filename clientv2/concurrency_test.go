@@ -0,0 +1,125 @@
+package clientv2
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+func TestClient_concurrentUse(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(validData))
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(server.Client(), server.URL, &Options{
+		ContextExtensions: map[string]ContextExtension{"traceId": ContextValue(traceIDKey{})},
+		RequestBodyHook: func(req *http.Request, body []byte) error {
+			req.Header.Set("X-Body-Length", fmt.Sprint(len(body)))
+
+			return nil
+		},
+	}, NewETagInterceptor(), NewRequestIDInterceptor("", nil), NewRetryInterceptor(DefaultRetryPolicy))
+
+	// the variables are shared by every operation
+	vars := map[string]any{"id": "1"}
+
+	var wg sync.WaitGroup
+
+	errs := make(chan error, 16*20)
+
+	for worker := range 16 {
+		wg.Go(func() {
+			ctx := context.WithValue(context.Background(), traceIDKey{}, fmt.Sprint(worker))
+
+			for range 20 {
+				var res fakeRes
+				if err := c.Post(ctx, "GetSomething", "query GetSomething($id: ID!) { something(id: $id) }", &res, vars); err != nil {
+					errs <- err
+
+					continue
+				}
+
+				if res.Something != "some data" {
+					errs <- fmt.Errorf("unexpected response %q", res.Something)
+				}
+			}
+		})
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+}
+
+func TestClient_connectionReuse(t *testing.T) {
+	t.Parallel()
+
+	var connections atomic.Int32
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("response") {
+		case "html":
+			w.Header().Set("Content-Type", "text/html")
+			_, _ = w.Write([]byte("<html>" + strings.Repeat("proxy error page ", 1000) + "</html>"))
+		case "error":
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(strings.Repeat("internal error ", 1000)))
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(validData))
+		}
+	}))
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			connections.Add(1)
+		}
+	}
+	server.Start()
+	t.Cleanup(server.Close)
+
+	for _, response := range []string{"data", "html", "error", "data", "html", "error"} {
+		c := NewClient(server.Client(), server.URL+"?response="+response, nil)
+
+		var res fakeRes
+		_ = c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil)
+	}
+
+	require.Equal(t, int32(1), connections.Load(), "every response, failed or not, lets the connection be reused")
+}
+
+func TestPost_uploadsKeepVariables(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(validData))
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(http.DefaultClient, server.URL, nil)
+
+	upload := graphql.Upload{Filename: "a.txt", File: strings.NewReader("a")}
+	vars := map[string]any{"file": upload, "files": []*graphql.Upload{&upload}}
+
+	var res fakeRes
+	require.NoError(t, c.Post(context.Background(), "Upload", "mutation Upload($file: Upload!, $files: [Upload!]!) { upload(file: $file, files: $files) }", &res, vars))
+	require.Equal(t, upload, vars["file"])
+	require.Equal(t, []*graphql.Upload{&upload}, vars["files"])
+}
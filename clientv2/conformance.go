@@ -0,0 +1,314 @@
+package clientv2
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+
+	"github.com/gqlgo/gqlgenc/graphqljson"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// ErrNonConformingResponse is returned by CheckConformance when a response does not conform to the schema or to
+// the generated type of its operation.
+var ErrNonConformingResponse = errors.New("non-conforming response")
+
+// CheckConformance checks that response, a response body of the operation of document such as a recorded test
+// fixture, conforms to schema: the data has the fields document selects and no other, non-null fields are not
+// null, enums hold values of their enum and scalars values of their kind. When res is not nil, the data must also
+// decode into it, typically a pointer to the generated response struct of the operation. Fixtures drifting from
+// the schema the client was generated from are caught by tests like:
+//
+//	schema := gqlparser.MustLoadSchema(&ast.Source{Name: "schema.graphql", Input: sdl})
+//	require.NoError(t, clientv2.CheckConformance(schema, gen.GetUserDocument, fixture, &gen.GetUser{}))
+//
+// The fields skipped or included with variables and those of fragments on types the data does not tell with
+// __typename are checked only when present.
+func CheckConformance(schema *ast.Schema, document string, response []byte, res any) error {
+	queryDocument, gqlErrs := gqlparser.LoadQuery(schema, document)
+	if len(gqlErrs) > 0 {
+		return fmt.Errorf("invalid document: %w", gqlErrs)
+	}
+
+	if len(queryDocument.Operations) != 1 {
+		return fmt.Errorf("document has %d operations, want 1", len(queryDocument.Operations))
+	}
+
+	var body struct {
+		Data json.RawMessage `json:"data"`
+	}
+
+	if err := json.Unmarshal(response, &body); err != nil {
+		return fmt.Errorf("%w: %w", ErrNonConformingResponse, err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body.Data))
+	decoder.UseNumber()
+
+	var data any
+	if len(body.Data) > 0 {
+		if err := decoder.Decode(&data); err != nil {
+			return fmt.Errorf("%w: %w", ErrNonConformingResponse, err)
+		}
+	}
+
+	operation := queryDocument.Operations[0]
+	checker := &conformanceChecker{schema: schema}
+	checker.checkValue("data", &ast.Type{NamedType: rootTypeName(schema, operation.Operation)}, operation.SelectionSet, data)
+
+	if res != nil && data != nil {
+		if err := graphqljson.UnmarshalData(body.Data, res); err != nil {
+			checker.errs = append(checker.errs, fmt.Errorf("data does not decode into %T: %w", res, err))
+		}
+	}
+
+	if len(checker.errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrNonConformingResponse, errors.Join(checker.errs...))
+	}
+
+	return nil
+}
+
+func rootTypeName(schema *ast.Schema, operation ast.Operation) string {
+	switch operation {
+	case ast.Mutation:
+		return schema.Mutation.Name
+	case ast.Subscription:
+		return schema.Subscription.Name
+	default:
+		return schema.Query.Name
+	}
+}
+
+// conformanceChecker collects the errors of CheckConformance.
+type conformanceChecker struct {
+	schema *ast.Schema
+	errs   []error
+}
+
+func (c *conformanceChecker) errorf(path, format string, args ...any) {
+	c.errs = append(c.errs, fmt.Errorf("%s: %s", path, fmt.Sprintf(format, args...)))
+}
+
+// checkValue checks that value, decoded with json.Number, is a value of typ selecting selectionSet.
+func (c *conformanceChecker) checkValue(path string, typ *ast.Type, selectionSet ast.SelectionSet, value any) {
+	if value == nil {
+		if typ.NonNull {
+			c.errorf(path, "null for non-null %s", typ)
+		}
+
+		return
+	}
+
+	if typ.Elem != nil {
+		items, ok := value.([]any)
+		if !ok {
+			c.errorf(path, "%s for %s, want a list", jsonKind(value), typ)
+
+			return
+		}
+
+		for i, item := range items {
+			c.checkValue(fmt.Sprintf("%s[%d]", path, i), typ.Elem, selectionSet, item)
+		}
+
+		return
+	}
+
+	definition := c.schema.Types[typ.NamedType]
+
+	switch definition.Kind {
+	case ast.Scalar:
+		c.checkScalar(path, definition.Name, value)
+	case ast.Enum:
+		s, ok := value.(string)
+		if !ok {
+			c.errorf(path, "%s for enum %s, want a string", jsonKind(value), definition.Name)
+		} else if definition.EnumValues.ForName(s) == nil {
+			c.errorf(path, "%q is not a value of %s", s, definition.Name)
+		}
+	case ast.Object, ast.Interface, ast.Union:
+		object, ok := value.(map[string]any)
+		if !ok {
+			c.errorf(path, "%s for %s, want an object", jsonKind(value), definition.Name)
+
+			return
+		}
+
+		c.checkObject(path, definition, selectionSet, object)
+	default:
+		c.errorf(path, "%s %s cannot be in responses", definition.Kind, definition.Name)
+	}
+}
+
+func (c *conformanceChecker) checkScalar(path, name string, value any) {
+	var ok bool
+
+	switch name {
+	case "Int":
+		number, isNumber := value.(json.Number)
+		if n, err := number.Int64(); isNumber && err == nil {
+			ok = n >= math.MinInt32 && n <= math.MaxInt32
+		}
+	case "Float":
+		_, ok = value.(json.Number)
+	case "String":
+		_, ok = value.(string)
+	case "Boolean":
+		_, ok = value.(bool)
+	case "ID":
+		switch value := value.(type) {
+		case string:
+			ok = true
+		case json.Number:
+			_, err := value.Int64()
+			ok = err == nil
+		}
+	default:
+		// custom scalars may hold any value
+		ok = true
+	}
+
+	if !ok {
+		c.errorf(path, "%s is not a valid %s", jsonKind(value), name)
+	}
+}
+
+// expectedField is a response key of an object and the fields selected under it.
+type expectedField struct {
+	key    string
+	fields []*ast.Field
+	// certain is set when the key must be in the object, unset when it depends on variables or on a type the
+	// object does not tell.
+	certain bool
+}
+
+func (c *conformanceChecker) checkObject(path string, definition *ast.Definition, selectionSet ast.SelectionSet, object map[string]any) {
+	concrete := ""
+	if definition.Kind == ast.Object {
+		concrete = definition.Name
+	} else if typename, ok := object["__typename"].(string); ok {
+		if !slices.ContainsFunc(c.schema.GetPossibleTypes(definition), func(possible *ast.Definition) bool { return possible.Name == typename }) {
+			c.errorf(path, "%s is not a possible type of %s", typename, definition.Name)
+
+			return
+		}
+
+		concrete = typename
+	}
+
+	var expected []*expectedField
+
+	c.collectFields(definition, concrete, selectionSet, true, &expected)
+
+	selected := make(map[string]bool, len(expected))
+
+	for _, field := range expected {
+		selected[field.key] = true
+
+		value, ok := object[field.key]
+		if !ok {
+			if field.certain {
+				c.errorf(path, "%s is missing", field.key)
+			}
+
+			continue
+		}
+
+		var subSelection ast.SelectionSet
+		for _, f := range field.fields {
+			subSelection = append(subSelection, f.SelectionSet...)
+		}
+
+		c.checkValue(path+"."+field.key, field.fields[0].Definition.Type, subSelection, value)
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(object)) {
+		if !selected[key] {
+			c.errorf(path, "%s is not selected", key)
+		}
+	}
+}
+
+// collectFields adds the fields of selectionSet on parent to expected by response key. Fragments apply when their
+// type condition is concrete, the type of the object, or one of its abstract types; they are uncertain when the
+// concrete type is unknown.
+func (c *conformanceChecker) collectFields(parent *ast.Definition, concrete string, selectionSet ast.SelectionSet, certain bool, expected *[]*expectedField) {
+	for _, selection := range selectionSet {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			fieldCertain := certain && !isConditional(selection.Directives)
+
+			i := slices.IndexFunc(*expected, func(field *expectedField) bool { return field.key == selection.Alias })
+			if i < 0 {
+				*expected = append(*expected, &expectedField{key: selection.Alias})
+				i = len(*expected) - 1
+			}
+
+			(*expected)[i].fields = append((*expected)[i].fields, selection)
+			(*expected)[i].certain = (*expected)[i].certain || fieldCertain
+		case *ast.InlineFragment:
+			if applies, fragmentCertain := c.fragmentApplies(parent, concrete, selection.TypeCondition); applies {
+				c.collectFields(parent, concrete, selection.SelectionSet, certain && fragmentCertain && !isConditional(selection.Directives), expected)
+			}
+		case *ast.FragmentSpread:
+			if applies, fragmentCertain := c.fragmentApplies(parent, concrete, selection.Definition.TypeCondition); applies {
+				c.collectFields(parent, concrete, selection.Definition.SelectionSet, certain && fragmentCertain && !isConditional(selection.Directives), expected)
+			}
+		}
+	}
+}
+
+// fragmentApplies reports whether a fragment on typeCondition applies to an object of parent whose concrete type is
+// concrete, empty when unknown, and whether that is certain.
+func (c *conformanceChecker) fragmentApplies(parent *ast.Definition, concrete, typeCondition string) (bool, bool) {
+	if typeCondition == "" || typeCondition == parent.Name {
+		return true, true
+	}
+
+	if concrete == "" {
+		return true, false
+	}
+
+	if typeCondition == concrete {
+		return true, true
+	}
+
+	condition := c.schema.Types[typeCondition]
+	if condition == nil {
+		return false, true
+	}
+
+	applies := slices.ContainsFunc(c.schema.GetPossibleTypes(condition), func(possible *ast.Definition) bool { return possible.Name == concrete })
+
+	return applies, true
+}
+
+// isConditional reports whether directives skip or include their selection depending on a variable.
+func isConditional(directives ast.DirectiveList) bool {
+	return directives.ForName("skip") != nil || directives.ForName("include") != nil
+}
+
+// jsonKind returns the kind of the JSON value of value, decoded with json.Number.
+func jsonKind(value any) string {
+	switch value := value.(type) {
+	case string:
+		return fmt.Sprintf("string %q", value)
+	case json.Number:
+		return "number " + value.String()
+	case bool:
+		return fmt.Sprintf("boolean %t", value)
+	case []any:
+		return "list"
+	case map[string]any:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
@@ -0,0 +1,155 @@
+package clientv2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const conformanceSchema = `
+enum Role { ADMIN MEMBER }
+
+interface Node { id: ID! }
+
+type User implements Node {
+  id: ID!
+  name: String!
+  role: Role!
+  age: Int
+  score: Float
+}
+
+type Bot implements Node {
+  id: ID!
+  model: String
+}
+
+type Query {
+  user(id: ID!): User
+  nodes: [Node!]!
+}
+`
+
+func TestCheckConformance(t *testing.T) {
+	t.Parallel()
+
+	schema := gqlparser.MustLoadSchema(&ast.Source{Name: "schema.graphql", Input: conformanceSchema})
+
+	const getUser = `query GetUser($id: ID!) { user(id: $id) { id name role age } }`
+
+	const listNodes = `query ListNodes($withModel: Boolean!) {
+  nodes {
+    __typename
+    id
+    ... on User { name }
+    ... on Bot { model @include(if: $withModel) }
+  }
+}`
+
+	type getUserRes struct {
+		User *struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+			Role string `json:"role"`
+			Age  *int   `json:"age"`
+		} `json:"user"`
+	}
+
+	tests := []struct {
+		name     string
+		document string
+		response string
+		res      any
+		want     []string
+	}{
+		{
+			name:     "conforming",
+			document: getUser,
+			response: `{"data":{"user":{"id":"1","name":"alice","role":"ADMIN","age":null}}}`,
+			res:      &getUserRes{},
+		},
+		{
+			name:     "null data",
+			document: getUser,
+			response: `{"data":null,"errors":[{"message":"failed"}]}`,
+		},
+		{
+			name:     "null for non-null",
+			document: getUser,
+			response: `{"data":{"user":{"id":"1","name":null,"role":"ADMIN","age":1}}}`,
+			want:     []string{"data.user.name: null for non-null String!"},
+		},
+		{
+			name:     "unknown enum value",
+			document: getUser,
+			response: `{"data":{"user":{"id":"1","name":"alice","role":"OWNER","age":1}}}`,
+			want:     []string{`data.user.role: "OWNER" is not a value of Role`},
+		},
+		{
+			name:     "scalar of another kind",
+			document: getUser,
+			response: `{"data":{"user":{"id":"1","name":"alice","role":"ADMIN","age":1.5}}}`,
+			want:     []string{"data.user.age: number 1.5 is not a valid Int"},
+		},
+		{
+			name:     "missing and unselected fields",
+			document: getUser,
+			response: `{"data":{"user":{"id":"1","name":"alice","age":1,"email":"a@example.com"}}}`,
+			want:     []string{"data.user: role is missing", "data.user: email is not selected"},
+		},
+		{
+			name:     "not decoding into the generated type",
+			document: getUser,
+			response: `{"data":{"user":{"id":"1","name":"alice","role":"ADMIN","age":null}}}`,
+			res:      &struct{ User []string }{},
+			want:     []string{"data does not decode into"},
+		},
+		{
+			name:     "abstract types resolved by __typename",
+			document: listNodes,
+			response: `{"data":{"nodes":[{"__typename":"User","id":"1","name":"alice"},{"__typename":"Bot","id":"2"}]}}`,
+		},
+		{
+			name:     "fields of fragments on other types",
+			document: listNodes,
+			response: `{"data":{"nodes":[{"__typename":"Bot","id":"2","name":"alice"},{"__typename":"User","id":"1"}]}}`,
+			want:     []string{"data.nodes[0]: name is not selected", "data.nodes[1]: name is missing"},
+		},
+		{
+			name:     "impossible __typename",
+			document: listNodes,
+			response: `{"data":{"nodes":[{"__typename":"Query","id":"1"}]}}`,
+			want:     []string{"data.nodes[0]: Query is not a possible type of Node"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := CheckConformance(schema, tt.document, []byte(tt.response), tt.res)
+			if len(tt.want) == 0 {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.ErrorIs(t, err, ErrNonConformingResponse)
+
+			for _, want := range tt.want {
+				require.ErrorContains(t, err, want)
+			}
+		})
+	}
+
+	t.Run("invalid document", func(t *testing.T) {
+		t.Parallel()
+
+		err := CheckConformance(schema, `query { unknown }`, []byte(`{"data":{}}`), nil)
+		require.ErrorContains(t, err, "invalid document")
+		require.NotErrorIs(t, err, ErrNonConformingResponse)
+	})
+}
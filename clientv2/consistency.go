@@ -0,0 +1,91 @@
+package clientv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// ConsistencyTokenStore keeps the consistency token of the last write, returned by backends in the extensions
+// of mutation responses and expected with the queries that must read it. The context of the operation is
+// passed so tokens can be kept per user or session.
+type ConsistencyTokenStore interface {
+	// Token returns the token to send with a query, empty when there is none.
+	Token(ctx context.Context) (string, error)
+	// SetToken stores the token returned by a mutation.
+	SetToken(ctx context.Context, token string) error
+}
+
+// MemoryConsistencyTokenStore is a ConsistencyTokenStore keeping the last token in memory, shared by all the
+// operations of the clients using it. The zero value is ready to use.
+type MemoryConsistencyTokenStore struct {
+	mu    sync.Mutex
+	token string
+}
+
+// Token returns the last token stored.
+func (s *MemoryConsistencyTokenStore) Token(context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.token, nil
+}
+
+// SetToken replaces the token stored.
+func (s *MemoryConsistencyTokenStore) SetToken(_ context.Context, token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token = token
+
+	return nil
+}
+
+// NewConsistencyTokenInterceptor returns an interceptor giving read-your-writes consistency: after a mutation,
+// the token the response holds in extensions.<extension> is put in store, and before a query, the token of
+// store is sent in the header named header. Tokens that are not JSON strings are kept as their JSON text.
+func NewConsistencyTokenInterceptor(store ConsistencyTokenStore, extension, header string) RequestInterceptor {
+	return func(ctx context.Context, req *http.Request, gqlInfo *GQLRequestInfo, res any, next RequestInterceptorFunc) error {
+		if isQueryOperation(gqlInfo) {
+			token, err := store.Token(ctx)
+			if err != nil {
+				return fmt.Errorf("consistency token: %w", err)
+			}
+
+			if token != "" {
+				req.Header.Set(header, token)
+			}
+
+			return next(ctx, req, gqlInfo, res)
+		}
+
+		err := next(ctx, req, gqlInfo, res)
+
+		if !isMutationOperation(gqlInfo) || gqlInfo.Response == nil || len(gqlInfo.Response.Extensions) == 0 {
+			return err
+		}
+
+		var extensions map[string]json.RawMessage
+		if json.Unmarshal(gqlInfo.Response.Extensions, &extensions) != nil {
+			return err
+		}
+
+		raw, ok := extensions[extension]
+		if !ok || string(raw) == "null" {
+			return err
+		}
+
+		var token string
+		if json.Unmarshal(raw, &token) != nil {
+			token = string(raw)
+		}
+
+		if storeErr := store.SetToken(ctx, token); storeErr != nil && err == nil {
+			err = fmt.Errorf("consistency token: %w", storeErr)
+		}
+
+		return err
+	}
+}
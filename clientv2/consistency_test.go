@@ -0,0 +1,120 @@
+package clientv2
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type failingConsistencyTokenStore struct{}
+
+func (failingConsistencyTokenStore) Token(context.Context) (string, error) {
+	return "", errors.New("unavailable")
+}
+
+func (failingConsistencyTokenStore) SetToken(context.Context, string) error {
+	return errors.New("unavailable")
+}
+
+func TestNewConsistencyTokenInterceptor(t *testing.T) {
+	t.Parallel()
+
+	newServer := func(t *testing.T, extensions string) (*httptest.Server, func() []string) {
+		t.Helper()
+
+		var (
+			mu     sync.Mutex
+			tokens []string
+		)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			tokens = append(tokens, r.Header.Get("X-Consistency-Token"))
+			mu.Unlock()
+
+			_, _ = w.Write([]byte(`{"data":{"something":"some data"},"extensions":` + extensions + `}`))
+		}))
+		t.Cleanup(server.Close)
+
+		return server, func() []string {
+			mu.Lock()
+			defer mu.Unlock()
+
+			return tokens
+		}
+	}
+
+	t.Run("sends the token of the last mutation with queries", func(t *testing.T) {
+		t.Parallel()
+
+		server, tokens := newServer(t, `{"consistencyToken":"txn-42"}`)
+
+		store := &MemoryConsistencyTokenStore{}
+		c := NewClient(http.DefaultClient, server.URL, nil, NewConsistencyTokenInterceptor(store, "consistencyToken", "X-Consistency-Token"))
+
+		var res fakeRes
+		require.NoError(t, c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil))
+		require.NoError(t, c.Post(context.Background(), "SetSomething", "mutation SetSomething { something }", &res, nil))
+		require.NoError(t, c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil))
+
+		require.Equal(t, []string{"", "", "txn-42"}, tokens())
+
+		token, err := store.Token(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "txn-42", token)
+	})
+
+	t.Run("keeps tokens that are not strings as JSON", func(t *testing.T) {
+		t.Parallel()
+
+		server, _ := newServer(t, `{"consistencyToken":1712345678901}`)
+
+		store := &MemoryConsistencyTokenStore{}
+		c := NewClient(http.DefaultClient, server.URL, nil, NewConsistencyTokenInterceptor(store, "consistencyToken", "X-Consistency-Token"))
+
+		var res fakeRes
+		require.NoError(t, c.Post(context.Background(), "SetSomething", "mutation SetSomething { something }", &res, nil))
+
+		token, err := store.Token(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "1712345678901", token)
+	})
+
+	t.Run("queries do not store tokens", func(t *testing.T) {
+		t.Parallel()
+
+		server, _ := newServer(t, `{"consistencyToken":"txn-42"}`)
+
+		store := &MemoryConsistencyTokenStore{}
+		c := NewClient(http.DefaultClient, server.URL, nil, NewConsistencyTokenInterceptor(store, "consistencyToken", "X-Consistency-Token"))
+
+		var res fakeRes
+		require.NoError(t, c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil))
+
+		token, err := store.Token(context.Background())
+		require.NoError(t, err)
+		require.Empty(t, token)
+	})
+
+	t.Run("store errors fail the operation", func(t *testing.T) {
+		t.Parallel()
+
+		server, tokens := newServer(t, `{"consistencyToken":"txn-42"}`)
+
+		c := NewClient(http.DefaultClient, server.URL, nil, NewConsistencyTokenInterceptor(failingConsistencyTokenStore{}, "consistencyToken", "X-Consistency-Token"))
+
+		var res fakeRes
+
+		err := c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil)
+		require.ErrorContains(t, err, "consistency token: unavailable")
+		require.Empty(t, tokens(), "the query is not sent without its token")
+
+		err = c.Post(context.Background(), "SetSomething", "mutation SetSomething { something }", &res, nil)
+		require.ErrorContains(t, err, "consistency token: unavailable")
+	})
+}
@@ -0,0 +1,66 @@
+package clientv2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// ErrUploadNeedsJSON is returned when files are uploaded with ContentTypeGraphQL, which cannot carry them.
+var ErrUploadNeedsJSON = errors.New("file uploads need the multipart request of ContentTypeJSON")
+
+// RequestContentType selects how operations without file uploads are encoded.
+type RequestContentType int
+
+const (
+	// ContentTypeJSON sends the operation as an application/json body. This is the default.
+	ContentTypeJSON RequestContentType = iota
+	// ContentTypeGraphQL sends the document itself as an application/graphql body, for servers that
+	// only accept raw documents. Following express-graphql, the operation name and the JSON encoded
+	// variables and extensions are sent as the operationName, variables and extensions URL query parameters.
+	ContentTypeGraphQL
+)
+
+// graphQLContentTypeURL returns endpoint with the operation name, variables and extensions of r as query parameters.
+func (c *Client) graphQLContentTypeURL(ctx context.Context, endpoint string, r *Request) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("parse base url: %w", err)
+	}
+
+	query := u.Query()
+	names := c.RequestFieldNames.names()
+
+	if r.OperationName != "" {
+		query.Set(names.OperationName, r.OperationName)
+	}
+
+	if len(r.Variables) > 0 {
+		marshaler := c.VariablesMarshaler
+		if marshaler == nil {
+			marshaler = DefaultVariablesMarshaler
+		}
+
+		vars, err := marshaler.MarshalVariables(ctx, r.Variables)
+		if err != nil {
+			return "", fmt.Errorf("marshal variables: %w", err)
+		}
+
+		query.Set(names.Variables, string(vars))
+	}
+
+	if len(r.Extensions) > 0 {
+		extensions, err := json.Marshal(r.Extensions)
+		if err != nil {
+			return "", fmt.Errorf("marshal extensions: %w", err)
+		}
+
+		query.Set("extensions", string(extensions))
+	}
+
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
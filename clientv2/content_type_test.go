@@ -0,0 +1,43 @@
+package clientv2
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+func TestContentTypeGraphQL(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		if r.Header.Get("Content-Type") != "application/graphql; charset=utf-8" ||
+			string(body) != "query GetSomething($id: ID!) { something(id: $id) }" ||
+			r.URL.Query().Get("operationName") != "GetSomething" ||
+			r.URL.Query().Get("variables") != `{"id":"1"}` ||
+			r.URL.Query().Get("tenant") != "a" {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(validData))
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(http.DefaultClient, server.URL+"?tenant=a", &Options{ContentType: ContentTypeGraphQL})
+
+	var res fakeRes
+	require.NoError(t, c.Post(context.Background(), "GetSomething", "query GetSomething($id: ID!) { something(id: $id) }", &res, map[string]any{"id": "1"}))
+	require.Equal(t, "some data", res.Something)
+
+	err := c.Post(context.Background(), "Upload", "mutation Upload($file: Upload!) { upload(file: $file) }", &res, map[string]any{"file": graphql.Upload{Filename: "a.txt"}})
+	require.ErrorIs(t, err, ErrUploadNeedsJSON)
+}
@@ -0,0 +1,118 @@
+package clientv2
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// queryCost is the cost extension reported by Shopify-style APIs.
+type queryCost struct {
+	RequestedQueryCost float64 `json:"requestedQueryCost"`
+	ActualQueryCost    float64 `json:"actualQueryCost"`
+	ThrottleStatus     *struct {
+		MaximumAvailable   float64 `json:"maximumAvailable"`
+		CurrentlyAvailable float64 `json:"currentlyAvailable"`
+		RestoreRate        float64 `json:"restoreRate"`
+	} `json:"throttleStatus"`
+}
+
+// CostTracker follows the query cost budget reported in extensions.cost of responses, as done by
+// Shopify-style APIs, and delays calls that would exceed the available budget until it is restored.
+// The cost of an operation is estimated from the requestedQueryCost of its previous call.
+type CostTracker struct {
+	mu          sync.Mutex
+	maximum     float64
+	available   float64
+	restoreRate float64
+	updatedAt   time.Time
+	costs       map[string]float64
+}
+
+// NewCostTracker returns a CostTracker that knows nothing about the budget until the first response.
+func NewCostTracker() *CostTracker {
+	return &CostTracker{costs: make(map[string]float64)}
+}
+
+// Available returns the budget currently available, including what was restored since the last response.
+func (t *CostTracker) Available() float64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.availableAt(time.Now())
+}
+
+func (t *CostTracker) availableAt(now time.Time) float64 {
+	if t.updatedAt.IsZero() {
+		return math.Inf(1)
+	}
+
+	return math.Min(t.maximum, t.available+t.restoreRate*now.Sub(t.updatedAt).Seconds())
+}
+
+// wait returns how long to wait until the budget covers the estimated cost of operationName.
+func (t *CostTracker) wait(operationName string) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	cost := t.costs[operationName]
+	missing := cost - t.availableAt(time.Now())
+
+	if missing <= 0 || t.restoreRate <= 0 || cost > t.maximum {
+		return 0
+	}
+
+	return time.Duration(missing / t.restoreRate * float64(time.Second))
+}
+
+func (t *CostTracker) update(operationName string, cost *queryCost) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if cost.RequestedQueryCost > 0 {
+		t.costs[operationName] = cost.RequestedQueryCost
+	}
+
+	if status := cost.ThrottleStatus; status != nil {
+		t.maximum = status.MaximumAvailable
+		t.available = status.CurrentlyAvailable
+		t.restoreRate = status.RestoreRate
+		t.updatedAt = time.Now()
+	}
+}
+
+// Interceptor returns the interceptor feeding and enforcing the tracker. Share one tracker between
+// all clients spending the same budget.
+func (t *CostTracker) Interceptor() RequestInterceptor {
+	return func(ctx context.Context, req *http.Request, gqlInfo *GQLRequestInfo, res any, next RequestInterceptorFunc) error {
+		operationName := gqlInfo.Request.OperationName
+
+		if delay := t.wait(operationName); delay > 0 {
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+
+				return ctx.Err()
+			case <-timer.C:
+			}
+		}
+
+		err := next(ctx, req, gqlInfo, res)
+
+		if gqlInfo.Response != nil && len(gqlInfo.Response.Extensions) > 0 {
+			var extensions struct {
+				Cost *queryCost `json:"cost"`
+			}
+
+			if json.Unmarshal(gqlInfo.Response.Extensions, &extensions) == nil && extensions.Cost != nil {
+				t.update(operationName, extensions.Cost)
+			}
+		}
+
+		return err
+	}
+}
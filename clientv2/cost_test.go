@@ -0,0 +1,39 @@
+package clientv2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCostTracker(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"something":"some data"},"extensions":{"cost":{"requestedQueryCost":50,"actualQueryCost":40,"throttleStatus":{"maximumAvailable":1000,"currentlyAvailable":20,"restoreRate":100}}}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	tracker := NewCostTracker()
+	require.True(t, tracker.Available() > 1000, "the budget is unknown before the first response")
+
+	c := NewClient(http.DefaultClient, server.URL, nil, tracker.Interceptor())
+
+	var res fakeRes
+	require.NoError(t, c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil))
+	require.InDelta(t, 20, tracker.Available(), 10)
+
+	// 30 points are missing at a restore rate of 100 per second
+	start := time.Now()
+	require.NoError(t, c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil))
+	require.GreaterOrEqual(t, time.Since(start), 250*time.Millisecond)
+
+	// operations without a known cost are not delayed
+	start = time.Now()
+	require.NoError(t, c.Post(context.Background(), "Other", "query Other { something }", &res, nil))
+	require.Less(t, time.Since(start), 200*time.Millisecond)
+}
@@ -0,0 +1,77 @@
+package clientv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+)
+
+// ErrMissingEndpointParam is returned when the BaseURL of a client has a placeholder no value is given for.
+var ErrMissingEndpointParam = errors.New("missing endpoint parameter")
+
+// endpointPlaceholder matches the {name} placeholders of BaseURL.
+var endpointPlaceholder = regexp.MustCompile(`\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// EndpointParam returns the value of a placeholder of the BaseURL read from the context of the operation,
+// and whether there is one.
+type EndpointParam func(ctx context.Context) (string, bool)
+
+type endpointParamsKey struct{}
+
+// WithEndpointParams returns a context giving the values of the {name} placeholders of the BaseURL of the client,
+// such as /graphql/{tenant}, for the operations it is passed to. They take precedence over the EndpointParams of
+// the client.
+func WithEndpointParams(ctx context.Context, params map[string]string) context.Context {
+	return context.WithValue(ctx, endpointParamsKey{}, params)
+}
+
+type httpMethodKey struct{}
+
+// WithHTTPMethod returns a context sending the operations it is passed to with method, whatever the Method of
+// the client.
+func WithHTTPMethod(ctx context.Context, method string) context.Context {
+	return context.WithValue(ctx, httpMethodKey{}, method)
+}
+
+// endpointURL returns the BaseURL with its placeholders replaced by their path escaped values, given by
+// WithEndpointParams or the EndpointParams of the client.
+func (c *Client) endpointURL(ctx context.Context) (string, error) {
+	params, _ := ctx.Value(endpointParamsKey{}).(map[string]string)
+
+	var err error
+
+	endpoint := endpointPlaceholder.ReplaceAllStringFunc(c.BaseURL, func(placeholder string) string {
+		name := placeholder[1 : len(placeholder)-1]
+
+		value, ok := params[name]
+		if !ok && c.EndpointParams[name] != nil {
+			value, ok = c.EndpointParams[name](ctx)
+		}
+
+		if !ok {
+			err = errors.Join(err, fmt.Errorf("%w %s", ErrMissingEndpointParam, name))
+
+			return placeholder
+		}
+
+		return url.PathEscape(value)
+	})
+
+	return endpoint, err
+}
+
+// httpMethod returns the method of requests, given by WithHTTPMethod or the Method of the client, POST by default.
+func (c *Client) httpMethod(ctx context.Context) string {
+	if method, ok := ctx.Value(httpMethodKey{}).(string); ok && method != "" {
+		return method
+	}
+
+	if c.Method != "" {
+		return c.Method
+	}
+
+	return http.MethodPost
+}
@@ -0,0 +1,153 @@
+package clientv2
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpoint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		path       string
+		options    *Options
+		ctx        func(ctx context.Context) context.Context
+		wantPath   string
+		wantMethod string
+		wantErr    error
+	}{
+		{
+			name:       "untemplated",
+			path:       "/graphql",
+			wantPath:   "/graphql",
+			wantMethod: http.MethodPost,
+		},
+		{
+			name: "param from the context",
+			path: "/graphql/{tenant}",
+			ctx: func(ctx context.Context) context.Context {
+				return WithEndpointParams(ctx, map[string]string{"tenant": "acme"})
+			},
+			wantPath:   "/graphql/acme",
+			wantMethod: http.MethodPost,
+		},
+		{
+			name: "param from the options",
+			path: "/graphql/{tenant}",
+			options: &Options{EndpointParams: map[string]EndpointParam{
+				"tenant": func(context.Context) (string, bool) { return "acme", true },
+			}},
+			wantPath:   "/graphql/acme",
+			wantMethod: http.MethodPost,
+		},
+		{
+			name: "context params take precedence",
+			path: "/graphql/{tenant}",
+			options: &Options{EndpointParams: map[string]EndpointParam{
+				"tenant": func(context.Context) (string, bool) { return "acme", true },
+			}},
+			ctx: func(ctx context.Context) context.Context {
+				return WithEndpointParams(ctx, map[string]string{"tenant": "other"})
+			},
+			wantPath:   "/graphql/other",
+			wantMethod: http.MethodPost,
+		},
+		{
+			name: "params are path escaped",
+			path: "/graphql/{tenant}",
+			ctx: func(ctx context.Context) context.Context {
+				return WithEndpointParams(ctx, map[string]string{"tenant": "a/b"})
+			},
+			wantPath:   "/graphql/a%2Fb",
+			wantMethod: http.MethodPost,
+		},
+		{
+			name: "missing param",
+			path: "/graphql/{tenant}",
+			options: &Options{EndpointParams: map[string]EndpointParam{
+				"tenant": func(context.Context) (string, bool) { return "", false },
+			}},
+			wantErr: ErrMissingEndpointParam,
+		},
+		{
+			name:       "method from the options",
+			path:       "/graphql",
+			options:    &Options{Method: http.MethodPut},
+			wantPath:   "/graphql",
+			wantMethod: http.MethodPut,
+		},
+		{
+			name:    "method from the context",
+			path:    "/graphql",
+			options: &Options{Method: http.MethodPut},
+			ctx: func(ctx context.Context) context.Context {
+				return WithHTTPMethod(ctx, http.MethodPatch)
+			},
+			wantPath:   "/graphql",
+			wantMethod: http.MethodPatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotPath, gotMethod string
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotPath, gotMethod = r.URL.EscapedPath(), r.Method
+				_, _ = w.Write([]byte(`{"data":{"something":"some data"}}`))
+			}))
+			t.Cleanup(server.Close)
+
+			ctx := context.Background()
+			if tt.ctx != nil {
+				ctx = tt.ctx(ctx)
+			}
+
+			c := NewClient(http.DefaultClient, server.URL+tt.path, tt.options)
+
+			var res fakeRes
+
+			err := c.Post(ctx, "GetSomething", "query GetSomething { something }", &res, nil)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.ErrorContains(t, err, "GetSomething")
+				require.ErrorContains(t, err, "tenant")
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantPath, gotPath)
+			require.Equal(t, tt.wantMethod, gotMethod)
+		})
+	}
+}
+
+func TestEndpoint_urlInErrors(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(http.DefaultClient, server.URL+"/graphql/{tenant}", nil)
+	ctx := WithEndpointParams(context.Background(), map[string]string{"tenant": "acme"})
+
+	var res fakeRes
+
+	err := c.Post(ctx, "GetSomething", "query GetSomething { something }", &res, nil)
+
+	var httpErr *HTTPError
+	require.True(t, errors.As(err, &httpErr))
+	require.Equal(t, server.URL+"/graphql/acme", httpErr.URL)
+	require.ErrorContains(t, err, server.URL+"/graphql/acme")
+}
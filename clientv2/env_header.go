@@ -0,0 +1,22 @@
+package clientv2
+
+import (
+	"context"
+	"net/http"
+	"os"
+)
+
+// NewEnvHeaderInterceptor returns an interceptor that sets the headers of env, which maps header names to the
+// environment variables holding their values, on every request. The variables are read for each request, so
+// rotated credentials are picked up, and headers whose variable is unset or empty are not sent.
+func NewEnvHeaderInterceptor(env map[string]string) RequestInterceptor {
+	return func(ctx context.Context, req *http.Request, gqlInfo *GQLRequestInfo, res any, next RequestInterceptorFunc) error {
+		for header, variable := range env {
+			if value := os.Getenv(variable); value != "" {
+				req.Header.Set(header, value)
+			}
+		}
+
+		return next(ctx, req, gqlInfo, res)
+	}
+}
@@ -0,0 +1,40 @@
+package clientv2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// not parallel, since it sets environment variables
+func TestNewEnvHeaderInterceptor(t *testing.T) {
+	var headers []http.Header
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Clone())
+		_, _ = w.Write([]byte(validData))
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(http.DefaultClient, server.URL, nil, NewEnvHeaderInterceptor(map[string]string{
+		"Authorization": "GQLGENC_TEST_TOKEN",
+		"X-Tenant":      "GQLGENC_TEST_TENANT",
+	}))
+
+	t.Setenv("GQLGENC_TEST_TOKEN", "Bearer first")
+	t.Setenv("GQLGENC_TEST_TENANT", "")
+
+	var res fakeRes
+	require.NoError(t, c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil))
+
+	t.Setenv("GQLGENC_TEST_TOKEN", "Bearer rotated")
+	require.NoError(t, c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil))
+
+	require.Len(t, headers, 2)
+	require.Equal(t, "Bearer first", headers[0].Get("Authorization"))
+	require.Equal(t, "Bearer rotated", headers[1].Get("Authorization"))
+	require.NotContains(t, headers[0], "X-Tenant")
+}
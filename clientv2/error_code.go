@@ -0,0 +1,52 @@
+package clientv2
+
+import "errors"
+
+// ErrorCodes returns the extensions.code of every GraphQL error in err, in response order.
+func ErrorCodes(err error) []string {
+	var errResponse *ErrorResponse
+	if !errors.As(err, &errResponse) || errResponse.GqlErrors == nil {
+		return nil
+	}
+
+	var codes []string
+
+	for _, gqlErr := range *errResponse.GqlErrors {
+		if code, ok := gqlErr.Extensions["code"].(string); ok {
+			codes = append(codes, code)
+		}
+	}
+
+	return codes
+}
+
+// WrapErrorCodes wraps err so errors.Is also reports the sentinels of the extensions.code of its GraphQL errors.
+// err is returned as is when none of its codes has a sentinel.
+func WrapErrorCodes(err error, sentinels map[string]error) error {
+	var matched []error
+
+	for _, code := range ErrorCodes(err) {
+		if sentinel, ok := sentinels[code]; ok {
+			matched = append(matched, sentinel)
+		}
+	}
+
+	if len(matched) == 0 {
+		return err
+	}
+
+	return &codeError{err: err, sentinels: matched}
+}
+
+type codeError struct {
+	err       error
+	sentinels []error
+}
+
+func (e *codeError) Error() string {
+	return e.err.Error()
+}
+
+func (e *codeError) Unwrap() []error {
+	return append([]error{e.err}, e.sentinels...)
+}
@@ -0,0 +1,52 @@
+package clientv2
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrapErrorCodes(t *testing.T) {
+	t.Parallel()
+
+	errNotFound := errors.New("NOT_FOUND")
+	errForbidden := errors.New("FORBIDDEN")
+	sentinels := map[string]error{
+		"NOT_FOUND": errNotFound,
+		"FORBIDDEN": errForbidden,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"errors":[{"message":"user not found","extensions":{"code":"NOT_FOUND"}},{"message":"boom"},{"message":"slow down","extensions":{"code":"THROTTLED"}}]}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(http.DefaultClient, server.URL, nil)
+
+	var res fakeRes
+
+	err := c.Post(context.Background(), "Query", "query Query { id }", &res, nil)
+	require.Equal(t, []string{"NOT_FOUND", "THROTTLED"}, ErrorCodes(err))
+
+	wrapped := WrapErrorCodes(err, sentinels)
+	require.ErrorIs(t, wrapped, errNotFound)
+	require.NotErrorIs(t, wrapped, errForbidden)
+	require.Equal(t, err.Error(), wrapped.Error())
+
+	var errResponse *ErrorResponse
+	require.ErrorAs(t, wrapped, &errResponse)
+}
+
+func TestWrapErrorCodesWithoutMatch(t *testing.T) {
+	t.Parallel()
+
+	err := errors.New("connection refused")
+	require.Nil(t, ErrorCodes(err))
+	require.Same(t, err, WrapErrorCodes(err, map[string]error{"NOT_FOUND": errors.New("NOT_FOUND")}))
+	require.NoError(t, WrapErrorCodes(nil, nil))
+}
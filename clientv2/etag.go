@@ -0,0 +1,74 @@
+package clientv2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+)
+
+type etagEntry struct {
+	etag string
+	data []byte
+}
+
+// NewETagInterceptor returns an interceptor that remembers the ETag of every response per
+// operation and variables, sends it back as If-None-Match, and serves the cached result
+// when the server answers 304 Not Modified.
+//
+// Cached results are stored as JSON, so response types must survive an encoding/json round trip.
+func NewETagInterceptor() RequestInterceptor {
+	var (
+		mu      sync.Mutex
+		entries = make(map[string]*etagEntry)
+	)
+
+	return func(ctx context.Context, req *http.Request, gqlInfo *GQLRequestInfo, res any, next RequestInterceptorFunc) error {
+		if gqlInfo == nil || gqlInfo.Request == nil {
+			return next(ctx, req, gqlInfo, res)
+		}
+
+		key, err := CacheKey(ctx, gqlInfo.Request.OperationName, gqlInfo.Request.Query, gqlInfo.Request.Variables)
+		if err != nil {
+			return next(ctx, req, gqlInfo, res)
+		}
+
+		mu.Lock()
+		cached := entries[key]
+		mu.Unlock()
+
+		if cached != nil {
+			req.Header.Set("If-None-Match", cached.etag)
+		}
+
+		err = next(ctx, req, gqlInfo, res)
+
+		if gqlInfo.Response == nil {
+			return err
+		}
+
+		if cached != nil && gqlInfo.Response.StatusCode == http.StatusNotModified {
+			// a 304 has no body, so the client reports it as a network error
+			var errResponse *ErrorResponse
+			if err != nil && (!errors.As(err, &errResponse) || errResponse.GqlErrors != nil) {
+				return err
+			}
+
+			return json.Unmarshal(cached.data, res)
+		}
+
+		etag := gqlInfo.Response.Header.Get("ETag")
+		if err != nil || etag == "" {
+			return err
+		}
+
+		if data, err := json.Marshal(res); err == nil {
+			mu.Lock()
+			entries[key] = &etagEntry{etag: etag, data: data}
+			mu.Unlock()
+		}
+
+		return nil
+	}
+}
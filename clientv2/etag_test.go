@@ -0,0 +1,50 @@
+package clientv2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewETagInterceptor(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+
+			return
+		}
+
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(validData))
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(server.Client(), server.URL, nil, NewETagInterceptor())
+	query := "query GetSomething { something }"
+
+	var first fakeRes
+	require.NoError(t, c.Post(context.Background(), "GetSomething", query, &first, nil))
+	require.Equal(t, "some data", first.Something)
+
+	ctx, meta := WithResponseMetadata(context.Background())
+
+	var second fakeRes
+	require.NoError(t, c.Post(ctx, "GetSomething", query, &second, nil))
+	require.Equal(t, http.StatusNotModified, meta.StatusCode)
+	require.Equal(t, "some data", second.Something)
+
+	var other fakeRes
+	require.NoError(t, c.Post(context.Background(), "GetSomething", query, &other, map[string]any{"id": "2"}))
+	require.Equal(t, "some data", other.Something)
+	require.Equal(t, int32(3), requests.Load())
+}
@@ -0,0 +1,54 @@
+package clientv2
+
+import (
+	"context"
+	"maps"
+)
+
+// ContextExtension returns the value of a request extension read from the context of the operation,
+// and whether there is one.
+type ContextExtension func(ctx context.Context) (any, bool)
+
+// ContextValue returns a ContextExtension reading the value of key from the context, left out when it is nil.
+func ContextValue(key any) ContextExtension {
+	return func(ctx context.Context) (any, bool) {
+		value := ctx.Value(key)
+
+		return value, value != nil
+	}
+}
+
+type requestExtensionsKey struct{}
+
+// WithRequestExtensions returns a context adding extensions to the extensions object of the operations it is
+// passed to, as generated clients do with the extensions of generate.complexityHints. The ContextExtensions of
+// the client take precedence over them.
+func WithRequestExtensions(ctx context.Context, extensions map[string]any) context.Context {
+	return context.WithValue(ctx, requestExtensionsKey{}, extensions)
+}
+
+// contextExtensions returns the extensions set in ctx with WithRequestExtensions and those the ContextExtensions of
+// the client read from ctx, nil when there is none.
+func (c *Client) contextExtensions(ctx context.Context) map[string]any {
+	var extensions map[string]any
+
+	if requestExtensions, ok := ctx.Value(requestExtensionsKey{}).(map[string]any); ok && len(requestExtensions) > 0 {
+		// the map is shared by the operations of a generated client, so it is copied before adding to it
+		extensions = maps.Clone(requestExtensions)
+	}
+
+	for name, extension := range c.ContextExtensions {
+		value, ok := extension(ctx)
+		if !ok {
+			continue
+		}
+
+		if extensions == nil {
+			extensions = make(map[string]any, len(c.ContextExtensions))
+		}
+
+		extensions[name] = value
+	}
+
+	return extensions
+}
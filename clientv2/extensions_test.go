@@ -0,0 +1,122 @@
+package clientv2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type traceIDKey struct{}
+
+func TestContextExtensions(t *testing.T) {
+	t.Parallel()
+
+	const query = "query GetSomething { something }"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(validData))
+	}))
+	t.Cleanup(server.Close)
+
+	options := &Options{
+		ContextExtensions: map[string]ContextExtension{
+			"traceId": ContextValue(traceIDKey{}),
+			"flags": func(context.Context) (any, bool) {
+				return []string{"new-checkout"}, true
+			},
+		},
+	}
+	ctx := context.WithValue(context.Background(), traceIDKey{}, "trace-1")
+
+	t.Run("json body", func(t *testing.T) {
+		t.Parallel()
+
+		c := NewClient(http.DefaultClient, server.URL, options)
+
+		var (
+			bodies RawBodies
+			res    fakeRes
+		)
+
+		require.NoError(t, c.Post(WithRawBodies(ctx, &bodies), "GetSomething", query, &res, nil))
+		require.JSONEq(t, `{"query":"`+query+`","operationName":"GetSomething","extensions":{"traceId":"trace-1","flags":["new-checkout"]}}`, string(bodies.Request))
+
+		// values missing from the context are left out
+		require.NoError(t, c.Post(WithRawBodies(context.Background(), &bodies), "GetSomething", query, &res, nil))
+		require.JSONEq(t, `{"query":"`+query+`","operationName":"GetSomething","extensions":{"flags":["new-checkout"]}}`, string(bodies.Request))
+	})
+
+	t.Run("renamed request fields", func(t *testing.T) {
+		t.Parallel()
+
+		c := NewClient(http.DefaultClient, server.URL, &Options{
+			ContextExtensions: options.ContextExtensions,
+			RequestFieldNames: RequestFieldNames{OperationName: "operation"},
+		})
+
+		var (
+			bodies RawBodies
+			res    fakeRes
+		)
+
+		require.NoError(t, c.Post(WithRawBodies(ctx, &bodies), "GetSomething", query, &res, nil))
+		require.JSONEq(t, `{"query":"`+query+`","operation":"GetSomething","extensions":{"traceId":"trace-1","flags":["new-checkout"]}}`, string(bodies.Request))
+	})
+
+	t.Run("no extensions", func(t *testing.T) {
+		t.Parallel()
+
+		c := NewClient(http.DefaultClient, server.URL, nil)
+
+		var (
+			bodies RawBodies
+			res    fakeRes
+		)
+
+		require.NoError(t, c.Post(WithRawBodies(ctx, &bodies), "GetSomething", query, &res, nil))
+		require.JSONEq(t, `{"query":"`+query+`","operationName":"GetSomething"}`, string(bodies.Request))
+	})
+
+	t.Run("request extensions", func(t *testing.T) {
+		t.Parallel()
+
+		c := NewClient(http.DefaultClient, server.URL, options)
+
+		var (
+			bodies RawBodies
+			res    fakeRes
+		)
+
+		requestExtensions := map[string]any{"complexityHint": json.RawMessage(`12`), "flags": "overridden"}
+
+		require.NoError(t, c.Post(WithRequestExtensions(WithRawBodies(ctx, &bodies), requestExtensions), "GetSomething", query, &res, nil))
+		require.JSONEq(t, `{"query":"`+query+`","operationName":"GetSomething","extensions":{"complexityHint":12,"traceId":"trace-1","flags":["new-checkout"]}}`, string(bodies.Request))
+		require.Len(t, requestExtensions, 2, "the extensions of the context are not modified")
+	})
+}
+
+func TestContextExtensions_graphQLContentType(t *testing.T) {
+	t.Parallel()
+
+	var extensions string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		extensions = r.URL.Query().Get("extensions")
+		_, _ = w.Write([]byte(validData))
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(http.DefaultClient, server.URL, &Options{
+		ContentType:       ContentTypeGraphQL,
+		ContextExtensions: map[string]ContextExtension{"traceId": ContextValue(traceIDKey{})},
+	})
+
+	var res fakeRes
+	ctx := context.WithValue(context.Background(), traceIDKey{}, "trace-1")
+	require.NoError(t, c.Post(ctx, "GetSomething", "query GetSomething { something }", &res, nil))
+	require.JSONEq(t, `{"traceId":"trace-1"}`, extensions)
+}
@@ -0,0 +1,111 @@
+package clientv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// NewFailoverInterceptor returns an interceptor that spreads requests round-robin over endpoints,
+// replacing the scheme and host of the request URL, whose path and query are kept. An endpoint that
+// fails with a transport error or a 5xx status is skipped for cooldown and the request is retried on
+// the next endpoint. When every endpoint is cooling down they are all tried anyway, so requests never
+// fail without reaching a server.
+func NewFailoverInterceptor(endpoints []string, cooldown time.Duration) (RequestInterceptor, error) {
+	if len(endpoints) == 0 {
+		return nil, errors.New("failover needs at least one endpoint")
+	}
+
+	urls := make([]*url.URL, 0, len(endpoints))
+
+	for _, endpoint := range endpoints {
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("parse endpoint %s: %w", endpoint, err)
+		}
+
+		urls = append(urls, u)
+	}
+
+	var (
+		mu        sync.Mutex
+		cursor    int
+		downUntil = make([]time.Time, len(urls))
+	)
+
+	// order returns the endpoint indexes to try, healthy ones first, starting at the round-robin position.
+	order := func() []int {
+		mu.Lock()
+		defer mu.Unlock()
+
+		start := cursor
+		cursor = (cursor + 1) % len(urls)
+		now := time.Now()
+
+		healthy := make([]int, 0, len(urls))
+		var down []int
+
+		for i := range urls {
+			idx := (start + i) % len(urls)
+			if now.Before(downUntil[idx]) {
+				down = append(down, idx)
+			} else {
+				healthy = append(healthy, idx)
+			}
+		}
+
+		return append(healthy, down...)
+	}
+
+	markDown := func(idx int) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		downUntil[idx] = time.Now().Add(cooldown)
+	}
+
+	return func(ctx context.Context, req *http.Request, gqlInfo *GQLRequestInfo, res any, next RequestInterceptorFunc) error {
+		var err error
+
+		for attempt, idx := range order() {
+			attemptReq := req.Clone(ctx)
+			attemptReq.URL.Scheme = urls[idx].Scheme
+			attemptReq.URL.Host = urls[idx].Host
+			attemptReq.Host = ""
+
+			if attempt > 0 {
+				if req.GetBody == nil {
+					return err
+				}
+
+				attemptReq.Body, err = req.GetBody()
+				if err != nil {
+					return fmt.Errorf("failed to rewind request body: %w", err)
+				}
+			}
+
+			gqlInfo.Response = nil
+
+			err = next(ctx, attemptReq, gqlInfo, res)
+			if ctx.Err() != nil || !isEndpointFailure(err, gqlInfo) {
+				return err
+			}
+
+			markDown(idx)
+		}
+
+		return err
+	}, nil
+}
+
+func isEndpointFailure(err error, gqlInfo *GQLRequestInfo) bool {
+	if gqlInfo.Response == nil {
+		return err != nil
+	}
+
+	return gqlInfo.Response.StatusCode >= http.StatusInternalServerError
+}
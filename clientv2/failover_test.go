@@ -0,0 +1,89 @@
+package clientv2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFailoverInterceptor(t *testing.T) {
+	t.Parallel()
+
+	var brokenHits, healthyHits atomic.Int32
+
+	broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		brokenHits.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	t.Cleanup(broken.Close)
+
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		healthyHits.Add(1)
+		_, _ = w.Write([]byte(validData))
+	}))
+	t.Cleanup(healthy.Close)
+
+	failover, err := NewFailoverInterceptor([]string{broken.URL, healthy.URL}, time.Minute)
+	require.NoError(t, err)
+
+	c := NewClient(http.DefaultClient, "http://unused.invalid", nil, failover)
+
+	for range 3 {
+		var res fakeRes
+		require.NoError(t, c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil))
+		require.Equal(t, "some data", res.Something)
+	}
+
+	// the broken endpoint is skipped while cooling down
+	require.Equal(t, int32(1), brokenHits.Load())
+	require.Equal(t, int32(3), healthyHits.Load())
+}
+
+func TestNewFailoverInterceptor_keepsPathAndQuery(t *testing.T) {
+	t.Parallel()
+
+	broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	t.Cleanup(broken.Close)
+
+	var gotMethod, gotPath string
+
+	var gotQuery url.Values
+
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod, gotPath, gotQuery = r.Method, r.URL.Path, r.URL.Query()
+		_, _ = w.Write([]byte(validData))
+	}))
+	t.Cleanup(healthy.Close)
+
+	failover, err := NewFailoverInterceptor([]string{broken.URL, healthy.URL}, time.Minute)
+	require.NoError(t, err)
+
+	c := NewClient(http.DefaultClient, "http://unused.invalid/graphql/{tenant}", &Options{
+		Method:      http.MethodGet,
+		ContentType: ContentTypeGraphQL,
+	}, failover)
+	ctx := WithEndpointParams(context.Background(), map[string]string{"tenant": "acme"})
+
+	var res fakeRes
+	require.NoError(t, c.Post(ctx, "GetSomething", "query GetSomething($id: ID!) { something }", &res, map[string]any{"id": "1"}))
+	require.Equal(t, "some data", res.Something)
+	require.Equal(t, http.MethodGet, gotMethod)
+	require.Equal(t, "/graphql/acme", gotPath)
+	require.Equal(t, "GetSomething", gotQuery.Get("operationName"))
+	require.JSONEq(t, `{"id":"1"}`, gotQuery.Get("variables"))
+}
+
+func TestNewFailoverInterceptor_noEndpoints(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewFailoverInterceptor(nil, time.Minute)
+	require.Error(t, err)
+}
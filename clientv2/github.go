@@ -0,0 +1,150 @@
+package clientv2
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GitHubEndpoint is the endpoint of the GitHub GraphQL API.
+const GitHubEndpoint = "https://api.github.com/graphql"
+
+// GitHubOptions configures NewGitHubInterceptor.
+type GitHubOptions struct {
+	// Previews are the names of the API previews requested in the Accept header, like "starfox"
+	// for application/vnd.github.starfox-preview+json.
+	Previews []string
+	// MaxAttempts is the total number of attempts of requests hitting a rate limit, including the first one.
+	// Requests are not retried when it is below 2.
+	MaxAttempts int
+	// MaxDelay caps the wait for a rate limit to reset. Longer waits are not made, the error is returned instead.
+	MaxDelay time.Duration
+}
+
+// NewGitHubInterceptor returns an interceptor for the GitHub GraphQL API that requests options.Previews and
+// retries the requests rejected by the primary or secondary rate limits once they allow them again, as told by
+// the Retry-After and X-RateLimit-* headers, waiting a minute when they tell nothing as GitHub recommends.
+// It composes with NewBearerTokenInterceptor for authentication:
+//
+//	client := clientv2.NewClient(http.DefaultClient, clientv2.GitHubEndpoint, nil,
+//		clientv2.NewBearerTokenInterceptor(token),
+//		clientv2.NewGitHubInterceptor(clientv2.GitHubOptions{MaxAttempts: 3, MaxDelay: 5 * time.Minute}),
+//	)
+func NewGitHubInterceptor(options GitHubOptions) RequestInterceptor {
+	accept := make([]string, 0, len(options.Previews))
+	for _, preview := range options.Previews {
+		accept = append(accept, "application/vnd.github."+preview+"-preview+json")
+	}
+
+	return func(ctx context.Context, req *http.Request, gqlInfo *GQLRequestInfo, res any, next RequestInterceptorFunc) error {
+		if len(accept) > 0 {
+			req.Header.Set("Accept", strings.Join(accept, ", "))
+		}
+
+		return retryAttempts(ctx, req, gqlInfo, res, next, options.MaxAttempts, func(_ int, _ error, gqlInfo *GQLRequestInfo) (time.Duration, bool) {
+			delay, limited := gitHubRateLimitDelay(gqlInfo.Response)
+
+			return delay, limited && (options.MaxDelay <= 0 || delay <= options.MaxDelay)
+		})
+	}
+}
+
+// gitHubRateLimitDelay reports whether resp was rejected by a rate limit of GitHub and how long to wait before
+// retrying it.
+func gitHubRateLimitDelay(resp *ResponseMetadata) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	limited := resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests
+
+	if delay := parseRetryAfter(resp.Header.Get("Retry-After")); limited && delay > 0 {
+		return delay, true
+	}
+
+	if rateLimit, ok := ParseGitHubRateLimit(resp.Header); ok && rateLimit.Remaining == 0 {
+		return max(time.Until(rateLimit.Reset), 0), true
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return time.Minute, true
+	}
+
+	return 0, false
+}
+
+// GitHubRateLimit is the state of a rate limit of the GitHub API, reported in the X-RateLimit-* headers of its
+// responses. Read them with WithResponseMetadata to account for the points operations cost:
+//
+//	ctx, meta := clientv2.WithResponseMetadata(ctx)
+//	res, err := client.GetRepository(ctx, owner, name)
+//	rateLimit, ok := clientv2.ParseGitHubRateLimit(meta.Header)
+type GitHubRateLimit struct {
+	// Resource is the rate limit the request counted against, "graphql" for the GraphQL API.
+	Resource string
+	// Limit is the number of points allowed in the current window.
+	Limit int
+	// Used is the number of points used in the current window.
+	Used int
+	// Remaining is the number of points left in the current window.
+	Remaining int
+	// Reset is when the current window ends.
+	Reset time.Time
+}
+
+// ParseGitHubRateLimit returns the rate limit reported in header, and false when header has no X-RateLimit-* headers.
+func ParseGitHubRateLimit(header http.Header) (GitHubRateLimit, bool) {
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return GitHubRateLimit{}, false
+	}
+
+	rateLimit := GitHubRateLimit{
+		Resource:  header.Get("X-RateLimit-Resource"),
+		Remaining: remaining,
+	}
+	rateLimit.Limit, _ = strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	rateLimit.Used, _ = strconv.Atoi(header.Get("X-RateLimit-Used"))
+
+	if reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		rateLimit.Reset = time.Unix(reset, 0)
+	}
+
+	return rateLimit, true
+}
+
+// GitHubLegacyNodeID returns the global node ID, in the legacy format of the node_id fields of the REST API, of
+// the object of type typeName, like "User" or "Repository", whose database ID is databaseID.
+func GitHubLegacyNodeID(typeName string, databaseID int64) string {
+	return base64.StdEncoding.EncodeToString(fmt.Appendf(nil, "0%d:%s%d", len(typeName), typeName, databaseID))
+}
+
+// ParseGitHubLegacyNodeID returns the type name and the database ID of a global node ID in the legacy format.
+// The IDs in the newer format, like "U_kgDOABCD", do not carry them in a documented way and are rejected.
+func ParseGitHubLegacyNodeID(nodeID string) (typeName string, databaseID int64, err error) {
+	decoded, err := base64.StdEncoding.DecodeString(nodeID)
+	if err != nil {
+		return "", 0, fmt.Errorf("github node ID %q is not in the legacy format: %w", nodeID, err)
+	}
+
+	length, rest, ok := strings.Cut(string(decoded), ":")
+	if !ok || !strings.HasPrefix(length, "0") {
+		return "", 0, fmt.Errorf("github node ID %q is not in the legacy format", nodeID)
+	}
+
+	n, err := strconv.Atoi(length[1:])
+	if err != nil || n <= 0 || n >= len(rest) {
+		return "", 0, fmt.Errorf("github node ID %q is not in the legacy format", nodeID)
+	}
+
+	databaseID, err = strconv.ParseInt(rest[n:], 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("github node ID %q is not in the legacy format: %w", nodeID, err)
+	}
+
+	return rest[:n], databaseID, nil
+}
@@ -0,0 +1,162 @@
+package clientv2
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGitHubInterceptor(t *testing.T) {
+	t.Parallel()
+
+	var (
+		attempts atomic.Int32
+		accept   atomic.Value
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accept.Store(r.Header.Get("Accept"))
+
+		if attempts.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Unix(), 10))
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message":"You have exceeded a secondary rate limit."}`))
+
+			return
+		}
+
+		_, _ = w.Write([]byte(validData))
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(http.DefaultClient, server.URL, nil, NewGitHubInterceptor(GitHubOptions{
+		Previews:    []string{"starfox", "merge-info"},
+		MaxAttempts: 2,
+		MaxDelay:    time.Second,
+	}))
+
+	var res fakeRes
+	require.NoError(t, c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil))
+	require.Equal(t, "some data", res.Something)
+	require.Equal(t, int32(2), attempts.Load())
+	require.Equal(t, "application/vnd.github.starfox-preview+json, application/vnd.github.merge-info-preview+json", accept.Load())
+}
+
+func TestNewGitHubInterceptor_notRateLimited(t *testing.T) {
+	t.Parallel()
+
+	var attempts atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(http.DefaultClient, server.URL, nil, NewGitHubInterceptor(GitHubOptions{MaxAttempts: 3}))
+
+	var res fakeRes
+	require.Error(t, c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil))
+	require.Equal(t, int32(1), attempts.Load())
+}
+
+func TestGitHubRateLimitDelay(t *testing.T) {
+	t.Parallel()
+
+	reset := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	tests := []struct {
+		name    string
+		resp    *ResponseMetadata
+		limited bool
+		longer  time.Duration
+	}{
+		{
+			name:    "retry after",
+			resp:    &ResponseMetadata{StatusCode: http.StatusForbidden, Header: http.Header{"Retry-After": {"30"}}},
+			limited: true,
+			longer:  30 * time.Second,
+		},
+		{
+			name: "primary rate limit",
+			resp: &ResponseMetadata{StatusCode: http.StatusForbidden, Header: http.Header{
+				"X-Ratelimit-Remaining": {"0"},
+				"X-Ratelimit-Reset":     {strconv.FormatInt(reset.Unix(), 10)},
+			}},
+			limited: true,
+			longer:  59 * time.Minute,
+		},
+		{
+			name:    "too many requests without headers",
+			resp:    &ResponseMetadata{StatusCode: http.StatusTooManyRequests, Header: http.Header{}},
+			limited: true,
+			longer:  time.Minute,
+		},
+		{
+			name: "forbidden",
+			resp: &ResponseMetadata{StatusCode: http.StatusForbidden, Header: http.Header{}},
+		},
+		{
+			name: "transport error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			delay, limited := gitHubRateLimitDelay(tt.resp)
+			require.Equal(t, tt.limited, limited)
+			require.GreaterOrEqual(t, delay, tt.longer)
+		})
+	}
+}
+
+func TestParseGitHubRateLimit(t *testing.T) {
+	t.Parallel()
+
+	rateLimit, ok := ParseGitHubRateLimit(http.Header{
+		"X-Ratelimit-Limit":     {"5000"},
+		"X-Ratelimit-Remaining": {"4990"},
+		"X-Ratelimit-Used":      {"10"},
+		"X-Ratelimit-Reset":     {"1700000000"},
+		"X-Ratelimit-Resource":  {"graphql"},
+	})
+	require.True(t, ok)
+	require.Equal(t, GitHubRateLimit{
+		Resource:  "graphql",
+		Limit:     5000,
+		Used:      10,
+		Remaining: 4990,
+		Reset:     time.Unix(1700000000, 0),
+	}, rateLimit)
+
+	_, ok = ParseGitHubRateLimit(http.Header{})
+	require.False(t, ok)
+}
+
+func TestGitHubLegacyNodeID(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "MDQ6VXNlcjU4MzIzMQ==", GitHubLegacyNodeID("User", 583231))
+	require.Equal(t, "MDEwOlJlcG9zaXRvcnkxMjk2MjY5", GitHubLegacyNodeID("Repository", 1296269))
+
+	typeName, databaseID, err := ParseGitHubLegacyNodeID("MDEwOlJlcG9zaXRvcnkxMjk2MjY5")
+	require.NoError(t, err)
+	require.Equal(t, "Repository", typeName)
+	require.Equal(t, int64(1296269), databaseID)
+
+	for _, nodeID := range []string{"U_kgDOABCD", "MDQ6VXNlcg==", base64.StdEncoding.EncodeToString([]byte("User583231"))} {
+		_, _, err := ParseGitHubLegacyNodeID(nodeID)
+		require.Error(t, err, nodeID)
+	}
+}
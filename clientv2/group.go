@@ -0,0 +1,138 @@
+package clientv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// GroupOptions configures a Group.
+type GroupOptions struct {
+	// Limit is the maximum number of operations of the group running at once, unbounded when 0.
+	Limit int
+	// CancelOnError cancels the context of the group on the first failed operation, so the other ones stop early,
+	// and makes Wait return that first error only.
+	CancelOnError bool
+}
+
+// Group runs operations, such as the methods of generated clients, concurrently with a shared context.
+// Operations are added with Go and their results read once Wait returns.
+//
+//	g := clientv2.NewGroup(ctx, &clientv2.GroupOptions{Limit: 4})
+//	user := clientv2.Go(g, "user", func(ctx context.Context) (*gen.GetUser, error) { return c.GetUser(ctx, id) })
+//	posts := clientv2.Go(g, "posts", func(ctx context.Context) (*gen.ListPosts, error) { return c.ListPosts(ctx, id) })
+//	if err := g.Wait(); err != nil {
+//		return err
+//	}
+//	u, _ := user.Get()
+//	p, _ := posts.Get()
+type Group struct {
+	ctx           context.Context
+	cancel        context.CancelCauseFunc
+	limit         chan struct{}
+	cancelOnError bool
+
+	wg       sync.WaitGroup
+	mu       sync.Mutex
+	errs     []error
+	firstErr error
+}
+
+// NewGroup returns a Group whose operations run with a context derived from ctx, canceled when Wait returns.
+func NewGroup(ctx context.Context, options *GroupOptions) *Group {
+	if options == nil {
+		options = &GroupOptions{}
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+
+	g := &Group{
+		ctx:           ctx,
+		cancel:        cancel,
+		cancelOnError: options.CancelOnError,
+	}
+
+	if options.Limit > 0 {
+		g.limit = make(chan struct{}, options.Limit)
+	}
+
+	return g
+}
+
+// GroupResult is the result of an operation of a Group.
+type GroupResult[T any] struct {
+	done  chan struct{}
+	value T
+	err   error
+}
+
+// Get waits for the operation to finish and returns its result.
+func (r *GroupResult[T]) Get() (T, error) {
+	<-r.done
+
+	return r.value, r.err
+}
+
+// Go runs op in g, waiting first for a slot when the group has a Limit. Its error, if any, is prefixed with name.
+func Go[T any](g *Group, name string, op func(ctx context.Context) (T, error)) *GroupResult[T] {
+	result := &GroupResult[T]{done: make(chan struct{})}
+
+	g.wg.Add(1)
+
+	go func() {
+		defer g.wg.Done()
+		defer close(result.done)
+
+		if g.limit != nil {
+			select {
+			case g.limit <- struct{}{}:
+				defer func() { <-g.limit }()
+			case <-g.ctx.Done():
+				result.err = fmt.Errorf("%s: %w", name, context.Cause(g.ctx))
+				g.fail(result.err)
+
+				return
+			}
+		}
+
+		result.value, result.err = op(g.ctx)
+		if result.err != nil {
+			result.err = fmt.Errorf("%s: %w", name, result.err)
+			g.fail(result.err)
+		}
+	}()
+
+	return result
+}
+
+func (g *Group) fail(err error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.errs = append(g.errs, err)
+
+	if g.firstErr == nil {
+		g.firstErr = err
+
+		if g.cancelOnError {
+			g.cancel(err)
+		}
+	}
+}
+
+// Wait waits for the operations of g to finish and cancels its context. It returns the first error when
+// CancelOnError is set, the errors of all the failed operations joined otherwise, nil when none failed.
+func (g *Group) Wait() error {
+	g.wg.Wait()
+	g.cancel(context.Canceled)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.cancelOnError {
+		return g.firstErr
+	}
+
+	return errors.Join(g.errs...)
+}
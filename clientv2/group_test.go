@@ -0,0 +1,143 @@
+package clientv2
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("typed results", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"data":{"something":"some data"}}`))
+		}))
+		t.Cleanup(server.Close)
+
+		c := NewClient(http.DefaultClient, server.URL, nil)
+		g := NewGroup(context.Background(), nil)
+
+		something := Go(g, "something", func(ctx context.Context) (*fakeRes, error) {
+			var res fakeRes
+			if err := c.Post(ctx, "GetSomething", "query GetSomething { something }", &res, nil); err != nil {
+				return nil, err
+			}
+
+			return &res, nil
+		})
+		count := Go(g, "count", func(ctx context.Context) (int, error) { return 2, nil })
+
+		require.NoError(t, g.Wait())
+
+		res, err := something.Get()
+		require.NoError(t, err)
+		require.Equal(t, "some data", res.Something)
+
+		n, err := count.Get()
+		require.NoError(t, err)
+		require.Equal(t, 2, n)
+	})
+
+	t.Run("bounded parallelism", func(t *testing.T) {
+		t.Parallel()
+
+		var running, maxRunning atomic.Int32
+
+		g := NewGroup(context.Background(), &GroupOptions{Limit: 2})
+		for range 10 {
+			Go(g, "op", func(ctx context.Context) (struct{}, error) {
+				n := running.Add(1)
+				defer running.Add(-1)
+
+				for {
+					m := maxRunning.Load()
+					if n <= m || maxRunning.CompareAndSwap(m, n) {
+						break
+					}
+				}
+
+				time.Sleep(10 * time.Millisecond)
+
+				return struct{}{}, nil
+			})
+		}
+
+		require.NoError(t, g.Wait())
+		require.Equal(t, int32(2), maxRunning.Load())
+	})
+
+	t.Run("aggregated errors", func(t *testing.T) {
+		t.Parallel()
+
+		errFirst, errSecond := errors.New("first"), errors.New("second")
+
+		g := NewGroup(context.Background(), nil)
+		first := Go(g, "first", func(ctx context.Context) (int, error) { return 0, errFirst })
+		Go(g, "second", func(ctx context.Context) (int, error) { return 0, errSecond })
+		third := Go(g, "third", func(ctx context.Context) (int, error) { return 3, nil })
+
+		err := g.Wait()
+		require.ErrorIs(t, err, errFirst)
+		require.ErrorIs(t, err, errSecond)
+		require.ErrorContains(t, err, "first: first")
+
+		_, err = first.Get()
+		require.ErrorIs(t, err, errFirst)
+
+		n, err := third.Get()
+		require.NoError(t, err)
+		require.Equal(t, 3, n)
+	})
+
+	t.Run("cancel on error", func(t *testing.T) {
+		t.Parallel()
+
+		errFailed := errors.New("failed")
+
+		g := NewGroup(context.Background(), &GroupOptions{CancelOnError: true})
+		Go(g, "failing", func(ctx context.Context) (int, error) { return 0, errFailed })
+		waiting := Go(g, "waiting", func(ctx context.Context) (int, error) {
+			<-ctx.Done()
+
+			return 0, context.Cause(ctx)
+		})
+
+		err := g.Wait()
+		require.ErrorIs(t, err, errFailed)
+		require.Equal(t, "failing: failed", err.Error())
+
+		_, err = waiting.Get()
+		require.ErrorIs(t, err, errFailed)
+	})
+
+	t.Run("canceled while waiting for a slot", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		g := NewGroup(ctx, &GroupOptions{Limit: 1})
+		Go(g, "blocking", func(ctx context.Context) (int, error) {
+			<-ctx.Done()
+
+			return 0, ctx.Err()
+		})
+		queued := Go(g, "queued", func(ctx context.Context) (int, error) { return 1, nil })
+
+		cancel()
+
+		require.ErrorIs(t, g.Wait(), context.Canceled)
+		// the queued operation may have got the slot once the blocking one returned
+		if _, err := queued.Get(); err != nil {
+			require.ErrorIs(t, err, context.Canceled)
+		}
+	})
+}
@@ -0,0 +1,42 @@
+package clientv2
+
+import (
+	"context"
+	"net/http"
+)
+
+// The headers Hasura reads the admin secret and the role of requests from.
+const (
+	HasuraAdminSecretHeader = "X-Hasura-Admin-Secret"
+	HasuraRoleHeader        = "X-Hasura-Role"
+)
+
+type hasuraRoleKey struct{}
+
+// WithHasuraRole returns a context whose operations are sent with role in the X-Hasura-Role header
+// by the interceptor of NewHasuraInterceptor, replacing its default role.
+func WithHasuraRole(ctx context.Context, role string) context.Context {
+	return context.WithValue(ctx, hasuraRoleKey{}, role)
+}
+
+// NewHasuraInterceptor returns an interceptor for Hasura backends that sends adminSecret in the
+// X-Hasura-Admin-Secret header and the role of WithHasuraRole, or defaultRole, in the X-Hasura-Role header
+// of every request. Empty values are not sent.
+func NewHasuraInterceptor(adminSecret, defaultRole string) RequestInterceptor {
+	return func(ctx context.Context, req *http.Request, gqlInfo *GQLRequestInfo, res any, next RequestInterceptorFunc) error {
+		if adminSecret != "" {
+			req.Header.Set(HasuraAdminSecretHeader, adminSecret)
+		}
+
+		role := defaultRole
+		if r, ok := ctx.Value(hasuraRoleKey{}).(string); ok {
+			role = r
+		}
+
+		if role != "" {
+			req.Header.Set(HasuraRoleHeader, role)
+		}
+
+		return next(ctx, req, gqlInfo, res)
+	}
+}
@@ -0,0 +1,60 @@
+package clientv2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHasuraInterceptor(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu      sync.Mutex
+		headers []http.Header
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		headers = append(headers, r.Header.Clone())
+		mu.Unlock()
+
+		_, _ = w.Write([]byte(validData))
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(http.DefaultClient, server.URL, nil, NewHasuraInterceptor("secret", "user"))
+
+	var res fakeRes
+	require.NoError(t, c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil))
+	require.NoError(t, c.Post(WithHasuraRole(context.Background(), "editor"), "GetSomething", "query GetSomething { something }", &res, nil))
+
+	require.Len(t, headers, 2)
+	require.Equal(t, "secret", headers[0].Get(HasuraAdminSecretHeader))
+	require.Equal(t, "user", headers[0].Get(HasuraRoleHeader))
+	require.Equal(t, "secret", headers[1].Get(HasuraAdminSecretHeader))
+	require.Equal(t, "editor", headers[1].Get(HasuraRoleHeader))
+}
+
+func TestNewHasuraInterceptor_withoutValues(t *testing.T) {
+	t.Parallel()
+
+	var header http.Header
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		_, _ = w.Write([]byte(validData))
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(http.DefaultClient, server.URL, nil, NewHasuraInterceptor("", ""))
+
+	var res fakeRes
+	require.NoError(t, c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil))
+	require.NotContains(t, header, HasuraAdminSecretHeader)
+	require.NotContains(t, header, HasuraRoleHeader)
+}
@@ -0,0 +1,107 @@
+package clientv2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"time"
+)
+
+type hedgeResult struct {
+	gqlInfo *GQLRequestInfo
+	res     reflect.Value
+	err     error
+	// meta and bodies are the sinks of the attempt, copied to those of the caller when it wins.
+	meta   *ResponseMetadata
+	bodies *RawBodies
+}
+
+// attemptContext returns ctx with sinks of its own for attempt in place of the ResponseMetadata and RawBodies
+// captured by ctx, so attempts running at once do not write to the sinks of the caller.
+func attemptContext(ctx context.Context, attempt *hedgeResult) context.Context {
+	if _, ok := ctx.Value(responseMetadataKey{}).(*ResponseMetadata); ok {
+		ctx, attempt.meta = WithResponseMetadata(ctx)
+	}
+
+	if _, ok := ctx.Value(rawBodiesKey{}).(*RawBodies); ok {
+		attempt.bodies = &RawBodies{}
+		ctx = WithRawBodies(ctx, attempt.bodies)
+	}
+
+	return ctx
+}
+
+// commit copies the sinks of attempt to those captured by ctx.
+func (attempt *hedgeResult) commit(ctx context.Context) {
+	if captured, ok := ctx.Value(responseMetadataKey{}).(*ResponseMetadata); ok {
+		*captured = *attempt.meta
+	}
+
+	if sink, ok := ctx.Value(rawBodiesKey{}).(*RawBodies); ok {
+		*sink = *attempt.bodies
+	}
+}
+
+// NewHedgingInterceptor returns an interceptor that sends a second, identical request when a query
+// operation has not completed after delay. The first successful response wins and the other request
+// is canceled. Mutations are never hedged because they are not idempotent. Each attempt has its own
+// ResponseMetadata and RawBodies, and those of the winning attempt are copied to the ones of the context.
+func NewHedgingInterceptor(delay time.Duration) RequestInterceptor {
+	return func(ctx context.Context, req *http.Request, gqlInfo *GQLRequestInfo, res any, next RequestInterceptorFunc) error {
+		resValue := reflect.ValueOf(res)
+		if !isQueryOperation(gqlInfo) || req.GetBody == nil || resValue.Kind() != reflect.Pointer || resValue.IsNil() {
+			return next(ctx, req, gqlInfo, res)
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		results := make(chan *hedgeResult, 2)
+		send := func(attemptReq *http.Request) {
+			attempt := &hedgeResult{
+				gqlInfo: &GQLRequestInfo{Request: gqlInfo.Request},
+				res:     reflect.New(resValue.Elem().Type()),
+			}
+			attemptCtx := attemptContext(ctx, attempt)
+			attempt.err = next(attemptCtx, attemptReq.WithContext(attemptCtx), attempt.gqlInfo, attempt.res.Interface())
+			results <- attempt
+		}
+
+		go send(req)
+
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		pending := 1
+
+		var result *hedgeResult
+
+		for result == nil {
+			select {
+			case attempt := <-results:
+				pending--
+				if attempt.err == nil || pending == 0 {
+					result = attempt
+				}
+			case <-timer.C:
+				body, err := req.GetBody()
+				if err != nil {
+					return fmt.Errorf("failed to rewind request body: %w", err)
+				}
+
+				hedged := req.Clone(ctx)
+				hedged.Body = body
+				pending++
+
+				go send(hedged)
+			}
+		}
+
+		resValue.Elem().Set(result.res.Elem())
+		gqlInfo.Response = result.gqlInfo.Response
+		result.commit(ctx)
+
+		return result.err
+	}
+}
@@ -0,0 +1,98 @@
+package clientv2
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHedgingInterceptor(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			// the first request hangs until it is canceled by the hedged one,
+			// the body must be consumed for the server to notice the cancellation
+			_, _ = io.Copy(io.Discard, r.Body)
+			<-r.Context().Done()
+
+			return
+		}
+
+		_, _ = w.Write([]byte(validData))
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(http.DefaultClient, server.URL, nil, NewHedgingInterceptor(10*time.Millisecond))
+	ctx, meta := WithResponseMetadata(context.Background())
+
+	var res fakeRes
+	require.NoError(t, c.Post(ctx, "GetSomething", "query GetSomething { something }", &res, nil))
+	require.Equal(t, "some data", res.Something)
+	require.Equal(t, http.StatusOK, meta.StatusCode)
+	require.Equal(t, int32(2), requests.Load())
+}
+
+func TestNewHedgingInterceptor_mutation(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		time.Sleep(50 * time.Millisecond)
+		_, _ = w.Write([]byte(validData))
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(http.DefaultClient, server.URL, nil, NewHedgingInterceptor(time.Millisecond))
+
+	var res fakeRes
+	require.NoError(t, c.Post(context.Background(), "DoSomething", "mutation DoSomething { something }", &res, nil))
+	require.Equal(t, int32(1), requests.Load())
+}
+
+func TestNewHedgingInterceptor_overlappingAttempts(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		attempt := "first"
+		if requests.Add(1) > 1 {
+			attempt = "second"
+		}
+
+		w.Header().Set("X-Attempt", attempt)
+
+		// the first attempt responds while the hedged one is in flight
+		if attempt == "first" {
+			w.WriteHeader(http.StatusOK)
+			w.(http.Flusher).Flush()
+			time.Sleep(11 * time.Millisecond)
+		}
+
+		_, _ = w.Write([]byte(`{"data": {"something": "` + attempt + `"}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(http.DefaultClient, server.URL, nil, NewHedgingInterceptor(10*time.Millisecond))
+
+	var bodies RawBodies
+
+	ctx, meta := WithResponseMetadata(WithRawBodies(context.Background(), &bodies))
+
+	var res fakeRes
+	require.NoError(t, c.Post(ctx, "GetSomething", "query GetSomething { something }", &res, nil))
+	require.Equal(t, res.Something, meta.Header.Get("X-Attempt"), "the metadata must be the one of the winning attempt")
+	require.Contains(t, string(bodies.Response), res.Something, "the bodies must be the ones of the winning attempt")
+	require.Contains(t, string(bodies.Request), "GetSomething")
+}
@@ -0,0 +1,34 @@
+package clientv2
+
+import "context"
+
+// OperationHook runs around every generated operation method, so cross-cutting concerns
+// such as auditing or caching can be implemented once for the whole client.
+// Call sites are only generated when generate.operationHooks is enabled.
+type OperationHook interface {
+	// BeforeOperation is called before the request is sent. The returned context is used
+	// for the request and passed to AfterOperation. Returning an error aborts the operation.
+	BeforeOperation(ctx context.Context, operationName string, vars map[string]any) (context.Context, error)
+	// AfterOperation is called with the decoded response and the error of the request.
+	// The returned error replaces the error of the operation. Stream methods, whose items
+	// are decoded one at a time, pass a nil response once the stream ends or is stopped.
+	AfterOperation(ctx context.Context, operationName string, vars map[string]any, res any, err error) error
+}
+
+// BeforeOperation calls the configured OperationHook, if any.
+func (c *Client) BeforeOperation(ctx context.Context, operationName string, vars map[string]any) (context.Context, error) {
+	if c.OperationHook == nil {
+		return ctx, nil
+	}
+
+	return c.OperationHook.BeforeOperation(ctx, operationName, vars)
+}
+
+// AfterOperation calls the configured OperationHook, if any.
+func (c *Client) AfterOperation(ctx context.Context, operationName string, vars map[string]any, res any, err error) error {
+	if c.OperationHook == nil {
+		return err
+	}
+
+	return c.OperationHook.AfterOperation(ctx, operationName, vars, res, err)
+}
@@ -0,0 +1,64 @@
+package clientv2
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type hookContextKey struct{}
+
+type recordingHook struct {
+	calls []string
+}
+
+func (h *recordingHook) BeforeOperation(ctx context.Context, operationName string, vars map[string]any) (context.Context, error) {
+	h.calls = append(h.calls, "before "+operationName)
+
+	return context.WithValue(ctx, hookContextKey{}, vars["id"]), nil
+}
+
+func (h *recordingHook) AfterOperation(ctx context.Context, operationName string, _ map[string]any, res any, err error) error {
+	h.calls = append(h.calls, "after "+operationName+" "+ctx.Value(hookContextKey{}).(string)+" "+*res.(*string))
+
+	if err != nil {
+		return errors.New("hooked: " + err.Error())
+	}
+
+	return nil
+}
+
+func TestOperationHook(t *testing.T) {
+	t.Parallel()
+
+	t.Run("without hook", func(t *testing.T) {
+		t.Parallel()
+
+		c := NewClient(nil, "", nil)
+		ctx := context.Background()
+
+		gotCtx, err := c.BeforeOperation(ctx, "GetUser", nil)
+		require.NoError(t, err)
+		require.Equal(t, ctx, gotCtx)
+
+		outputErr := errors.New("some error")
+		require.Equal(t, outputErr, c.AfterOperation(ctx, "GetUser", nil, nil, outputErr))
+	})
+
+	t.Run("with hook", func(t *testing.T) {
+		t.Parallel()
+
+		hook := &recordingHook{}
+		c := NewClient(nil, "", &Options{OperationHook: hook})
+		vars := map[string]any{"id": "1"}
+
+		ctx, err := c.BeforeOperation(context.Background(), "GetUser", vars)
+		require.NoError(t, err)
+
+		res := "data"
+		require.EqualError(t, c.AfterOperation(ctx, "GetUser", vars, &res, errors.New("some error")), "hooked: some error")
+		require.Equal(t, []string{"before GetUser", "after GetUser 1 data"}, hook.calls)
+	})
+}
@@ -0,0 +1,28 @@
+package clientv2
+
+import (
+	"fmt"
+	"io"
+)
+
+// WriteMultipartRequest writes r to w as a multipart request of the GraphQL multipart request specification
+// (https://github.com/jaydenseric/graphql-multipart-request-spec), the body Post sends for variables holding
+// graphql.Upload values, for transports building requests themselves. The operations part is r with its uploads
+// set to null, the map part tells the variables they belong to, and each upload follows in its own part.
+// It returns the Content-Type of the body, with its boundary. The variables of r are not modified.
+func WriteMultipartRequest(w io.Writer, r *Request) (string, error) {
+	multipartFilesGroups, mapping, vars := parseMultipartFiles(r.Variables)
+
+	operations := *r
+	operations.Variables = vars
+
+	contentType, err := prepareMultipartFormBody(w, []FormField{
+		{Name: "operations", Value: &operations},
+		{Name: "map", Value: mapping},
+	}, multipartFilesGroups)
+	if err != nil {
+		return "", fmt.Errorf("write multipart request: %w", err)
+	}
+
+	return contentType, nil
+}
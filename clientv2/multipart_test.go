@@ -0,0 +1,66 @@
+package clientv2
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteMultipartRequest(t *testing.T) {
+	t.Parallel()
+
+	vars := map[string]any{
+		"id":   "1",
+		"file": graphql.Upload{Filename: "a.txt", File: strings.NewReader("a")},
+		"files": []*graphql.Upload{
+			{Filename: "b.txt", File: strings.NewReader("b")},
+		},
+	}
+
+	body := new(bytes.Buffer)
+	contentType, err := WriteMultipartRequest(body, &Request{
+		Query:         "mutation Upload($id: ID!, $file: Upload!, $files: [Upload!]!) { upload(id: $id, file: $file, files: $files) }",
+		Variables:     vars,
+		OperationName: "Upload",
+	})
+	require.NoError(t, err)
+	require.Contains(t, vars, "file", "the variables of the request must not be modified")
+	require.IsType(t, graphql.Upload{}, vars["file"])
+
+	req, err := http.NewRequest(http.MethodPost, "/", body)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", contentType)
+	require.NoError(t, req.ParseMultipartForm(1<<20))
+
+	require.JSONEq(t, `{
+		"query": "mutation Upload($id: ID!, $file: Upload!, $files: [Upload!]!) { upload(id: $id, file: $file, files: $files) }",
+		"variables": {"id": "1", "file": null, "files": [{}]},
+		"operationName": "Upload"
+	}`, req.FormValue("operations"))
+
+	files := map[string]string{}
+	for field, headers := range req.MultipartForm.File {
+		f, err := headers[0].Open()
+		require.NoError(t, err)
+
+		content, err := io.ReadAll(f)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+
+		files[field] = headers[0].Filename + ":" + string(content)
+	}
+
+	// the indexes of the files depend on the order of the variables
+	if files["0"] == "a.txt:a" {
+		require.JSONEq(t, `{"0": ["variables.file"], "1": ["variables.files.0"]}`, req.FormValue("map"))
+		require.Equal(t, map[string]string{"0": "a.txt:a", "1": "b.txt:b"}, files)
+	} else {
+		require.JSONEq(t, `{"0": ["variables.files.0"], "1": ["variables.file"]}`, req.FormValue("map"))
+		require.Equal(t, map[string]string{"0": "b.txt:b", "1": "a.txt:a"}, files)
+	}
+}
@@ -0,0 +1,443 @@
+package clientv2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// ErrCacheMiss is returned for operations sent with CacheOnly when the cache cannot answer them.
+var ErrCacheMiss = errors.New("normalized cache miss")
+
+// CachePolicy selects how an operation uses a NormalizedCache.
+type CachePolicy int
+
+const (
+	// CacheFirst answers queries from the cache when it holds every field they select,
+	// and sends them otherwise. It is the default policy.
+	CacheFirst CachePolicy = iota
+	// NetworkOnly always sends queries, and stores their results.
+	NetworkOnly
+	// CacheOnly answers queries from the cache, failing with ErrCacheMiss instead of sending them.
+	CacheOnly
+	// NoCache sends queries without reading nor storing their results.
+	NoCache
+)
+
+type (
+	cachePolicyKey        struct{}
+	cacheInvalidationKey  struct{}
+	optimisticResponseKey struct{}
+)
+
+// cacheRef is the key replacing entities in normalized values.
+const cacheRef = "__ref"
+
+// WithCachePolicy returns a context sending the operation it is passed to with policy.
+func WithCachePolicy(ctx context.Context, policy CachePolicy) context.Context {
+	return context.WithValue(ctx, cachePolicyKey{}, policy)
+}
+
+// WithCacheInvalidation returns a context that removes the entities of keys, built by EntityKey,
+// from the cache once the operation it is passed to succeeds, typically a mutation deleting them.
+func WithCacheInvalidation(ctx context.Context, keys ...string) context.Context {
+	return context.WithValue(ctx, cacheInvalidationKey{}, keys)
+}
+
+// WithOptimisticResponse returns a context that writes response, a value of the response type of the
+// mutation it is passed to, to the entities of the cache until the mutation completes, so queries
+// answered from the cache meanwhile read it. The entities it patches are rolled back when the mutation
+// fails, and replaced by the response of the server when it succeeds. response is encoded with
+// encoding/json, so fields left out by omitempty are not patched.
+func WithOptimisticResponse(ctx context.Context, response any) context.Context {
+	return context.WithValue(ctx, optimisticResponseKey{}, response)
+}
+
+// EntityKey returns the key of the entity of a GraphQL type and id in a NormalizedCache.
+func EntityKey(typename, id string) string {
+	return typename + ":" + id
+}
+
+// cacheSelection lists the response keys read from an object, with the selections of the values they hold.
+// Keys selected on the members of a union or interface only are prefixed by "<__typename>.".
+type cacheSelection struct {
+	fields map[string]*cacheSelection
+}
+
+// optimisticLayer holds the entities patched by the optimistic response of a mutation in flight.
+type optimisticLayer struct {
+	entities map[string]map[string]any
+}
+
+type cachedResult struct {
+	data      any
+	selection *cacheSelection
+}
+
+// NormalizedCache stores the results of operations normalized by entity: every object of a response
+// selecting __typename and id is stored once under EntityKey, and merged with the fields other
+// operations select on it. Queries are answered from the entities, so they see the changes
+// made by the responses of later queries and mutations:
+//
+//	cache := clientv2.NewNormalizedCache()
+//	client := gen.NewClient(http.DefaultClient, url, nil, cache.Interceptor())
+//
+// Objects are normalized by response key, so fields selected with different arguments must use
+// different aliases. Mutations are always sent, their responses update the entities they return
+// and WithCacheInvalidation removes the others they change. WithOptimisticResponse updates the
+// entities a mutation is expected to return before it completes.
+type NormalizedCache struct {
+	mu       sync.Mutex
+	entities map[string]map[string]any
+	results  map[string]*cachedResult
+	// layers are applied over entities in order.
+	layers []*optimisticLayer
+}
+
+// NewNormalizedCache returns an empty NormalizedCache.
+func NewNormalizedCache() *NormalizedCache {
+	return &NormalizedCache{
+		entities: make(map[string]map[string]any),
+		results:  make(map[string]*cachedResult),
+	}
+}
+
+// Entity returns a copy of the fields stored for the entity of key, entities they hold being
+// replaced by {"__ref": key}. Optimistic responses of mutations in flight are applied.
+func (c *NormalizedCache) Entity(key string) (map[string]any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entity, ok := c.entity(key)
+	if !ok {
+		return nil, false
+	}
+
+	return maps.Clone(entity), true
+}
+
+// entity returns the fields of the entity of key patched by the optimistic layers, which must not be modified.
+func (c *NormalizedCache) entity(key string) (map[string]any, bool) {
+	entity, ok := c.entities[key]
+
+	for _, layer := range c.layers {
+		patch, patched := layer.entities[key]
+		if !patched {
+			continue
+		}
+
+		if !ok {
+			entity, ok = patch, true
+
+			continue
+		}
+
+		entity = maps.Clone(entity)
+		maps.Copy(entity, patch)
+	}
+
+	return entity, ok
+}
+
+// Invalidate removes the entities of keys, so queries reading them are sent again.
+func (c *NormalizedCache) Invalidate(keys ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		delete(c.entities, key)
+	}
+}
+
+// Clear removes every entity and result.
+func (c *NormalizedCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entities = make(map[string]map[string]any)
+	c.results = make(map[string]*cachedResult)
+}
+
+// Interceptor returns the interceptor reading and writing the cache. Responses are normalized from
+// their raw bodies, so the cache does nothing for clients whose CustomDo does not record them.
+// Results served from the cache are decoded with the DecodeOptions and ScalarCodecs of the client, and
+// reported in the response metadata as a 200 response without headers.
+func (c *NormalizedCache) Interceptor() RequestInterceptor {
+	return func(ctx context.Context, req *http.Request, gqlInfo *GQLRequestInfo, res any, next RequestInterceptorFunc) error {
+		if gqlInfo == nil || gqlInfo.Request == nil {
+			return next(ctx, req, gqlInfo, res)
+		}
+
+		policy, _ := ctx.Value(cachePolicyKey{}).(CachePolicy)
+		if policy == NoCache {
+			return next(ctx, req, gqlInfo, res)
+		}
+
+		var key string
+
+		if isQueryOperation(gqlInfo) {
+			var err error
+
+			key, err = CacheKey(ctx, gqlInfo.Request.OperationName, gqlInfo.Request.Query, gqlInfo.Request.Variables)
+			if err != nil {
+				return next(ctx, req, gqlInfo, res)
+			}
+
+			if policy == CacheFirst || policy == CacheOnly {
+				data, ok := c.read(key)
+				if ok {
+					recordResponseMetadata(ctx, gqlInfo, &http.Response{StatusCode: http.StatusOK, Header: http.Header{}})
+
+					return decodeData(data, res, contextDecodeOptions(ctx)...)
+				}
+
+				if policy == CacheOnly {
+					return fmt.Errorf("%s: %w", gqlInfo.Request.OperationName, ErrCacheMiss)
+				}
+			}
+		}
+
+		// only mutations have optimistic responses, queries have a key
+		if optimistic := ctx.Value(optimisticResponseKey{}); optimistic != nil && key == "" {
+			layer, err := c.addLayer(optimistic)
+			if err != nil {
+				return fmt.Errorf("optimistic response: %w", err)
+			}
+
+			defer c.removeLayer(layer)
+		}
+
+		sink, ok := ctx.Value(rawBodiesKey{}).(*RawBodies)
+		if !ok {
+			sink = &RawBodies{}
+			ctx = WithRawBodies(ctx, sink)
+		}
+
+		if err := next(ctx, req, gqlInfo, res); err != nil {
+			return err
+		}
+
+		c.write(key, sink.Response)
+
+		if keys, ok := ctx.Value(cacheInvalidationKey{}).([]string); ok {
+			c.Invalidate(keys...)
+		}
+
+		return nil
+	}
+}
+
+// read returns the data of the result of key built from the current entities, or false when
+// the result is unknown or an entity it reads is missing a field.
+func (c *NormalizedCache) read(key string) (json.RawMessage, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result, ok := c.results[key]
+	if !ok {
+		return nil, false
+	}
+
+	data, ok := c.denormalize(result.data, result.selection)
+	if !ok {
+		return nil, false
+	}
+
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, false
+	}
+
+	return encoded, true
+}
+
+// addLayer normalizes response into a new optimistic layer.
+func (c *NormalizedCache) addLayer(response any) (*optimisticLayer, error) {
+	encoded, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+
+	var data any
+
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&data); err != nil {
+		return nil, err
+	}
+
+	layer := &optimisticLayer{entities: make(map[string]map[string]any)}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.normalize(data, &cacheSelection{}, layer.entities)
+	c.layers = append(c.layers, layer)
+
+	return layer, nil
+}
+
+// removeLayer rolls back the entities patched by layer.
+func (c *NormalizedCache) removeLayer(layer *optimisticLayer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.layers = slices.DeleteFunc(c.layers, func(l *optimisticLayer) bool {
+		return l == layer
+	})
+}
+
+// write normalizes the data of a response body into the entities, and stores the result under key
+// unless it is empty. Responses with errors are ignored.
+func (c *NormalizedCache) write(key string, body []byte) {
+	var response struct {
+		Data   any             `json:"data"`
+		Errors json.RawMessage `json:"errors"`
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&response); err != nil || response.Data == nil || len(response.Errors) > 0 && string(response.Errors) != "null" {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	selection := &cacheSelection{}
+	data := c.normalize(response.Data, selection, c.entities)
+
+	if key != "" {
+		c.results[key] = &cachedResult{data: data, selection: selection}
+	}
+}
+
+// normalize merges the entities of value into entities, returning value with them replaced by
+// references, and records the keys it selects into selection.
+func (c *NormalizedCache) normalize(value any, selection *cacheSelection, entities map[string]map[string]any) any {
+	switch value := value.(type) {
+	case []any:
+		normalized := make([]any, len(value))
+		for i, item := range value {
+			normalized[i] = c.normalize(item, selection, entities)
+		}
+
+		return normalized
+	case map[string]any:
+		typename, _ := value["__typename"].(string)
+
+		if selection.fields == nil {
+			selection.fields = make(map[string]*cacheSelection, len(value))
+		}
+
+		normalized := make(map[string]any, len(value))
+
+		for field, fieldValue := range value {
+			selected := field
+			if typename != "" && field != "__typename" {
+				selected = typename + "." + field
+			}
+
+			fieldSelection, ok := selection.fields[selected]
+			if !ok {
+				fieldSelection = &cacheSelection{}
+				selection.fields[selected] = fieldSelection
+			}
+
+			normalized[field] = c.normalize(fieldValue, fieldSelection, entities)
+		}
+
+		key, ok := entityKeyOf(typename, value["id"])
+		if !ok {
+			return normalized
+		}
+
+		entity, ok := entities[key]
+		if !ok {
+			entity = make(map[string]any, len(normalized))
+			entities[key] = entity
+		}
+
+		for field, fieldValue := range normalized {
+			entity[field] = fieldValue
+		}
+
+		return map[string]any{cacheRef: key}
+	default:
+		return value
+	}
+}
+
+// denormalize returns the keys of selection read from value, references being replaced by their entities.
+func (c *NormalizedCache) denormalize(value any, selection *cacheSelection) (any, bool) {
+	switch value := value.(type) {
+	case []any:
+		denormalized := make([]any, len(value))
+		for i, item := range value {
+			var ok bool
+			if denormalized[i], ok = c.denormalize(item, selection); !ok {
+				return nil, false
+			}
+		}
+
+		return denormalized, true
+	case map[string]any:
+		if key, ok := value[cacheRef].(string); ok && len(value) == 1 {
+			if value, ok = c.entity(key); !ok {
+				return nil, false
+			}
+		}
+
+		typename, _ := value["__typename"].(string)
+		denormalized := make(map[string]any, len(selection.fields))
+
+		for selected, fieldSelection := range selection.fields {
+			field := selected
+			if prefix, name, found := strings.Cut(selected, "."); found {
+				if prefix != typename {
+					continue
+				}
+
+				field = name
+			}
+
+			fieldValue, ok := value[field]
+			if !ok {
+				return nil, false
+			}
+
+			if denormalized[field], ok = c.denormalize(fieldValue, fieldSelection); !ok {
+				return nil, false
+			}
+		}
+
+		return denormalized, true
+	default:
+		return value, true
+	}
+}
+
+// entityKeyOf returns the EntityKey of an object, which needs both a __typename and an id.
+func entityKeyOf(typename string, id any) (string, bool) {
+	if typename == "" {
+		return "", false
+	}
+
+	switch id := id.(type) {
+	case string:
+		return EntityKey(typename, id), true
+	case json.Number:
+		return EntityKey(typename, id.String()), true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,305 @@
+package clientv2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type cachedUser struct {
+	Typename string  `json:"__typename" graphql:"__typename"`
+	ID       string  `json:"id" graphql:"id"`
+	Name     string  `json:"name" graphql:"name"`
+	Email    *string `json:"email" graphql:"email"`
+}
+
+type cachedUserRes struct {
+	User *cachedUser `json:"user" graphql:"user"`
+}
+
+type cachedUsersRes struct {
+	Users []*cachedUser `json:"users" graphql:"users"`
+}
+
+const (
+	getUserQuery    = "query GetUser($id: ID!) { user(id: $id) { __typename id name email } }"
+	listUsersQuery  = "query ListUsers { users { __typename id name } }"
+	renameUserQuery = "mutation RenameUser($id: ID!, $name: String!) { user: renameUser(id: $id, name: $name) { __typename id name } }"
+)
+
+func newNormalizedCacheServer(t *testing.T, requests *atomic.Int32) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		var req Request
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		switch req.OperationName {
+		case "GetUser":
+			_, _ = w.Write([]byte(`{"data":{"user":{"__typename":"User","id":"1","name":"Ada","email":null}}}`))
+		case "ListUsers":
+			_, _ = w.Write([]byte(`{"data":{"users":[{"__typename":"User","id":"1","name":"Ada"},{"__typename":"User","id":"2","name":"Alan"}]}}`))
+		case "RenameUser":
+			_, _ = w.Write([]byte(`{"data":{"user":{"__typename":"User","id":"1","name":"` + req.Variables["name"].(string) + `"}}}`))
+		case "Failing":
+			_, _ = w.Write([]byte(`{"errors":[{"message":"failed"}],"data":{"user":null}}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return NewClient(http.DefaultClient, server.URL, nil, NewNormalizedCache().Interceptor())
+}
+
+func TestNormalizedCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("serves queries from the cache", func(t *testing.T) {
+		t.Parallel()
+
+		var requests atomic.Int32
+
+		c := newNormalizedCacheServer(t, &requests)
+		vars := map[string]any{"id": "1"}
+
+		var first, second cachedUserRes
+		require.NoError(t, c.Post(context.Background(), "GetUser", getUserQuery, &first, vars))
+		require.NoError(t, c.Post(context.Background(), "GetUser", getUserQuery, &second, vars))
+		require.Equal(t, first, second)
+		require.Equal(t, "Ada", second.User.Name)
+		require.Equal(t, int32(1), requests.Load())
+
+		var other cachedUserRes
+		require.NoError(t, c.Post(context.Background(), "GetUser", getUserQuery, &other, map[string]any{"id": "2"}))
+		require.Equal(t, int32(2), requests.Load())
+	})
+
+	t.Run("mutations update the entities of cached queries", func(t *testing.T) {
+		t.Parallel()
+
+		var requests atomic.Int32
+
+		c := newNormalizedCacheServer(t, &requests)
+
+		var users cachedUsersRes
+		require.NoError(t, c.Post(context.Background(), "ListUsers", listUsersQuery, &users, nil))
+
+		var renamed cachedUserRes
+		require.NoError(t, c.Post(context.Background(), "RenameUser", renameUserQuery, &renamed, map[string]any{"id": "1", "name": "Ada L."}))
+		require.NoError(t, c.Post(context.Background(), "RenameUser", renameUserQuery, &renamed, map[string]any{"id": "1", "name": "Ada L."}))
+		require.Equal(t, int32(3), requests.Load())
+
+		require.NoError(t, c.Post(context.Background(), "ListUsers", listUsersQuery, &users, nil))
+		require.Equal(t, int32(3), requests.Load())
+		require.Equal(t, []*cachedUser{{Typename: "User", ID: "1", Name: "Ada L."}, {Typename: "User", ID: "2", Name: "Alan"}}, users.Users)
+	})
+
+	t.Run("queries selecting fields missing from the entities are sent", func(t *testing.T) {
+		t.Parallel()
+
+		var requests atomic.Int32
+
+		c := newNormalizedCacheServer(t, &requests)
+
+		var users cachedUsersRes
+		require.NoError(t, c.Post(context.Background(), "ListUsers", listUsersQuery, &users, nil))
+
+		var user cachedUserRes
+		require.ErrorIs(t, c.Post(WithCachePolicy(context.Background(), CacheOnly), "GetUser", getUserQuery, &user, map[string]any{"id": "1"}), ErrCacheMiss)
+		require.NoError(t, c.Post(context.Background(), "GetUser", getUserQuery, &user, map[string]any{"id": "1"}))
+		require.Equal(t, int32(2), requests.Load())
+	})
+
+	t.Run("invalidation", func(t *testing.T) {
+		t.Parallel()
+
+		var requests atomic.Int32
+
+		c := newNormalizedCacheServer(t, &requests)
+
+		var users cachedUsersRes
+		require.NoError(t, c.Post(context.Background(), "ListUsers", listUsersQuery, &users, nil))
+
+		var renamed cachedUserRes
+		ctx := WithCacheInvalidation(context.Background(), EntityKey("User", "2"))
+		require.NoError(t, c.Post(ctx, "RenameUser", renameUserQuery, &renamed, map[string]any{"id": "1", "name": "Ada"}))
+
+		require.NoError(t, c.Post(context.Background(), "ListUsers", listUsersQuery, &users, nil))
+		require.Equal(t, int32(3), requests.Load())
+	})
+
+	t.Run("policies", func(t *testing.T) {
+		t.Parallel()
+
+		var requests atomic.Int32
+
+		c := newNormalizedCacheServer(t, &requests)
+		vars := map[string]any{"id": "1"}
+
+		var user cachedUserRes
+		require.NoError(t, c.Post(WithCachePolicy(context.Background(), NoCache), "GetUser", getUserQuery, &user, vars))
+		require.NoError(t, c.Post(WithCachePolicy(context.Background(), NetworkOnly), "GetUser", getUserQuery, &user, vars))
+		require.NoError(t, c.Post(WithCachePolicy(context.Background(), NetworkOnly), "GetUser", getUserQuery, &user, vars))
+		require.NoError(t, c.Post(WithCachePolicy(context.Background(), CacheOnly), "GetUser", getUserQuery, &user, vars))
+		require.Equal(t, int32(3), requests.Load())
+	})
+
+	t.Run("responses with errors are not cached", func(t *testing.T) {
+		t.Parallel()
+
+		var requests atomic.Int32
+
+		c := newNormalizedCacheServer(t, &requests)
+
+		var user cachedUserRes
+		require.Error(t, c.Post(context.Background(), "Failing", getUserQuery, &user, nil))
+		require.Error(t, c.Post(context.Background(), "Failing", getUserQuery, &user, nil))
+		require.Equal(t, int32(2), requests.Load())
+	})
+}
+
+func TestNormalizedCache_unions(t *testing.T) {
+	t.Parallel()
+
+	cache := NewNormalizedCache()
+	cache.write("Search", []byte(`{"data":{"search":[
+		{"__typename":"User","id":"1","name":"Ada"},
+		{"__typename":"Post","id":"1","title":"Notes"},
+		{"__typename":"Tag","label":"math"}
+	]}}`))
+
+	data, ok := cache.read("Search")
+	require.True(t, ok)
+	require.JSONEq(t, `{"search":[
+		{"__typename":"User","id":"1","name":"Ada"},
+		{"__typename":"Post","id":"1","title":"Notes"},
+		{"__typename":"Tag","label":"math"}
+	]}`, string(data))
+
+	user, ok := cache.Entity(EntityKey("User", "1"))
+	require.True(t, ok)
+	require.Equal(t, map[string]any{"__typename": "User", "id": "1", "name": "Ada"}, user)
+
+	cache.Invalidate(EntityKey("Post", "1"))
+
+	_, ok = cache.read("Search")
+	require.False(t, ok)
+
+	cache.Clear()
+
+	_, ok = cache.Entity(EntityKey("User", "1"))
+	require.False(t, ok)
+}
+
+func TestNormalizedCache_optimisticResponse(t *testing.T) {
+	t.Parallel()
+
+	for _, fail := range []bool{false, true} {
+		name := "success"
+		if fail {
+			name = "failure"
+		}
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			arrived, release := make(chan struct{}), make(chan struct{})
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var req Request
+				_ = json.NewDecoder(r.Body).Decode(&req)
+
+				if req.OperationName == "ListUsers" {
+					_, _ = w.Write([]byte(`{"data":{"users":[{"__typename":"User","id":"1","name":"Ada"}]}}`))
+
+					return
+				}
+
+				close(arrived)
+				<-release
+
+				if fail {
+					_, _ = w.Write([]byte(`{"errors":[{"message":"failed"}],"data":null}`))
+
+					return
+				}
+
+				_, _ = w.Write([]byte(`{"data":{"user":{"__typename":"User","id":"1","name":"Ada Lovelace"}}}`))
+			}))
+			t.Cleanup(server.Close)
+
+			c := NewClient(http.DefaultClient, server.URL, nil, NewNormalizedCache().Interceptor())
+			cacheOnly := WithCachePolicy(context.Background(), CacheOnly)
+
+			var users cachedUsersRes
+			require.NoError(t, c.Post(context.Background(), "ListUsers", listUsersQuery, &users, nil))
+
+			done := make(chan error)
+
+			go func() {
+				optimistic := &cachedUserRes{User: &cachedUser{Typename: "User", ID: "1", Name: "Ada L."}}
+				ctx := WithOptimisticResponse(context.Background(), optimistic)
+
+				var renamed cachedUserRes
+				done <- c.Post(ctx, "RenameUser", renameUserQuery, &renamed, map[string]any{"id": "1", "name": "Ada L."})
+			}()
+
+			<-arrived
+			require.NoError(t, c.Post(cacheOnly, "ListUsers", listUsersQuery, &users, nil))
+			require.Equal(t, "Ada L.", users.Users[0].Name)
+
+			close(release)
+
+			err := <-done
+			require.NoError(t, c.Post(cacheOnly, "ListUsers", listUsersQuery, &users, nil))
+
+			if fail {
+				require.Error(t, err)
+				require.Equal(t, "Ada", users.Users[0].Name)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "Ada Lovelace", users.Users[0].Name)
+			}
+		})
+	}
+}
+
+func TestNormalizedCache_decodeOptions(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		_, _ = w.Write([]byte(`{"data":{"order":{"id":"AB-CD","related":["EF-01",null]}}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(http.DefaultClient, server.URL, &Options{
+		ScalarCodecs: map[string]ScalarCodec{"UUID": scalarsIDCodec},
+	}, NewNormalizedCache().Interceptor())
+
+	const query = "query Order { order { id related } }"
+
+	var first scalarsRes
+	require.NoError(t, c.Post(context.Background(), "Order", query, &first, nil))
+
+	ctx, meta := WithResponseMetadata(context.Background())
+
+	var cached scalarsRes
+	require.NoError(t, c.Post(ctx, "Order", query, &cached, nil))
+	require.Equal(t, int32(1), requests.Load())
+	require.Equal(t, scalarsID{"ab", "cd"}, cached.Order.ID)
+	require.Equal(t, first, cached)
+	require.Equal(t, http.StatusOK, meta.StatusCode)
+}
@@ -0,0 +1,52 @@
+package clientv2
+
+import (
+	"context"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// OperationNamePolicy selects how the operationName of requests is filled.
+type OperationNamePolicy int
+
+const (
+	// OperationNameGiven sends the operation name passed to the client, which generated clients pass the name
+	// of their operation as. This is the default.
+	OperationNameGiven OperationNamePolicy = iota
+	// OperationNameFromDocument sends the name the document declares for its operation, and none when the
+	// operation is anonymous, for servers rejecting names that do not match the document. Documents with
+	// several operations keep the name given.
+	OperationNameFromDocument
+	// OperationNameOmitted never sends an operation name.
+	OperationNameOmitted
+)
+
+type operationNameKey struct{}
+
+// WithOperationName returns a context sending name as the operationName of the operations it is passed to,
+// whatever the OperationNamePolicy of the client. An empty name is not sent.
+func WithOperationName(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, operationNameKey{}, name)
+}
+
+// sentOperationName returns the operationName to send for the operation name of query.
+func (c *Client) sentOperationName(ctx context.Context, name, query string) string {
+	if override, ok := ctx.Value(operationNameKey{}).(string); ok {
+		return override
+	}
+
+	switch c.OperationNamePolicy {
+	case OperationNameOmitted:
+		return ""
+	case OperationNameFromDocument:
+		doc, err := parser.ParseQuery(&ast.Source{Input: query})
+		if err != nil || len(doc.Operations) != 1 {
+			return name
+		}
+
+		return doc.Operations[0].Name
+	default:
+		return name
+	}
+}
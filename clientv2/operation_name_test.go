@@ -0,0 +1,95 @@
+package clientv2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationNamePolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		policy OperationNamePolicy
+		ctx    func(ctx context.Context) context.Context
+		query  string
+		want   any
+	}{
+		{
+			name:  "given by default",
+			query: "query Renamed { something }",
+			want:  "GetSomething",
+		},
+		{
+			name:   "from the document",
+			policy: OperationNameFromDocument,
+			query:  "query Renamed { something }",
+			want:   "Renamed",
+		},
+		{
+			name:   "omitted for anonymous documents",
+			policy: OperationNameFromDocument,
+			query:  "{ something }",
+			want:   nil,
+		},
+		{
+			name:   "given for documents with several operations",
+			policy: OperationNameFromDocument,
+			query:  "query GetSomething { something } query Other { something }",
+			want:   "GetSomething",
+		},
+		{
+			name:   "omitted",
+			policy: OperationNameOmitted,
+			query:  "query GetSomething { something }",
+			want:   nil,
+		},
+		{
+			name:   "overridden per call",
+			policy: OperationNameOmitted,
+			ctx: func(ctx context.Context) context.Context {
+				return WithOperationName(ctx, "Override")
+			},
+			query: "query GetSomething { something }",
+			want:  "Override",
+		},
+		{
+			name: "omitted per call",
+			ctx: func(ctx context.Context) context.Context {
+				return WithOperationName(ctx, "")
+			},
+			query: "query GetSomething { something }",
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var body map[string]any
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				_, _ = w.Write([]byte(`{"data":{"something":"some data"}}`))
+			}))
+			t.Cleanup(server.Close)
+
+			ctx := context.Background()
+			if tt.ctx != nil {
+				ctx = tt.ctx(ctx)
+			}
+
+			c := NewClient(http.DefaultClient, server.URL, &Options{OperationNamePolicy: tt.policy})
+
+			var res fakeRes
+			require.NoError(t, c.Post(ctx, "GetSomething", tt.query, &res, nil))
+			require.Equal(t, tt.want, body["operationName"])
+		})
+	}
+}
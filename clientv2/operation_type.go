@@ -0,0 +1,41 @@
+package clientv2
+
+import (
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// operationType returns the type of the operation gqlInfo sends, the one named by its operation name when the
+// document has several, or "" when the document does not parse or has no such operation. The document is parsed,
+// as it may start with comments or fragment definitions.
+func operationType(gqlInfo *GQLRequestInfo) ast.Operation {
+	if gqlInfo == nil || gqlInfo.Request == nil {
+		return ""
+	}
+
+	doc, err := parser.ParseQuery(&ast.Source{Input: gqlInfo.Request.Query})
+	if err != nil {
+		return ""
+	}
+
+	operation := doc.Operations.ForName(gqlInfo.Request.OperationName)
+	if len(doc.Operations) == 1 {
+		operation = doc.Operations[0]
+	}
+
+	if operation == nil {
+		return ""
+	}
+
+	return operation.Operation
+}
+
+// isQueryOperation reports whether gqlInfo sends a query, which is safe to send twice.
+func isQueryOperation(gqlInfo *GQLRequestInfo) bool {
+	return operationType(gqlInfo) == ast.Query
+}
+
+// isMutationOperation reports whether gqlInfo sends a mutation.
+func isMutationOperation(gqlInfo *GQLRequestInfo) bool {
+	return operationType(gqlInfo) == ast.Mutation
+}
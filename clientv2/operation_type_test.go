@@ -0,0 +1,38 @@
+package clientv2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestOperationType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		query         string
+		operationName string
+		want          ast.Operation
+	}{
+		{name: "query", query: "query GetUser { user { id } }", want: ast.Query},
+		{name: "anonymous query", query: "{ user { id } }", want: ast.Query},
+		{name: "mutation", query: "mutation DoSomething { something }", want: ast.Mutation},
+		{name: "leading comment", query: "# GetUser fetches a user.\nquery GetUser { user { id } }", want: ast.Query},
+		{name: "leading fragment", query: "fragment UserFields on User { id }\nmutation UpdateUser { updateUser { ...UserFields } }", want: ast.Mutation},
+		{name: "operation by name", query: "query GetUser { user { id } }\nmutation DoSomething { something }", operationName: "DoSomething", want: ast.Mutation},
+		{name: "unknown operation name", query: "query GetUser { user { id } }\nmutation DoSomething { something }", operationName: "Other"},
+		{name: "invalid document", query: "query GetUser {"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gqlInfo := NewGQLRequestInfo(&Request{Query: tt.query, OperationName: tt.operationName})
+			require.Equal(t, tt.want, operationType(gqlInfo))
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package clientv2
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+// RawBodies receives the exact request body sent and the raw response body received by one call.
+type RawBodies struct {
+	Request  []byte
+	Response []byte
+}
+
+type rawBodiesKey struct{}
+
+// WithRawBodies returns a context that captures the raw bodies of the operation it is passed to
+// into sink, which helps to compare a failing call with the same request sent by curl:
+//
+//	var bodies clientv2.RawBodies
+//	res, err := client.GetUser(clientv2.WithRawBodies(ctx, &bodies), id)
+//	fmt.Printf("%s\n%s\n", bodies.Request, bodies.Response)
+//
+// The response body is captured after gzip decoding.
+func WithRawBodies(ctx context.Context, sink *RawBodies) context.Context {
+	return context.WithValue(ctx, rawBodiesKey{}, sink)
+}
+
+// recordRawBodies stores the body of req and the response body in the RawBodies captured by ctx, if any.
+func recordRawBodies(ctx context.Context, req *http.Request, responseBody []byte) {
+	sink, ok := ctx.Value(rawBodiesKey{}).(*RawBodies)
+	if !ok {
+		return
+	}
+
+	sink.Response = responseBody
+
+	if req.GetBody == nil {
+		return
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return
+	}
+	defer body.Close()
+
+	sink.Request, _ = io.ReadAll(body)
+}
@@ -0,0 +1,28 @@
+package clientv2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRawBodies(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(validData))
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(http.DefaultClient, server.URL, nil)
+
+	var bodies RawBodies
+
+	var res fakeRes
+	require.NoError(t, c.Post(WithRawBodies(context.Background(), &bodies), "GetSomething", "query GetSomething { something }", &res, map[string]any{"id": "1"}))
+	require.JSONEq(t, `{"query":"query GetSomething { something }","variables":{"id":"1"},"operationName":"GetSomething"}`, string(bodies.Request))
+	require.Equal(t, validData, string(bodies.Response))
+}
@@ -0,0 +1,76 @@
+package clientv2
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrRedirect is returned when the endpoint redirects a request and the RedirectPolicy denies it.
+var ErrRedirect = errors.New("graphql endpoint redirected the request")
+
+// RedirectPolicy controls how the client handles HTTP redirects of its POST requests.
+type RedirectPolicy int
+
+const (
+	// RedirectDeny fails requests that are redirected with ErrRedirect. Redirected POSTs are
+	// turned into GETs or lose their body on some proxies, so this is the default.
+	RedirectDeny RedirectPolicy = iota
+	// RedirectFollow lets the HttpClient follow redirects. Failures of a redirected request
+	// mention the URL it was redirected to.
+	RedirectFollow
+)
+
+// sendRequest sends req with the client's HttpClient, applying the RedirectPolicy.
+// An *http.Client without CheckRedirect is stopped before following a denied redirect,
+// other HttpClient implementations are only checked after the fact.
+func (c *Client) sendRequest(req *http.Request) (*http.Response, error) {
+	client := c.Client
+	if hc, ok := client.(*http.Client); ok && c.RedirectPolicy == RedirectDeny && hc.CheckRedirect == nil {
+		noRedirect := *hc
+		noRedirect.CheckRedirect = func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+		client = &noRedirect
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.RedirectPolicy != RedirectDeny {
+		return resp, nil
+	}
+
+	if location := resp.Header.Get("Location"); location != "" && isRedirectStatus(resp.StatusCode) {
+		c.closeBody(req.Context(), resp.Body)
+
+		return nil, fmt.Errorf("%w: %d to %s", ErrRedirect, resp.StatusCode, location)
+	}
+
+	if redirectedTo := redirectedURL(req, resp); redirectedTo != "" {
+		c.closeBody(req.Context(), resp.Body)
+
+		return nil, fmt.Errorf("%w: followed to %s", ErrRedirect, redirectedTo)
+	}
+
+	return resp, nil
+}
+
+func isRedirectStatus(code int) bool {
+	return code >= http.StatusMultipleChoices && code < http.StatusBadRequest && code != http.StatusNotModified
+}
+
+// redirectedURL returns the final URL of resp if the HttpClient followed a redirect, or "".
+func redirectedURL(req *http.Request, resp *http.Response) string {
+	if resp.Request == nil || resp.Request.URL == nil || req.URL == nil {
+		return ""
+	}
+
+	if final := resp.Request.URL.String(); final != req.URL.String() {
+		return final
+	}
+
+	return ""
+}
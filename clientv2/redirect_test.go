@@ -0,0 +1,97 @@
+package clientv2
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// followingClient follows redirects itself, like HttpClient implementations the client cannot configure.
+type followingClient struct {
+	*http.Client
+}
+
+func (f followingClient) Do(req *http.Request) (*http.Response, error) {
+	return f.Client.Do(req)
+}
+
+func newRedirectServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/old", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/new", http.StatusPermanentRedirect)
+	})
+	mux.HandleFunc("/new", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), "GetSomething") {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(validData))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestRedirectPolicy(t *testing.T) {
+	t.Parallel()
+
+	server := newRedirectServer(t)
+
+	t.Run("deny by default", func(t *testing.T) {
+		t.Parallel()
+
+		c := NewClient(http.DefaultClient, server.URL+"/old", nil)
+
+		var res fakeRes
+		err := c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil)
+		require.ErrorIs(t, err, ErrRedirect)
+		require.ErrorContains(t, err, "308 to /new")
+	})
+
+	t.Run("deny with a following HttpClient", func(t *testing.T) {
+		t.Parallel()
+
+		c := NewClient(followingClient{http.DefaultClient}, server.URL+"/old", nil)
+
+		var res fakeRes
+		err := c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil)
+		require.ErrorIs(t, err, ErrRedirect)
+		require.ErrorContains(t, err, "followed to "+server.URL+"/new")
+	})
+
+	t.Run("follow", func(t *testing.T) {
+		t.Parallel()
+
+		c := NewClient(http.DefaultClient, server.URL+"/old", &Options{RedirectPolicy: RedirectFollow})
+
+		var res fakeRes
+		require.NoError(t, c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil))
+		require.Equal(t, "some data", res.Something)
+	})
+
+	t.Run("follow wraps failures", func(t *testing.T) {
+		t.Parallel()
+
+		c := NewClient(http.DefaultClient, server.URL+"/old", &Options{RedirectPolicy: RedirectFollow})
+
+		var res fakeRes
+		err := c.Post(context.Background(), "Other", "query Other { other }", &res, nil)
+
+		var errResponse *ErrorResponse
+		require.True(t, errors.As(err, &errResponse))
+		require.ErrorContains(t, err, "after redirect to "+server.URL+"/new")
+	})
+}
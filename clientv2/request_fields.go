@@ -0,0 +1,65 @@
+package clientv2
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ErrRequestFieldNames is returned when RequestFieldNames give several fields the same name.
+var ErrRequestFieldNames = errors.New("request field names must be distinct")
+
+// RequestFieldNames renames the fields of the JSON body of requests, for servers expecting for example
+// "operation" and "vars" instead of "operationName" and "variables". With ContentTypeGraphQL they rename
+// the URL query parameters. Empty names keep the standard ones.
+type RequestFieldNames struct {
+	Query         string
+	Variables     string
+	OperationName string
+}
+
+// isStandard reports whether n keeps all the standard field names.
+func (n RequestFieldNames) isStandard() bool {
+	return n.names() == RequestFieldNames{Query: "query", Variables: "variables", OperationName: "operationName"}
+}
+
+// names returns n with its empty names replaced by the standard ones.
+func (n RequestFieldNames) names() RequestFieldNames {
+	if n.Query == "" {
+		n.Query = "query"
+	}
+
+	if n.Variables == "" {
+		n.Variables = "variables"
+	}
+
+	if n.OperationName == "" {
+		n.OperationName = "operationName"
+	}
+
+	return n
+}
+
+// encode encodes the request body of the operation, omitting empty variables, operation name and extensions
+// like Request. Extensions keep their standard name.
+func (n RequestFieldNames) encode(query, operationName string, vars json.RawMessage, extensions map[string]any) ([]byte, error) {
+	names := n.names()
+	if names.Query == names.Variables || names.Query == names.OperationName || names.Variables == names.OperationName {
+		return nil, ErrRequestFieldNames
+	}
+
+	fields := map[string]any{names.Query: query}
+
+	if len(vars) > 0 {
+		fields[names.Variables] = vars
+	}
+
+	if operationName != "" {
+		fields[names.OperationName] = operationName
+	}
+
+	if len(extensions) > 0 {
+		fields["extensions"] = extensions
+	}
+
+	return json.Marshal(fields)
+}
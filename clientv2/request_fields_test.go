@@ -0,0 +1,92 @@
+package clientv2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+func TestRequestFieldNames(t *testing.T) {
+	t.Parallel()
+
+	const query = "query GetSomething($id: ID!) { something(id: $id) }"
+
+	names := RequestFieldNames{Variables: "vars", OperationName: "operation"}
+
+	t.Run("json body", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(validData))
+		}))
+		t.Cleanup(server.Close)
+
+		c := NewClient(http.DefaultClient, server.URL, &Options{RequestFieldNames: names})
+
+		var (
+			bodies RawBodies
+			res    fakeRes
+		)
+
+		require.NoError(t, c.Post(WithRawBodies(context.Background(), &bodies), "GetSomething", query, &res, map[string]any{"id": "1"}))
+		require.JSONEq(t, `{"query":"`+query+`","vars":{"id":"1"},"operation":"GetSomething"}`, string(bodies.Request))
+
+		require.NoError(t, c.Post(WithRawBodies(context.Background(), &bodies), "", query, &res, nil))
+		require.JSONEq(t, `{"query":"`+query+`"}`, string(bodies.Request))
+	})
+
+	t.Run("multipart operations", func(t *testing.T) {
+		t.Parallel()
+
+		var operations string
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			operations = r.FormValue("operations")
+			_, _ = w.Write([]byte(validData))
+		}))
+		t.Cleanup(server.Close)
+
+		c := NewClient(http.DefaultClient, server.URL, &Options{RequestFieldNames: names})
+
+		var res fakeRes
+
+		vars := map[string]any{"file": graphql.Upload{Filename: "a.txt", File: strings.NewReader("a")}}
+		require.NoError(t, c.Post(context.Background(), "Upload", "mutation Upload($file: Upload!) { upload(file: $file) }", &res, vars))
+		require.JSONEq(t, `{"query":"mutation Upload($file: Upload!) { upload(file: $file) }","vars":{"file":null},"operation":"Upload"}`, operations)
+	})
+
+	t.Run("graphql content type", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("operation") != "GetSomething" || r.URL.Query().Get("vars") != `{"id":"1"}` {
+				w.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
+
+			_, _ = w.Write([]byte(validData))
+		}))
+		t.Cleanup(server.Close)
+
+		c := NewClient(http.DefaultClient, server.URL, &Options{RequestFieldNames: names, ContentType: ContentTypeGraphQL})
+
+		var res fakeRes
+		require.NoError(t, c.Post(context.Background(), "GetSomething", query, &res, map[string]any{"id": "1"}))
+	})
+
+	t.Run("names must be distinct", func(t *testing.T) {
+		t.Parallel()
+
+		c := NewClient(http.DefaultClient, "http://localhost", &Options{RequestFieldNames: RequestFieldNames{OperationName: "query"}})
+
+		var res fakeRes
+		require.ErrorIs(t, c.Post(context.Background(), "GetSomething", query, &res, nil), ErrRequestFieldNames)
+	})
+}
@@ -0,0 +1,51 @@
+package clientv2
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+)
+
+// RequestIDHeader is the header NewRequestIDInterceptor stamps when no other header is given.
+const RequestIDHeader = "X-Request-ID"
+
+// RequestIDFunc returns the correlation id of an outgoing request. It receives the context of the
+// operation, so ids of incoming requests can be propagated.
+type RequestIDFunc func(ctx context.Context) string
+
+// NewRequestIDInterceptor returns an interceptor that stamps header with an id from generate on
+// every request, keeping an id already set by an earlier interceptor. Errors are wrapped with the
+// id so client failures can be correlated with server logs. An empty header defaults to
+// RequestIDHeader and a nil generate to random ids.
+func NewRequestIDInterceptor(header string, generate RequestIDFunc) RequestInterceptor {
+	if header == "" {
+		header = RequestIDHeader
+	}
+
+	if generate == nil {
+		generate = randomRequestID
+	}
+
+	return func(ctx context.Context, req *http.Request, gqlInfo *GQLRequestInfo, res any, next RequestInterceptorFunc) error {
+		id := req.Header.Get(header)
+		if id == "" {
+			id = generate(ctx)
+			req.Header.Set(header, id)
+		}
+
+		if err := next(ctx, req, gqlInfo, res); err != nil {
+			return fmt.Errorf("%s %s: %w", header, id, err)
+		}
+
+		return nil
+	}
+}
+
+func randomRequestID(context.Context) string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,62 @@
+package clientv2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type requestIDKey struct{}
+
+func TestNewRequestIDInterceptor(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Correlation-ID") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(server.Close)
+
+	generate := func(ctx context.Context) string {
+		return ctx.Value(requestIDKey{}).(string)
+	}
+
+	c := NewClient(http.DefaultClient, server.URL, nil, NewRequestIDInterceptor("X-Correlation-ID", generate))
+	ctx := context.WithValue(context.Background(), requestIDKey{}, "incoming-1")
+
+	var res fakeRes
+	err := c.Post(ctx, "GetSomething", "query GetSomething { something }", &res, nil)
+	require.ErrorContains(t, err, "X-Correlation-ID incoming-1: ")
+	require.ErrorContains(t, err, "500")
+}
+
+func TestNewRequestIDInterceptor_defaults(t *testing.T) {
+	t.Parallel()
+
+	var ids []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ids = append(ids, r.Header.Get(RequestIDHeader))
+		_, _ = w.Write([]byte(validData))
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(http.DefaultClient, server.URL, nil, NewRequestIDInterceptor("", nil))
+
+	for range 2 {
+		var res fakeRes
+		require.NoError(t, c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil))
+	}
+
+	require.Len(t, ids, 2)
+	require.Len(t, ids[0], 32)
+	require.NotEqual(t, ids[0], ids[1])
+}
@@ -0,0 +1,65 @@
+package clientv2
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+)
+
+// ErrResponseTooLarge is returned when a response body exceeds the ResponseReadLimit of the client.
+var ErrResponseTooLarge = errors.New("response body too large")
+
+// limitBody makes the body of resp fail with ErrResponseTooLarge after the ResponseReadLimit of the client.
+func (c *Client) limitBody(resp *http.Response) {
+	if c.ResponseReadLimit <= 0 {
+		return
+	}
+
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{&limitedReader{reader: resp.Body, remaining: c.ResponseReadLimit}, resp.Body}
+}
+
+// limitedReader reads from reader until remaining bytes are read, and fails with ErrResponseTooLarge
+// when reader has more.
+type limitedReader struct {
+	reader    io.Reader
+	remaining int64
+}
+
+func (r *limitedReader) Read(p []byte) (int, error) {
+	if r.remaining < 0 {
+		return 0, ErrResponseTooLarge
+	}
+
+	// one byte more than remaining tells whether the body goes on
+	if int64(len(p)) > r.remaining+1 {
+		p = p[:r.remaining+1]
+	}
+
+	n, err := r.reader.Read(p)
+
+	r.remaining -= int64(n)
+	if r.remaining < 0 {
+		return n + int(r.remaining), ErrResponseTooLarge
+	}
+
+	return n, err
+}
+
+// closeBody closes body after draining it up to the ResponseDrainLimit of the client. Bodies of canceled
+// requests are closed right away, since their connection is closed by the transport anyway.
+func (c *Client) closeBody(ctx context.Context, body io.ReadCloser) {
+	limit := c.ResponseDrainLimit
+	if limit == 0 {
+		limit = drainLimit
+	}
+
+	if limit > 0 && ctx.Err() == nil {
+		_, _ = io.Copy(io.Discard, io.LimitReader(body, limit))
+	}
+
+	_ = body.Close()
+}
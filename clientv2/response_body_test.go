@@ -0,0 +1,205 @@
+package clientv2
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_responseReadLimit(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(validData))
+	}))
+	t.Cleanup(server.Close)
+
+	t.Run("within the limit", func(t *testing.T) {
+		t.Parallel()
+
+		c := NewClient(server.Client(), server.URL, &Options{ResponseReadLimit: int64(len(validData))})
+
+		var res fakeRes
+		require.NoError(t, c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil))
+		require.Equal(t, "some data", res.Something)
+	})
+
+	t.Run("beyond the limit", func(t *testing.T) {
+		t.Parallel()
+
+		c := NewClient(server.Client(), server.URL, &Options{ResponseReadLimit: int64(len(validData)) - 1})
+
+		var res fakeRes
+		require.ErrorIs(t, c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil), ErrResponseTooLarge)
+	})
+}
+
+func TestLimitedReader(t *testing.T) {
+	t.Parallel()
+
+	buf := make([]byte, 4)
+
+	r := &limitedReader{reader: strings.NewReader("abcdef"), remaining: 4}
+	n, err := r.Read(buf)
+	require.NoError(t, err)
+	require.Equal(t, "abcd", string(buf[:n]))
+
+	n, err = r.Read(buf)
+	require.ErrorIs(t, err, ErrResponseTooLarge)
+	require.Zero(t, n)
+
+	r = &limitedReader{reader: strings.NewReader("abcd"), remaining: 4}
+	n, err = r.Read(make([]byte, 8))
+	require.NoError(t, err)
+	require.Equal(t, 4, n)
+}
+
+// countConnections returns a server answering with handler and the number of connections opened to it.
+func countConnections(t *testing.T, handler http.HandlerFunc) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var connections atomic.Int32
+
+	server := httptest.NewUnstartedServer(handler)
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			connections.Add(1)
+		}
+	}
+	server.Start()
+	t.Cleanup(server.Close)
+
+	return server, &connections
+}
+
+func TestClient_responseDrainLimit(t *testing.T) {
+	t.Parallel()
+
+	errorPage := func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte("<html>" + strings.Repeat("proxy error page ", 1000) + "</html>"))
+	}
+
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+
+		server, connections := countConnections(t, errorPage)
+		c := NewClient(server.Client(), server.URL, nil)
+
+		for range 3 {
+			var res fakeRes
+			require.ErrorIs(t, c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil), ErrUnexpectedContentType)
+		}
+
+		require.Equal(t, int32(1), connections.Load())
+	})
+
+	t.Run("too small", func(t *testing.T) {
+		t.Parallel()
+
+		server, connections := countConnections(t, errorPage)
+		c := NewClient(server.Client(), server.URL, &Options{ResponseDrainLimit: 16})
+
+		for range 3 {
+			var res fakeRes
+			require.ErrorIs(t, c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil), ErrUnexpectedContentType)
+		}
+
+		require.Equal(t, int32(3), connections.Load(), "bodies left unread beyond the limit close their connection")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		server, connections := countConnections(t, errorPage)
+		c := NewClient(server.Client(), server.URL, &Options{ResponseDrainLimit: -1})
+
+		for range 3 {
+			var res fakeRes
+			require.ErrorIs(t, c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil), ErrUnexpectedContentType)
+		}
+
+		require.Equal(t, int32(3), connections.Load())
+	})
+}
+
+func TestClient_cancellation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("before the response", func(t *testing.T) {
+		t.Parallel()
+
+		release := make(chan struct{})
+
+		server, connections := countConnections(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("slow") != "" {
+				select {
+				case <-release:
+				case <-r.Context().Done():
+				}
+
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(validData))
+		})
+		t.Cleanup(func() { close(release) })
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		var res fakeRes
+
+		err := NewClient(server.Client(), server.URL+"?slow=1", nil).Post(ctx, "GetSomething", "query GetSomething { something }", &res, nil)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		require.NoError(t, NewClient(server.Client(), server.URL, nil).Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil))
+		require.Equal(t, "some data", res.Something)
+		require.Equal(t, int32(2), connections.Load(), "the connection of the canceled request is not reused")
+	})
+
+	t.Run("while reading the body", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"data": {"something": "`))
+			w.(http.Flusher).Flush()
+
+			<-r.Context().Done()
+		}))
+		t.Cleanup(server.Close)
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		c := NewClient(server.Client(), server.URL, nil, func(ctx context.Context, req *http.Request, gqlInfo *GQLRequestInfo, res any, next RequestInterceptorFunc) error {
+			time.AfterFunc(50*time.Millisecond, cancel)
+
+			return next(ctx, req, gqlInfo, res)
+		})
+
+		done := make(chan error, 1)
+
+		go func() {
+			var res fakeRes
+			done <- c.Post(ctx, "GetSomething", "query GetSomething { something }", &res, nil)
+		}()
+
+		select {
+		case err := <-done:
+			require.True(t, errors.Is(err, context.Canceled), "unexpected error %v", err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("Post did not return after its context was canceled")
+		}
+	})
+}
@@ -0,0 +1,109 @@
+package clientv2
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+var (
+	// ErrUnsupportedContentEncoding is returned for a response encoded with a Content-Encoding without ContentDecoder.
+	ErrUnsupportedContentEncoding = errors.New("unsupported content encoding")
+	// ErrUnexpectedContentType is returned for a successful response whose Content-Type is not JSON,
+	// typically the HTML page of a proxy or a login portal.
+	ErrUnexpectedContentType = errors.New("unexpected content type")
+)
+
+// ContentDecoder decodes a response body encoded with a Content-Encoding.
+type ContentDecoder func(body io.Reader) (io.Reader, error)
+
+// DefaultContentDecoders decode the gzip and deflate encodings. Other encodings are added with the
+// ContentDecoders of the client, for example brotli with github.com/andybalholm/brotli:
+//
+//	ContentDecoders: map[string]clientv2.ContentDecoder{
+//		"br": func(body io.Reader) (io.Reader, error) {
+//			return brotli.NewReader(body), nil
+//		},
+//	}
+var DefaultContentDecoders = map[string]ContentDecoder{
+	"gzip": func(body io.Reader) (io.Reader, error) {
+		return gzip.NewReader(body)
+	},
+	"deflate": func(body io.Reader) (io.Reader, error) {
+		return zlib.NewReader(body)
+	},
+}
+
+// decodeContent replaces the body of resp with its decoded content, undoing its Content-Encoding.
+// An http.Client decompressing transparently removes the header, so the body is only decoded
+// when the HttpClient did not.
+func (c *Client) decodeContent(resp *http.Response) error {
+	var encodings []string
+
+	for _, value := range resp.Header.Values("Content-Encoding") {
+		for encoding := range strings.SplitSeq(value, ",") {
+			encoding = strings.ToLower(strings.TrimSpace(encoding))
+			if encoding != "" && encoding != "identity" {
+				encodings = append(encodings, encoding)
+			}
+		}
+	}
+
+	body := io.Reader(resp.Body)
+
+	// encodings are listed in the order they were applied
+	for i := len(encodings) - 1; i >= 0; i-- {
+		decoder, ok := c.ContentDecoders[encodings[i]]
+		if !ok {
+			decoder, ok = DefaultContentDecoders[encodings[i]]
+		}
+
+		if !ok {
+			return fmt.Errorf("%w %q", ErrUnsupportedContentEncoding, encodings[i])
+		}
+
+		var err error
+
+		body, err = decoder(body)
+		if err != nil {
+			return fmt.Errorf("%s decode failed: %w", encodings[i], err)
+		}
+	}
+
+	if len(encodings) > 0 {
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{body, resp.Body}
+	}
+
+	return nil
+}
+
+// checkContentType returns ErrUnexpectedContentType, with the start of the body, when resp is successful
+// but not JSON. Responses without Content-Type or with text/plain, which servers sniffing their content
+// like net/http send for JSON, are accepted. Failed responses are reported as HTTPError instead.
+func (c *Client) checkContentType(resp *http.Response) error {
+	if c.AllowAnyContentType || resp.StatusCode < 200 || 299 < resp.StatusCode {
+		return nil
+	}
+
+	contentType := resp.Header.Get("Content-Type")
+	if contentType == "" {
+		return nil
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err == nil && (mediaType == "application/json" || mediaType == "text/plain" || strings.HasSuffix(mediaType, "+json")) {
+		return nil
+	}
+
+	content, _ := io.ReadAll(io.LimitReader(resp.Body, httpErrorBodyLimit+1))
+
+	return fmt.Errorf("%w %q: %s", ErrUnexpectedContentType, contentType, bodySnippet(content))
+}
@@ -0,0 +1,218 @@
+package clientv2
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func gzipped(t *testing.T, content []byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	return buf.Bytes()
+}
+
+func deflated(t *testing.T, content []byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	w := zlib.NewWriter(&buf)
+	_, err := w.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	return buf.Bytes()
+}
+
+func TestClient_decodeContent(t *testing.T) {
+	t.Parallel()
+
+	// the client must not decompress by itself
+	httpClient := &http.Client{Transport: &http.Transport{DisableCompression: true}}
+
+	tests := []struct {
+		name     string
+		encoding string
+		body     func(t *testing.T) []byte
+		options  *Options
+		wantErr  error
+	}{
+		{
+			name:     "gzip",
+			encoding: "gzip",
+			body: func(t *testing.T) []byte {
+				t.Helper()
+
+				return gzipped(t, []byte(validData))
+			},
+		},
+		{
+			name:     "deflate",
+			encoding: "deflate",
+			body: func(t *testing.T) []byte {
+				t.Helper()
+
+				return deflated(t, []byte(validData))
+			},
+		},
+		{
+			name:     "encodings applied in order",
+			encoding: "deflate, GZIP",
+			body: func(t *testing.T) []byte {
+				t.Helper()
+
+				return gzipped(t, deflated(t, []byte(validData)))
+			},
+		},
+		{
+			name:     "identity",
+			encoding: "identity",
+			body: func(*testing.T) []byte {
+				return []byte(validData)
+			},
+		},
+		{
+			name:     "custom decoder",
+			encoding: "reversed",
+			body: func(*testing.T) []byte {
+				return []byte(reverse(validData))
+			},
+			options: &Options{ContentDecoders: map[string]ContentDecoder{
+				"reversed": func(body io.Reader) (io.Reader, error) {
+					content, err := io.ReadAll(body)
+
+					return strings.NewReader(reverse(string(content))), err
+				},
+			}},
+		},
+		{
+			name:     "unsupported encoding",
+			encoding: "br",
+			body: func(*testing.T) []byte {
+				return []byte("not json")
+			},
+			wantErr: ErrUnsupportedContentEncoding,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			body := tt.body(t)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("Content-Encoding", tt.encoding)
+				_, _ = w.Write(body)
+			}))
+			t.Cleanup(server.Close)
+
+			c := NewClient(httpClient, server.URL, tt.options)
+
+			var res fakeRes
+
+			err := c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "some data", res.Something)
+		})
+	}
+}
+
+func reverse(s string) string {
+	runes := []rune(s)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+
+	return string(runes)
+}
+
+func TestClient_checkContentType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		options     *Options
+		wantErr     string
+	}{
+		{
+			name:        "json",
+			contentType: "application/json; charset=utf-8",
+			body:        validData,
+		},
+		{
+			name:        "graphql response",
+			contentType: "application/graphql-response+json",
+			body:        validData,
+		},
+		{
+			name:        "sniffed text",
+			contentType: "text/plain; charset=utf-8",
+			body:        validData,
+		},
+		{
+			name:        "html",
+			contentType: "text/html",
+			body:        "<html>\n<body>Sign in</body>\n</html>",
+			wantErr:     `unexpected content type "text/html": <html> <body>Sign in</body> </html>`,
+		},
+		{
+			name:        "html allowed",
+			contentType: "text/html",
+			body:        validData,
+			options:     &Options{AllowAnyContentType: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", tt.contentType)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			t.Cleanup(server.Close)
+
+			c := NewClient(http.DefaultClient, server.URL, tt.options)
+
+			var res fakeRes
+
+			err := c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil)
+			if tt.wantErr != "" {
+				require.ErrorIs(t, err, ErrUnexpectedContentType)
+				require.EqualError(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "some data", res.Something)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package clientv2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// ResponseMetadata holds the HTTP level details of a GraphQL response.
+type ResponseMetadata struct {
+	StatusCode int
+	Header     http.Header
+	// Extensions is the raw top-level extensions object of the response, if any.
+	Extensions json.RawMessage
+}
+
+type responseMetadataKey struct{}
+
+// WithResponseMetadata returns a context that captures the response metadata of the
+// operation it is passed to, so headers such as ETag or X-RateLimit-* can be read after
+// a generated method returns:
+//
+//	ctx, meta := clientv2.WithResponseMetadata(ctx)
+//	res, err := client.GetUser(ctx, id)
+//	remaining := meta.Header.Get("X-RateLimit-Remaining")
+//
+// The metadata stays zero when the request never reached the server.
+func WithResponseMetadata(ctx context.Context) (context.Context, *ResponseMetadata) {
+	meta := &ResponseMetadata{}
+
+	return context.WithValue(ctx, responseMetadataKey{}, meta), meta
+}
+
+// recordResponseMetadata stores resp in gqlInfo and in the ResponseMetadata captured by ctx, if any.
+func recordResponseMetadata(ctx context.Context, gqlInfo *GQLRequestInfo, resp *http.Response) {
+	meta := &ResponseMetadata{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+	}
+
+	if gqlInfo != nil {
+		gqlInfo.Response = meta
+	}
+
+	if captured, ok := ctx.Value(responseMetadataKey{}).(*ResponseMetadata); ok {
+		*captured = *meta
+	}
+}
+
+// recordResponseExtensions stores the top-level extensions of body like recordResponseMetadata.
+func recordResponseExtensions(ctx context.Context, gqlInfo *GQLRequestInfo, body []byte) {
+	// most responses have no extensions, avoid decoding them twice
+	if !bytes.Contains(body, []byte(`"extensions"`)) {
+		return
+	}
+
+	var resp struct {
+		Extensions json.RawMessage `json:"extensions"`
+	}
+
+	if err := json.Unmarshal(body, &resp); err != nil || len(resp.Extensions) == 0 {
+		return
+	}
+
+	if gqlInfo != nil && gqlInfo.Response != nil {
+		gqlInfo.Response.Extensions = resp.Extensions
+	}
+
+	if captured, ok := ctx.Value(responseMetadataKey{}).(*ResponseMetadata); ok {
+		captured.Extensions = resp.Extensions
+	}
+}
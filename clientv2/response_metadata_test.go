@@ -0,0 +1,42 @@
+package clientv2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithResponseMetadata(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("X-RateLimit-Remaining", "41")
+		_, _ = w.Write([]byte(`{"data":{"something": "some data"},"extensions":{"cost":1}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	var intercepted *ResponseMetadata
+
+	c := NewClient(server.Client(), server.URL, nil, func(ctx context.Context, req *http.Request, gqlInfo *GQLRequestInfo, res any, next RequestInterceptorFunc) error {
+		err := next(ctx, req, gqlInfo, res)
+		intercepted = gqlInfo.Response
+
+		return err
+	})
+
+	ctx, meta := WithResponseMetadata(context.Background())
+
+	var res fakeRes
+	require.NoError(t, c.Post(ctx, "GetSomething", "query GetSomething { something }", &res, nil))
+	require.Equal(t, "some data", res.Something)
+
+	require.Equal(t, http.StatusOK, meta.StatusCode)
+	require.Equal(t, `"v1"`, meta.Header.Get("ETag"))
+	require.Equal(t, "41", meta.Header.Get("X-RateLimit-Remaining"))
+	require.JSONEq(t, `{"cost":1}`, string(meta.Extensions))
+	require.Equal(t, meta, intercepted)
+}
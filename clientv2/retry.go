@@ -0,0 +1,178 @@
+package clientv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// ThrottledErrorCode is the extensions.code of GraphQL errors reporting that the client is rate limited.
+const ThrottledErrorCode = "THROTTLED"
+
+// RetryPolicy configures NewRetryInterceptor.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry when the server gives no hint, doubled on every retry.
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff. A server hint above MaxDelay is not waited for, the error is returned instead.
+	MaxDelay time.Duration
+}
+
+// DefaultRetryPolicy retries three times, starting at 100ms and waiting at most 10s between attempts.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	BaseDelay:   100 * time.Millisecond,
+	MaxDelay:    10 * time.Second,
+}
+
+// NewRetryInterceptor returns an interceptor that retries rate limited and failed requests.
+//
+// Requests answered with 429 Too Many Requests or with a GraphQL error whose extensions.code is
+// THROTTLED were not processed, so they are retried for every operation. The delay honors the
+// Retry-After header and the extensions.retryAfter seconds before falling back to exponential
+// backoff with jitter. Transport errors and 502, 503 and 504 responses are only retried for queries,
+// since a mutation may already have been applied.
+func NewRetryInterceptor(policy RetryPolicy) RequestInterceptor {
+	return func(ctx context.Context, req *http.Request, gqlInfo *GQLRequestInfo, res any, next RequestInterceptorFunc) error {
+		return retryAttempts(ctx, req, gqlInfo, res, next, policy.MaxAttempts, policy.retryDelay)
+	}
+}
+
+// retryAttempts sends req with next up to maxAttempts times, while retryDelay reports the failed attempts
+// are retried, waiting the delay it returns before rewinding the request body and resetting res.
+func retryAttempts(ctx context.Context, req *http.Request, gqlInfo *GQLRequestInfo, res any, next RequestInterceptorFunc, maxAttempts int, retryDelay func(attempt int, err error, gqlInfo *GQLRequestInfo) (time.Duration, bool)) error {
+	attemptReq := req
+
+	for attempt := 1; ; attempt++ {
+		gqlInfo.Response = nil
+
+		err := next(ctx, attemptReq, gqlInfo, res)
+		if err == nil || attempt >= maxAttempts || req.GetBody == nil {
+			return err
+		}
+
+		delay, retry := retryDelay(attempt, err, gqlInfo)
+		if !retry {
+			return err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return err
+		case <-timer.C:
+		}
+
+		attemptReq = req.Clone(ctx)
+
+		attemptReq.Body, err = req.GetBody()
+		if err != nil {
+			return fmt.Errorf("failed to rewind request body: %w", err)
+		}
+
+		if v := reflect.ValueOf(res); v.Kind() == reflect.Pointer && !v.IsNil() {
+			v.Elem().SetZero()
+		}
+	}
+}
+
+// retryDelay reports whether a failed attempt is retried and how long to wait before it.
+func (p RetryPolicy) retryDelay(attempt int, err error, gqlInfo *GQLRequestInfo) (time.Duration, bool) {
+	var hint time.Duration
+
+	switch {
+	case gqlInfo.Response != nil && gqlInfo.Response.StatusCode == http.StatusTooManyRequests:
+		hint = parseRetryAfter(gqlInfo.Response.Header.Get("Retry-After"))
+	case isThrottled(err):
+		hint = throttledRetryAfter(err)
+	case !isQueryOperation(gqlInfo):
+		return 0, false
+	case gqlInfo.Response == nil:
+		// transport error
+	case gqlInfo.Response.StatusCode == http.StatusServiceUnavailable:
+		hint = parseRetryAfter(gqlInfo.Response.Header.Get("Retry-After"))
+	case gqlInfo.Response.StatusCode == http.StatusBadGateway, gqlInfo.Response.StatusCode == http.StatusGatewayTimeout:
+	default:
+		return 0, false
+	}
+
+	if hint > 0 {
+		return hint, p.MaxDelay <= 0 || hint <= p.MaxDelay
+	}
+
+	backoff := p.BaseDelay << (attempt - 1)
+	if p.MaxDelay > 0 && (backoff > p.MaxDelay || backoff <= 0) {
+		backoff = p.MaxDelay
+	}
+
+	// full jitter keeps clients that failed together from retrying together
+	return time.Duration(rand.Int64N(int64(backoff) + 1)), true
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date)
+	}
+
+	return 0
+}
+
+func throttledErrors(err error) []map[string]any {
+	var errResponse *ErrorResponse
+	if !errors.As(err, &errResponse) || errResponse.GqlErrors == nil {
+		return nil
+	}
+
+	var extensions []map[string]any
+
+	for _, gqlErr := range *errResponse.GqlErrors {
+		if gqlErr.Extensions["code"] == ThrottledErrorCode {
+			extensions = append(extensions, gqlErr.Extensions)
+		}
+	}
+
+	return extensions
+}
+
+func isThrottled(err error) bool {
+	return len(throttledErrors(err)) > 0
+}
+
+// throttledRetryAfter returns the largest extensions.retryAfter, in seconds, of the THROTTLED errors in err.
+func throttledRetryAfter(err error) time.Duration {
+	var longest time.Duration
+
+	for _, extensions := range throttledErrors(err) {
+		var seconds float64
+
+		switch v := extensions["retryAfter"].(type) {
+		case float64:
+			seconds = v
+		case string:
+			seconds, _ = strconv.ParseFloat(v, 64)
+		}
+
+		if d := time.Duration(seconds * float64(time.Second)); d > longest {
+			longest = d
+		}
+	}
+
+	return longest
+}
@@ -0,0 +1,129 @@
+package clientv2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRetryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	policy := RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 2 * time.Second}
+
+	tests := []struct {
+		name     string
+		query    string
+		failures []func(w http.ResponseWriter)
+		wantErr  bool
+		wantHits int32
+		minDelay time.Duration
+	}{
+		{
+			name:  "429 honors Retry-After",
+			query: "mutation DoSomething { something }",
+			failures: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					w.Header().Set("Retry-After", "1")
+					w.WriteHeader(http.StatusTooManyRequests)
+				},
+			},
+			wantHits: 2,
+			minDelay: time.Second,
+		},
+		{
+			name:  "THROTTLED honors retryAfter",
+			query: "query GetSomething { something }",
+			failures: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					_, _ = w.Write([]byte(`{"errors":[{"message":"slow down","extensions":{"code":"THROTTLED","retryAfter":0.2}}]}`))
+				},
+			},
+			wantHits: 2,
+			minDelay: 200 * time.Millisecond,
+		},
+		{
+			name:  "Retry-After above MaxDelay is not waited for",
+			query: "query GetSomething { something }",
+			failures: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					w.Header().Set("Retry-After", "60")
+					w.WriteHeader(http.StatusTooManyRequests)
+				},
+			},
+			wantErr:  true,
+			wantHits: 1,
+		},
+		{
+			name:  "query is retried on 502 until MaxAttempts",
+			query: "query GetSomething { something }",
+			failures: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusBadGateway) },
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusBadGateway) },
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusBadGateway) },
+			},
+			wantErr:  true,
+			wantHits: 3,
+		},
+		{
+			name:  "mutation is not retried on 502",
+			query: "mutation DoSomething { something }",
+			failures: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusBadGateway) },
+			},
+			wantErr:  true,
+			wantHits: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var hits atomic.Int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				hit := int(hits.Add(1))
+				if hit <= len(tt.failures) {
+					tt.failures[hit-1](w)
+
+					return
+				}
+
+				_, _ = w.Write([]byte(validData))
+			}))
+			t.Cleanup(server.Close)
+
+			c := NewClient(http.DefaultClient, server.URL, nil, NewRetryInterceptor(policy))
+			start := time.Now()
+
+			var res fakeRes
+			err := c.Post(context.Background(), "Operation", tt.query, &res, nil)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "some data", res.Something)
+			}
+
+			require.Equal(t, tt.wantHits, hits.Load())
+			require.GreaterOrEqual(t, time.Since(start), tt.minDelay)
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, 3*time.Second, parseRetryAfter("3"))
+	require.Zero(t, parseRetryAfter(""))
+	require.Zero(t, parseRetryAfter("soon"))
+
+	date := time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)
+	require.InDelta(t, time.Hour, parseRetryAfter(date), float64(2*time.Second))
+}
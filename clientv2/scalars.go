@@ -0,0 +1,141 @@
+package clientv2
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/gqlgo/gqlgenc/graphqljson"
+)
+
+// ScalarCodec encodes and decodes the values of a custom scalar in place of the methods of its Go type,
+// registered by the name of the scalar in the ScalarCodecs of Options. Clients generated with
+// generate.scalarCodecs use them for the variables, input fields and response fields of the scalar.
+// Lists and pointers are handled by the client, so the codec only sees single non-null values, and the Go
+// type of the scalar cannot itself be a slice, an array or a pointer.
+type ScalarCodec struct {
+	// Marshal encodes v, the Go value of the scalar, to JSON. When nil, v is encoded like any other value.
+	Marshal func(v any) ([]byte, error)
+	// Unmarshal decodes data, the JSON value of the scalar, into v, a pointer to its Go value.
+	// When nil, the value is decoded into its Go type.
+	Unmarshal func(data []byte, v any) error
+}
+
+// scalarTag is the struct tag naming the custom scalar of a generated field.
+const scalarTag = "scalar"
+
+// ScalarValue is a variable of the custom scalar Name, encoded by the ScalarCodec of the client
+// registered for Name. Clients generated with generate.scalarCodecs pass such variables in vars.
+type ScalarValue struct {
+	Name  string
+	Value any
+}
+
+// MarshalJSON encodes the value without codec, for encoders other than the client's.
+func (s ScalarValue) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(context.Background(), s.Value)
+}
+
+type scalarCodecsKey struct{}
+
+// withScalarCodecs returns a context whose MarshalJSON calls encode custom scalars with codecs.
+func withScalarCodecs(ctx context.Context, codecs map[string]ScalarCodec) context.Context {
+	if len(codecs) == 0 {
+		return ctx
+	}
+
+	return context.WithValue(ctx, scalarCodecsKey{}, codecs)
+}
+
+func scalarCodecs(ctx context.Context) map[string]ScalarCodec {
+	codecs, _ := ctx.Value(scalarCodecsKey{}).(map[string]ScalarCodec)
+
+	return codecs
+}
+
+type decodeOptionsKey struct{}
+
+// withDecodeOptions returns a context giving the interceptors decoding results themselves, like the one of
+// NormalizedCache, the decode options of the client.
+func withDecodeOptions(ctx context.Context, options []graphqljson.Option) context.Context {
+	if len(options) == 0 {
+		return ctx
+	}
+
+	return context.WithValue(ctx, decodeOptionsKey{}, options)
+}
+
+func contextDecodeOptions(ctx context.Context) []graphqljson.Option {
+	options, _ := ctx.Value(decodeOptionsKey{}).([]graphqljson.Option)
+
+	return options
+}
+
+// decodeOptions returns the DecodeOptions of the client, with the Unmarshal functions of its ScalarCodecs.
+func (c *Client) decodeOptions() []graphqljson.Option {
+	unmarshalers := make(map[string]graphqljson.ScalarUnmarshaler, len(c.ScalarCodecs))
+
+	for name, codec := range c.ScalarCodecs {
+		if codec.Unmarshal != nil {
+			unmarshalers[name] = codec.Unmarshal
+		}
+	}
+
+	if len(unmarshalers) == 0 {
+		return c.DecodeOptions
+	}
+
+	return append(c.DecodeOptions[:len(c.DecodeOptions):len(c.DecodeOptions)], graphqljson.WithScalarUnmarshalers(unmarshalers))
+}
+
+// omittable is implemented by graphql.Omittable, whose Value method returns the value it holds.
+type omittable interface {
+	IsSet() bool
+}
+
+// encodeScalar encodes v, a value of the custom scalar name, with the Marshal function of its codec,
+// following pointers and encoding lists element by element.
+func (e *Encoder) encodeScalar(name string, v reflect.Value) ([]byte, error) {
+	codec, ok := e.scalarCodecs[name]
+	if !ok || codec.Marshal == nil {
+		return e.Encode(v)
+	}
+
+	if !v.IsValid() || isNil(v) {
+		return []byte("null"), nil
+	}
+
+	if _, ok := v.Interface().(omittable); ok {
+		return e.encodeScalar(name, v.MethodByName("Value").Call(nil)[0])
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return e.encodeScalar(name, v.Elem())
+	case reflect.Slice, reflect.Array:
+		elements := make([]byte, 0, 2+v.Len()*8)
+		elements = append(elements, '[')
+
+		for i := range v.Len() {
+			if i > 0 {
+				elements = append(elements, ',')
+			}
+
+			element, err := e.encodeScalar(name, v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+
+			elements = append(elements, element...)
+		}
+
+		return append(elements, ']'), nil
+	default:
+		data, err := codec.Marshal(v.Interface())
+		if err != nil {
+			return nil, fmt.Errorf("marshal %s: %w", name, err)
+		}
+
+		return data, nil
+	}
+}
@@ -0,0 +1,97 @@
+package clientv2
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// scalarsID is a custom scalar type without JSON methods, encoded by its codec as an uppercase string.
+type scalarsID struct {
+	first, second string
+}
+
+type scalarsOrderInput struct {
+	CustomerID scalarsID  `json:"customerId" scalar:"UUID"`
+	Previous   *scalarsID `json:"previous,omitempty" scalar:"UUID"`
+}
+
+type scalarsRes struct {
+	Order struct {
+		ID      scalarsID    `json:"id" graphql:"id" scalar:"UUID"`
+		Related []*scalarsID `json:"related" graphql:"related" scalar:"UUID"`
+	} `json:"order" graphql:"order"`
+}
+
+var scalarsIDCodec = ScalarCodec{
+	Marshal: func(v any) ([]byte, error) {
+		id := v.(scalarsID)
+
+		return json.Marshal(strings.ToUpper(id.first + "-" + id.second))
+	},
+	Unmarshal: func(data []byte, v any) error {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+
+		first, second, _ := strings.Cut(strings.ToLower(s), "-")
+		*v.(*scalarsID) = scalarsID{first, second}
+
+		return nil
+	},
+}
+
+func TestClient_ScalarCodecs(t *testing.T) {
+	t.Parallel()
+
+	var variables string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Variables json.RawMessage `json:"variables"`
+		}
+
+		data, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(data, &body)
+		variables = string(body.Variables)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"order": {"id": "AB-CD", "related": ["EF-01", null]}}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(http.DefaultClient, server.URL, &Options{
+		ScalarCodecs: map[string]ScalarCodec{"UUID": scalarsIDCodec},
+	})
+
+	vars := map[string]any{
+		"ids":   ScalarValue{Name: "UUID", Value: []scalarsID{{"a", "b"}}},
+		"input": scalarsOrderInput{CustomerID: scalarsID{"c", "d"}},
+	}
+
+	var res scalarsRes
+	require.NoError(t, c.Post(context.Background(), "Order", "query Order { order { id related } }", &res, vars))
+
+	require.JSONEq(t, `{"ids": ["A-B"], "input": {"customerId": "C-D"}}`, variables)
+	require.Equal(t, scalarsID{"ab", "cd"}, res.Order.ID)
+	require.Equal(t, []*scalarsID{{"ef", "01"}, nil}, res.Order.Related)
+}
+
+func TestMarshalJSON_scalarValueWithoutCodecs(t *testing.T) {
+	t.Parallel()
+
+	data, err := MarshalJSON(context.Background(), map[string]any{"id": ScalarValue{Name: "UUID", Value: "a-b"}})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"id": "a-b"}`, string(data))
+
+	data, err = json.Marshal(ScalarValue{Name: "UUID", Value: "a-b"})
+	require.NoError(t, err)
+	require.JSONEq(t, `"a-b"`, string(data))
+}
@@ -0,0 +1,66 @@
+package clientv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gqlgo/gqlgenc/introspection"
+
+	"github.com/vektah/gqlparser/v2/validator"
+)
+
+// ErrSchemaDrift is returned by VerifySchemaHash when the schema of the endpoint no longer has the expected hash.
+var ErrSchemaDrift = errors.New("schema drift")
+
+// Introspect sends the introspection query to the endpoint and returns its result, decoded with
+// introspection.Query.UnmarshalJSON rather than graphqljson.
+func (c *Client) Introspect(ctx context.Context, interceptors ...RequestInterceptor) (*introspection.Query, error) {
+	var raw rawData
+	if err := c.Post(ctx, "Query", introspection.Introspection, &raw, nil, interceptors...); err != nil {
+		return nil, err
+	}
+
+	var res introspection.Query
+	if err := res.UnmarshalJSON(raw.data); err != nil {
+		return nil, fmt.Errorf("failed to decode data into response: %w", err)
+	}
+
+	return &res, nil
+}
+
+// SchemaHash introspects the schema of the endpoint and returns its introspection.SchemaHash.
+// Schemas fetched from a registry are hashed by introspection.SchemaHash directly.
+func (c *Client) SchemaHash(ctx context.Context, interceptors ...RequestInterceptor) (string, error) {
+	res, err := c.Introspect(ctx, interceptors...)
+	if err != nil {
+		return "", fmt.Errorf("introspection query failed: %w", err)
+	}
+
+	schema, err := validator.ValidateSchemaDocument(introspection.ParseIntrospectionQuery(c.BaseURL, *res))
+	if err != nil {
+		return "", fmt.Errorf("introspected schema: %w", err)
+	}
+
+	return introspection.SchemaHash(schema), nil
+}
+
+// VerifySchemaHash introspects the schema of the endpoint and returns an error wrapping ErrSchemaDrift when its hash
+// is not hash, typically the SchemaHash constant of a client generated with generate.schemaHash, so services can
+// alert when the server changed since the client was generated:
+//
+//	if err := client.Client.VerifySchemaHash(ctx, gen.SchemaHash); errors.Is(err, clientv2.ErrSchemaDrift) {
+//		logger.Warn("graphql schema drift", "err", err)
+//	}
+func (c *Client) VerifySchemaHash(ctx context.Context, hash string, interceptors ...RequestInterceptor) error {
+	live, err := c.SchemaHash(ctx, interceptors...)
+	if err != nil {
+		return err
+	}
+
+	if live != hash {
+		return fmt.Errorf("%w: generated from %s, endpoint serves %s", ErrSchemaDrift, hash, live)
+	}
+
+	return nil
+}
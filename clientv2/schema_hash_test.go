@@ -0,0 +1,43 @@
+package clientv2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gqlgo/gqlgenc/introspection"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const introspectionResponse = `{"data":{"__schema":{
+	"queryType":{"name":"Query"},
+	"types":[
+		{"kind":"OBJECT","name":"Query","fields":[{"name":"hello","args":[],"type":{"kind":"NON_NULL","ofType":{"kind":"SCALAR","name":"String"}}}],"interfaces":[]},
+		{"kind":"SCALAR","name":"String"}
+	],
+	"directives":[]
+}}}`
+
+func TestClient_VerifySchemaHash(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(introspectionResponse))
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(http.DefaultClient, server.URL, nil)
+
+	generated := introspection.SchemaHash(gqlparser.MustLoadSchema(&ast.Source{Input: "type Query { hello: String! }"}))
+	require.NoError(t, c.VerifySchemaHash(context.Background(), generated))
+
+	changed := introspection.SchemaHash(gqlparser.MustLoadSchema(&ast.Source{Input: "type Query { hello: String }"}))
+	err := c.VerifySchemaHash(context.Background(), changed)
+	require.ErrorIs(t, err, ErrSchemaDrift)
+	require.ErrorContains(t, err, "generated from "+changed+", endpoint serves "+generated)
+}
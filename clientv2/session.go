@@ -0,0 +1,91 @@
+package clientv2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// WithCookieJar returns an interceptor that sends the cookies of jar with every request and
+// stores the cookies set by every response, so session based backends work with any HttpClient.
+// Do not combine it with an *http.Client that already has a Jar.
+func WithCookieJar(jar http.CookieJar) RequestInterceptor {
+	return func(ctx context.Context, req *http.Request, gqlInfo *GQLRequestInfo, res any, next RequestInterceptorFunc) error {
+		for _, cookie := range jar.Cookies(req.URL) {
+			req.AddCookie(cookie)
+		}
+
+		err := next(ctx, req, gqlInfo, res)
+
+		if gqlInfo.Response != nil {
+			cookies := (&http.Response{Header: gqlInfo.Response.Header}).Cookies()
+			if len(cookies) > 0 {
+				jar.SetCookies(req.URL, cookies)
+			}
+		}
+
+		return err
+	}
+}
+
+// CSRFTokenFunc fetches a fresh CSRF token, typically by calling a bootstrap endpoint of the backend.
+type CSRFTokenFunc func(ctx context.Context) (string, error)
+
+// WithCSRFToken returns an interceptor that sends a CSRF token in the header named header.
+// The token is fetched with fetch on the first request and reused afterwards. When the server
+// answers 403 Forbidden the token is fetched again and the request is retried once.
+func WithCSRFToken(header string, fetch CSRFTokenFunc) RequestInterceptor {
+	var (
+		mu    sync.Mutex
+		token string
+	)
+
+	currentToken := func(ctx context.Context, stale string) (string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if token != "" && token != stale {
+			return token, nil
+		}
+
+		t, err := fetch(ctx)
+		if err != nil {
+			return "", fmt.Errorf("fetch csrf token: %w", err)
+		}
+
+		token = t
+
+		return token, nil
+	}
+
+	return func(ctx context.Context, req *http.Request, gqlInfo *GQLRequestInfo, res any, next RequestInterceptorFunc) error {
+		t, err := currentToken(ctx, "")
+		if err != nil {
+			return err
+		}
+
+		req.Header.Set(header, t)
+
+		err = next(ctx, req, gqlInfo, res)
+		if gqlInfo.Response == nil || gqlInfo.Response.StatusCode != http.StatusForbidden || req.GetBody == nil {
+			return err
+		}
+
+		t, err = currentToken(ctx, t)
+		if err != nil {
+			return err
+		}
+
+		retry := req.Clone(ctx)
+
+		retry.Body, err = req.GetBody()
+		if err != nil {
+			return fmt.Errorf("failed to rewind request body: %w", err)
+		}
+
+		retry.Header.Set(header, t)
+
+		return next(ctx, retry, gqlInfo, res)
+	}
+}
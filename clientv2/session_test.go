@@ -0,0 +1,83 @@
+package clientv2
+
+import (
+	"context"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithCookieJar(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := r.Cookie("session"); err != nil {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "s1"})
+			_, _ = w.Write([]byte(`{"data":{"something": "new session"}}`))
+
+			return
+		}
+
+		_, _ = w.Write([]byte(validData))
+	}))
+	t.Cleanup(server.Close)
+
+	jar, err := cookiejar.New(nil)
+	require.NoError(t, err)
+
+	c := NewClient(http.DefaultClient, server.URL, nil, WithCookieJar(jar))
+
+	var first fakeRes
+	require.NoError(t, c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &first, nil))
+	require.Equal(t, "new session", first.Something)
+
+	var second fakeRes
+	require.NoError(t, c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &second, nil))
+	require.Equal(t, "some data", second.Something)
+}
+
+func TestWithCSRFToken(t *testing.T) {
+	t.Parallel()
+
+	var (
+		fetches atomic.Int32
+		valid   atomic.Value
+	)
+
+	valid.Store("token-1")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-CSRF-Token") != valid.Load() {
+			w.WriteHeader(http.StatusForbidden)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(validData))
+	}))
+	t.Cleanup(server.Close)
+
+	fetch := func(context.Context) (string, error) {
+		return "token-" + strconv.Itoa(int(fetches.Add(1))), nil
+	}
+
+	c := NewClient(http.DefaultClient, server.URL, nil, WithCSRFToken("X-CSRF-Token", fetch))
+
+	var first fakeRes
+	require.NoError(t, c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &first, nil))
+	require.Equal(t, "some data", first.Something)
+	require.Equal(t, int32(1), fetches.Load())
+
+	// the server rotates the token, so the next request is retried with a fresh one
+	valid.Store("token-2")
+
+	var second fakeRes
+	require.NoError(t, c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &second, nil))
+	require.Equal(t, "some data", second.Something)
+	require.Equal(t, int32(2), fetches.Load())
+}
@@ -0,0 +1,211 @@
+package clientv2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+
+	"github.com/gqlgo/gqlgenc/graphqljson"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// errStreamStopped ends the decoding of a stream whose consumer stopped ranging over it.
+var errStreamStopped = errors.New("stream stopped")
+
+// listStream is passed to the interceptors as the response of PostStream. Instead of reading the whole
+// response body, do decodes the list at path of the response data and passes each raw element to each.
+type listStream struct {
+	path []string
+	// each returns false to stop the decoding.
+	each func(element json.RawMessage) (bool, error)
+	// stopped is set when each stopped the decoding.
+	stopped bool
+}
+
+// PostStream sends an operation like Post, then decodes the list at path in the response data one
+// element at a time, so responses with huge lists are never materialized as a whole:
+//
+//	for user, err := range clientv2.PostStream[*ListUsers_Users](ctx, c, "ListUsers", ListUsersDocument, vars, []string{"users"}) {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+//
+// path lists the JSON keys leading to the list from the data object, the aliases of the selected fields.
+// Elements already yielded are not taken back when a GraphQL error or a decoding error follows them.
+// Response extensions and raw bodies are not recorded, and a CustomDo of the client receives the
+// stream as its response, which it must not decode into.
+func PostStream[T any](ctx context.Context, c *Client, operationName, query string, vars map[string]any, path []string, interceptors ...RequestInterceptor) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		stream := &listStream{
+			path: path,
+			each: func(element json.RawMessage) (bool, error) {
+				var item T
+				if err := graphqljson.UnmarshalData(element, &item, c.decodeOptions()...); err != nil {
+					return false, fmt.Errorf("failed to decode list element %s: %w", string(element), err)
+				}
+
+				return yield(item, nil), nil
+			},
+		}
+
+		err := c.Post(ctx, operationName, query, stream, vars, interceptors...)
+		if err != nil && !stream.stopped {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
+
+// decode decodes a response body, stopping at the first error unless parseDataWhenErrors is set.
+func (s *listStream) decode(body io.Reader, parseDataWhenErrors bool) error {
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+
+	if err := expectDelim(decoder, '{'); err != nil {
+		return err
+	}
+
+	var gqlErrors gqlerror.List
+
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+
+		switch key {
+		case "data":
+			err = s.decodeData(decoder, s.path)
+		case "errors":
+			err = decoder.Decode(&gqlErrors)
+			if err == nil && len(gqlErrors) > 0 && !parseDataWhenErrors {
+				return &ErrorResponse{GqlErrors: &gqlErrors}
+			}
+		default:
+			err = decoder.Decode(&json.RawMessage{})
+		}
+
+		if errors.Is(err, errStreamStopped) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+
+	if len(gqlErrors) > 0 {
+		return &ErrorResponse{GqlErrors: &gqlErrors}
+	}
+
+	return nil
+}
+
+// decodeData decodes the value at path of the JSON value read by decoder, skipping everything else.
+func (s *listStream) decodeData(decoder *json.Decoder, path []string) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	if token == nil {
+		return nil
+	}
+
+	if len(path) == 0 {
+		if token != json.Delim('[') {
+			return fmt.Errorf("expected a list, got %v", token)
+		}
+
+		for decoder.More() {
+			var element json.RawMessage
+			if err := decoder.Decode(&element); err != nil {
+				return err
+			}
+
+			ok, err := s.each(element)
+			if err != nil {
+				return err
+			}
+
+			if !ok {
+				s.stopped = true
+
+				return errStreamStopped
+			}
+		}
+
+		_, err = decoder.Token()
+
+		return err
+	}
+
+	if token != json.Delim('{') {
+		return fmt.Errorf("expected an object at %s, got %v", path[0], token)
+	}
+
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		if key == path[0] {
+			err = s.decodeData(decoder, path[1:])
+		} else {
+			err = decoder.Decode(&json.RawMessage{})
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = decoder.Token()
+
+	return err
+}
+
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if token != delim {
+		return fmt.Errorf("failed to decode response: expected %v, got %v", delim, token)
+	}
+
+	return nil
+}
+
+// streamResponse decodes the body of a response to a PostStream operation.
+func (c *Client) streamResponse(body io.Reader, httpCode int, header http.Header, stream *listStream) error {
+	if httpCode < 200 || 299 < httpCode {
+		content, err := io.ReadAll(body)
+		if err != nil {
+			return fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		errResponse := &ErrorResponse{
+			NetworkError: newHTTPError(httpCode, header, content),
+		}
+
+		// some servers return a graphql error with a non OK http code
+		var gqlErr GqlErrorList
+		if json.Unmarshal(content, &gqlErr) == nil && len(gqlErr.Errors) > 0 {
+			errResponse.GqlErrors = &gqlErr.Errors
+		}
+
+		return errResponse
+	}
+
+	return stream.decode(body, c.ParseDataWhenErrors)
+}
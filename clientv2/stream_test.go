@@ -0,0 +1,123 @@
+package clientv2
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type streamedUser struct {
+	ID   string `json:"id" graphql:"id"`
+	Name string `json:"name" graphql:"name"`
+}
+
+func newStreamServer(t *testing.T, status int, body string) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return NewClient(http.DefaultClient, server.URL, nil)
+}
+
+func TestPostStream(t *testing.T) {
+	t.Parallel()
+
+	t.Run("decodes each element of the list", func(t *testing.T) {
+		t.Parallel()
+
+		c := newStreamServer(t, http.StatusOK, `{"extensions":{"cost":1},"data":{"other":[1],"page":{"users":[{"id":"1","name":"a"},{"id":"2","name":"b"}],"total":2}}}`)
+
+		var users []*streamedUser
+		for user, err := range PostStream[*streamedUser](context.Background(), c, "ListUsers", "query ListUsers { ... }", nil, []string{"page", "users"}) {
+			require.NoError(t, err)
+
+			users = append(users, user)
+		}
+
+		require.Equal(t, []*streamedUser{{ID: "1", Name: "a"}, {ID: "2", Name: "b"}}, users)
+	})
+
+	t.Run("stops when the consumer breaks", func(t *testing.T) {
+		t.Parallel()
+
+		c := newStreamServer(t, http.StatusOK, `{"data":{"users":[{"id":"1"},{"id":"2"},{"id":"3"}]}}`)
+
+		var ids []string
+		for user, err := range PostStream[streamedUser](context.Background(), c, "ListUsers", "query ListUsers { ... }", nil, []string{"users"}) {
+			require.NoError(t, err)
+
+			ids = append(ids, user.ID)
+			if len(ids) == 2 {
+				break
+			}
+		}
+
+		require.Equal(t, []string{"1", "2"}, ids)
+	})
+
+	t.Run("null list yields nothing", func(t *testing.T) {
+		t.Parallel()
+
+		c := newStreamServer(t, http.StatusOK, `{"data":{"users":null}}`)
+
+		for range PostStream[streamedUser](context.Background(), c, "ListUsers", "query ListUsers { ... }", nil, []string{"users"}) {
+			t.Fatal("unexpected element")
+		}
+	})
+
+	t.Run("yields graphql errors", func(t *testing.T) {
+		t.Parallel()
+
+		c := newStreamServer(t, http.StatusOK, `{"errors":[{"message":"forbidden"}],"data":{"users":[{"id":"1"}]}}`)
+
+		var errs []error
+		for _, err := range PostStream[streamedUser](context.Background(), c, "ListUsers", "query ListUsers { ... }", nil, []string{"users"}) {
+			errs = append(errs, err)
+		}
+
+		require.Len(t, errs, 1)
+
+		var errResponse *ErrorResponse
+		require.True(t, errors.As(errs[0], &errResponse))
+		require.Equal(t, "forbidden", (*errResponse.GqlErrors)[0].Message)
+	})
+
+	t.Run("yields network errors", func(t *testing.T) {
+		t.Parallel()
+
+		c := newStreamServer(t, http.StatusBadGateway, `bad gateway`)
+
+		var errs []error
+		for _, err := range PostStream[streamedUser](context.Background(), c, "ListUsers", "query ListUsers { ... }", nil, []string{"users"}) {
+			errs = append(errs, err)
+		}
+
+		require.Len(t, errs, 1)
+
+		var errResponse *ErrorResponse
+		require.True(t, errors.As(errs[0], &errResponse))
+		require.Equal(t, http.StatusBadGateway, errResponse.NetworkError.Code)
+	})
+
+	t.Run("yields an error when the path is not a list", func(t *testing.T) {
+		t.Parallel()
+
+		c := newStreamServer(t, http.StatusOK, `{"data":{"users":{"id":"1"}}}`)
+
+		var errs []error
+		for _, err := range PostStream[streamedUser](context.Background(), c, "ListUsers", "query ListUsers { ... }", nil, []string{"users"}) {
+			errs = append(errs, err)
+		}
+
+		require.Len(t, errs, 1)
+		require.ErrorContains(t, errs[0], "expected a list")
+	})
+}
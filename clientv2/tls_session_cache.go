@@ -0,0 +1,17 @@
+//go:build !tinygo
+
+package clientv2
+
+import (
+	"crypto/tls"
+	"net/http"
+)
+
+// setTLSSessionCache makes transport keep size TLS sessions for resumption.
+func setTLSSessionCache(transport *http.Transport, size int) {
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+
+	transport.TLSClientConfig.ClientSessionCache = tls.NewLRUClientSessionCache(size)
+}
@@ -0,0 +1,8 @@
+//go:build tinygo
+
+package clientv2
+
+import "net/http"
+
+// setTLSSessionCache does nothing, since the crypto/tls of TinyGo has no session cache.
+func setTLSSessionCache(*http.Transport, int) {}
@@ -0,0 +1,169 @@
+package clientv2
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// RedactedValue replaces values whose key is matched by a Redactor.
+const RedactedValue = "[REDACTED]"
+
+// Redactor reports whether the value of the object key must be hidden from transcripts.
+// It is applied to every key of the variables and of the response data, at any depth.
+type Redactor func(key string) bool
+
+// RedactKeys returns a Redactor hiding the given keys, ignoring case.
+func RedactKeys(keys ...string) Redactor {
+	redacted := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		redacted[strings.ToLower(key)] = true
+	}
+
+	return func(key string) bool {
+		return redacted[strings.ToLower(key)]
+	}
+}
+
+// SensitiveFields are the paths of the values of an operation hidden from transcripts whatever their key.
+// A path is a list of object keys separated by dots, such as "input.password", and applies to every element
+// of the lists it goes through.
+type SensitiveFields struct {
+	// Variables are the paths of the sensitive values in the variables.
+	Variables []string
+	// Response are the paths of the sensitive values in the response data, by response key.
+	Response []string
+}
+
+type sensitiveFieldsKey struct{}
+
+// WithSensitiveFields returns a context hiding fields from the transcripts of the operations it is used for.
+// Clients generated with generate.redactSensitive set it for the fields marked @sensitive.
+func WithSensitiveFields(ctx context.Context, fields SensitiveFields) context.Context {
+	return context.WithValue(ctx, sensitiveFieldsKey{}, fields)
+}
+
+// SensitiveFieldsFromContext returns the sensitive fields set by WithSensitiveFields.
+func SensitiveFieldsFromContext(ctx context.Context) SensitiveFields {
+	fields, _ := ctx.Value(sensitiveFieldsKey{}).(SensitiveFields)
+
+	return fields
+}
+
+// RedactVariables returns the JSON representation of vars with the sensitive fields of ctx replaced with
+// RedactedValue, for OperationHooks logging the variables of operations.
+func RedactVariables(ctx context.Context, vars map[string]any) any {
+	return redactTranscriptValue(ctx, vars, nil, SensitiveFieldsFromContext(ctx).Variables)
+}
+
+// RedactResponse returns the JSON representation of res with the sensitive fields of ctx replaced with
+// RedactedValue, for OperationHooks logging the responses of operations.
+func RedactResponse(ctx context.Context, res any) any {
+	return redactTranscriptValue(ctx, res, nil, SensitiveFieldsFromContext(ctx).Response)
+}
+
+// NewTranscriptInterceptor returns an interceptor that logs the document, variables and response
+// of every operation to logger at debug level. Values matched by redact, and the SensitiveFields of the
+// context, are replaced with RedactedValue, so transcripts are safe to store. redact may be nil.
+func NewTranscriptInterceptor(logger *slog.Logger, redact Redactor) RequestInterceptor {
+	return func(ctx context.Context, req *http.Request, gqlInfo *GQLRequestInfo, res any, next RequestInterceptorFunc) error {
+		if !logger.Enabled(ctx, slog.LevelDebug) {
+			return next(ctx, req, gqlInfo, res)
+		}
+
+		start := time.Now()
+		err := next(ctx, req, gqlInfo, res)
+
+		sensitive := SensitiveFieldsFromContext(ctx)
+
+		attrs := []slog.Attr{
+			slog.String("operation", gqlInfo.Request.OperationName),
+			slog.String("query", gqlInfo.Request.Query),
+			slog.Any("variables", redactTranscriptValue(ctx, gqlInfo.Request.Variables, redact, sensitive.Variables)),
+			slog.Duration("duration", time.Since(start)),
+		}
+
+		if gqlInfo.Response != nil {
+			attrs = append(attrs, slog.Int("status", gqlInfo.Response.StatusCode))
+		}
+
+		attrs = append(attrs, slog.Any("response", redactTranscriptValue(ctx, res, redact, sensitive.Response)))
+
+		if err != nil {
+			attrs = append(attrs, slog.String("error", err.Error()))
+		}
+
+		logger.LogAttrs(ctx, slog.LevelDebug, "graphql transcript", attrs...)
+
+		return err
+	}
+}
+
+// redactTranscriptValue converts v to its JSON representation and redacts the keys matched by redact and the
+// values at paths.
+func redactTranscriptValue(ctx context.Context, v any, redact Redactor, paths []string) any {
+	data, err := MarshalJSON(ctx, v)
+	if err != nil {
+		return "unable to encode: " + err.Error()
+	}
+
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return "unable to decode: " + err.Error()
+	}
+
+	for _, path := range paths {
+		decoded = redactJSONPath(decoded, strings.Split(path, "."))
+	}
+
+	if redact == nil {
+		return decoded
+	}
+
+	return redactJSONValue(decoded, redact)
+}
+
+// redactJSONPath replaces the values at the path of keys in v, going through lists.
+func redactJSONPath(v any, keys []string) any {
+	switch v := v.(type) {
+	case map[string]any:
+		value, ok := v[keys[0]]
+		if !ok {
+			return v
+		}
+
+		if len(keys) == 1 {
+			v[keys[0]] = RedactedValue
+		} else {
+			v[keys[0]] = redactJSONPath(value, keys[1:])
+		}
+	case []any:
+		for i, value := range v {
+			v[i] = redactJSONPath(value, keys)
+		}
+	}
+
+	return v
+}
+
+func redactJSONValue(v any, redact Redactor) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if redact(key) {
+				v[key] = RedactedValue
+			} else {
+				v[key] = redactJSONValue(value, redact)
+			}
+		}
+	case []any:
+		for i, value := range v {
+			v[i] = redactJSONValue(value, redact)
+		}
+	}
+
+	return v
+}
@@ -0,0 +1,106 @@
+package clientv2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTranscriptInterceptor(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"login":{"user":"alice","token":"secret-token"}}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	var buf bytes.Buffer
+
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	c := NewClient(http.DefaultClient, server.URL, nil, NewTranscriptInterceptor(logger, RedactKeys("password", "Token")))
+
+	var res struct {
+		Login struct {
+			User  string `json:"user"`
+			Token string `json:"token"`
+		} `json:"login"`
+	}
+
+	vars := map[string]any{"input": map[string]any{"user": "alice", "password": "hunter2"}}
+	require.NoError(t, c.Post(context.Background(), "Login", "mutation Login($input: LoginInput!) { login(input: $input) { user token } }", &res, vars))
+	require.Equal(t, "secret-token", res.Login.Token, "redaction must not touch the result")
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	require.Equal(t, "graphql transcript", entry["msg"])
+	require.Equal(t, "Login", entry["operation"])
+	require.InDelta(t, http.StatusOK, entry["status"], 0)
+	require.Equal(t, map[string]any{"input": map[string]any{"user": "alice", "password": RedactedValue}}, entry["variables"])
+	require.Equal(t, map[string]any{"login": map[string]any{"user": "alice", "token": RedactedValue}}, entry["response"])
+	require.NotContains(t, buf.String(), "hunter2")
+	require.NotContains(t, buf.String(), "secret-token")
+}
+
+func TestNewTranscriptInterceptor_disabled(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(validData))
+	}))
+	t.Cleanup(server.Close)
+
+	var buf bytes.Buffer
+
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+	c := NewClient(http.DefaultClient, server.URL, nil, NewTranscriptInterceptor(logger, nil))
+
+	var res fakeRes
+	require.NoError(t, c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil))
+	require.Empty(t, buf.String())
+}
+
+func TestNewTranscriptInterceptor_sensitiveFields(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"users":[{"name":"alice","token":"token-a"},{"name":"bob","token":"token-b"}]}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	var buf bytes.Buffer
+
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	c := NewClient(http.DefaultClient, server.URL, nil, NewTranscriptInterceptor(logger, nil))
+
+	var res struct {
+		Users []struct {
+			Name  string `json:"name"`
+			Token string `json:"token"`
+		} `json:"users"`
+	}
+
+	ctx := WithSensitiveFields(context.Background(), SensitiveFields{
+		Variables: []string{"filter.secret", "missing.key"},
+		Response:  []string{"users.token"},
+	})
+	vars := map[string]any{"filter": map[string]any{"name": "alice", "secret": "hunter2"}}
+	require.NoError(t, c.Post(ctx, "Users", "query Users($filter: Filter!) { users(filter: $filter) { name token } }", &res, vars))
+	require.Equal(t, "token-b", res.Users[1].Token, "redaction must not touch the result")
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	require.Equal(t, map[string]any{"filter": map[string]any{"name": "alice", "secret": RedactedValue}}, entry["variables"])
+	require.Equal(t, map[string]any{"users": []any{
+		map[string]any{"name": "alice", "token": RedactedValue},
+		map[string]any{"name": "bob", "token": RedactedValue},
+	}}, entry["response"])
+
+	require.Equal(t, map[string]any{"filter": map[string]any{"name": "alice", "secret": RedactedValue}}, RedactVariables(ctx, vars))
+	require.Equal(t, map[string]any{"filter": map[string]any{"name": "alice", "secret": "hunter2"}}, RedactVariables(context.Background(), vars))
+}
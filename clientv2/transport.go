@@ -0,0 +1,74 @@
+package clientv2
+
+import (
+	"net/http"
+	"time"
+)
+
+// TransportPreset holds the connection settings of an http.Transport for a traffic profile.
+type TransportPreset struct {
+	MaxIdleConns        int
+	MaxIdleConnsPerHost int
+	IdleConnTimeout     time.Duration
+	// TLSSessionCacheSize is the number of TLS sessions kept for resumption, 0 disables the cache. It is ignored
+	// when built with TinyGo, whose crypto/tls does not resume sessions.
+	TLSSessionCacheSize int
+}
+
+// HighQPSTransportPreset keeps enough idle connections to a single GraphQL endpoint to avoid
+// reconnecting under load, and resumes TLS sessions when connections have to be recreated.
+var HighQPSTransportPreset = TransportPreset{
+	MaxIdleConns:        512,
+	MaxIdleConnsPerHost: 128,
+	IdleConnTimeout:     90 * time.Second,
+	TLSSessionCacheSize: 256,
+}
+
+// Transport returns a clone of http.DefaultTransport tuned with the preset.
+func (p TransportPreset) Transport() *http.Transport {
+	transport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		transport = &http.Transport{}
+	}
+
+	transport = transport.Clone()
+	transport.MaxIdleConns = p.MaxIdleConns
+	transport.MaxIdleConnsPerHost = p.MaxIdleConnsPerHost
+	transport.IdleConnTimeout = p.IdleConnTimeout
+
+	if p.TLSSessionCacheSize > 0 {
+		setTLSSessionCache(transport, p.TLSSessionCacheSize)
+	}
+
+	return transport
+}
+
+// HTTPClient returns an *http.Client using the preset's Transport, to be passed to NewClient.
+// A zero timeout means no timeout.
+func (p TransportPreset) HTTPClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Transport: p.Transport(),
+		Timeout:   timeout,
+	}
+}
+
+// withTransport returns client sending its requests through transport. An *http.Client is copied with
+// transport, keeping its Timeout, CheckRedirect and Jar, and a nil client becomes an http.Client using
+// transport. Other HttpClient implementations do not expose their transport, so they are returned as is.
+func withTransport(client HttpClient, transport http.RoundTripper) HttpClient {
+	switch hc := client.(type) {
+	case nil:
+		return &http.Client{Transport: transport}
+	case *http.Client:
+		if hc == nil {
+			return &http.Client{Transport: transport}
+		}
+
+		withTransport := *hc
+		withTransport.Transport = transport
+
+		return &withTransport
+	default:
+		return client
+	}
+}
@@ -0,0 +1,81 @@
+package clientv2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransportPreset(t *testing.T) {
+	t.Parallel()
+
+	client := HighQPSTransportPreset.HTTPClient(10 * time.Second)
+	require.Equal(t, 10*time.Second, client.Timeout)
+
+	transport, ok := client.Transport.(*http.Transport)
+	require.True(t, ok)
+	require.Equal(t, 512, transport.MaxIdleConns)
+	require.Equal(t, 128, transport.MaxIdleConnsPerHost)
+	require.Equal(t, 90*time.Second, transport.IdleConnTimeout)
+	require.NotNil(t, transport.TLSClientConfig.ClientSessionCache)
+	require.NotSame(t, http.DefaultTransport, transport)
+
+	noCache := TransportPreset{MaxIdleConnsPerHost: 4}.Transport()
+	require.True(t, noCache.TLSClientConfig == nil || noCache.TLSClientConfig.ClientSessionCache == nil)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestOptions_Transport(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/moved" {
+			http.Redirect(w, r, "/graphql", http.StatusTemporaryRedirect)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(validData))
+	}))
+	t.Cleanup(server.Close)
+
+	var sent []string
+
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		sent = append(sent, req.URL.Path)
+		req.Header.Set("X-Middleware", "1")
+
+		return http.DefaultTransport.RoundTrip(req)
+	})
+
+	httpClient := &http.Client{Timeout: 5 * time.Second}
+	c := NewClient(httpClient, server.URL+"/graphql", &Options{Transport: transport})
+
+	var res fakeRes
+	require.NoError(t, c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil))
+	require.Equal(t, []string{"/graphql"}, sent)
+
+	composed, ok := c.Client.(*http.Client)
+	require.True(t, ok)
+	require.Equal(t, 5*time.Second, composed.Timeout)
+	require.Nil(t, httpClient.Transport, "the http.Client passed to NewClient is not modified")
+
+	// the redirect policy still applies
+	c = NewClient(httpClient, server.URL+"/moved", &Options{Transport: transport})
+	err := c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil)
+	require.ErrorIs(t, err, ErrRedirect)
+
+	// a nil HttpClient uses the transport
+	c = NewClient(nil, server.URL+"/graphql", &Options{Transport: transport})
+	require.NoError(t, c.Post(context.Background(), "GetSomething", "query GetSomething { something }", &res, nil))
+	require.Equal(t, []string{"/graphql", "/moved", "/graphql"}, sent)
+}
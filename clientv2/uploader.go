@@ -0,0 +1,92 @@
+package clientv2
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// Uploader uploads the file of an Upload variable before its operation is sent and returns the value sent in
+// its place, such as the handle of the stored file, for backends taking files out of band rather than in
+// multipart requests.
+type Uploader func(ctx context.Context, upload graphql.Upload) (any, error)
+
+// SignedURLFunc returns the signed URL upload is sent to, usually obtained from the backend, and the handle
+// sent in place of upload in the variables of the operation.
+type SignedURLFunc func(ctx context.Context, upload graphql.Upload) (url string, handle any, err error)
+
+// NewSignedURLUploader returns an Uploader that PUTs each file with its Content-Type to the URL returned by sign
+// through client, and replaces it with the handle returned by sign once the upload succeeded.
+func NewSignedURLUploader(client HttpClient, sign SignedURLFunc) Uploader {
+	return func(ctx context.Context, upload graphql.Upload) (any, error) {
+		url, handle, err := sign(ctx, upload)
+		if err != nil {
+			return nil, fmt.Errorf("sign %s: %w", upload.Filename, err)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, upload.File)
+		if err != nil {
+			return nil, fmt.Errorf("create upload request of %s: %w", upload.Filename, err)
+		}
+
+		if upload.Size > 0 {
+			req.ContentLength = upload.Size
+		}
+
+		if upload.ContentType != "" {
+			req.Header.Set("Content-Type", upload.ContentType)
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("upload %s: %w", upload.Filename, err)
+		}
+		defer drainAndClose(resp.Body)
+
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			body, _ := io.ReadAll(io.LimitReader(resp.Body, httpErrorBodyLimit))
+
+			return nil, fmt.Errorf("upload %s: %w", upload.Filename, newHTTPError(resp.StatusCode, resp.Header, body))
+		}
+
+		return handle, nil
+	}
+}
+
+// uploadVariables returns vars with the uploads of its variables replaced by the values the Uploader of the
+// client returns for them. The variables of the caller are not modified.
+func (c *Client) uploadVariables(ctx context.Context, vars map[string]any) (map[string]any, error) {
+	sent := maps.Clone(vars)
+
+	for k, v := range vars {
+		var err error
+
+		switch item := v.(type) {
+		case graphql.Upload:
+			sent[k], err = c.Uploader(ctx, item)
+		case *graphql.Upload:
+			if item != nil {
+				sent[k], err = c.Uploader(ctx, *item)
+			}
+		case []*graphql.Upload:
+			handles := make([]any, len(item))
+			for i, upload := range item {
+				if handles[i], err = c.Uploader(ctx, *upload); err != nil {
+					break
+				}
+			}
+
+			sent[k] = handles
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("variable %s: %w", k, err)
+		}
+	}
+
+	return sent, nil
+}
@@ -0,0 +1,91 @@
+package clientv2
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSignedURLUploader(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu     sync.Mutex
+		stored = map[string]string{}
+	)
+
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Query().Get("signature") != "ok" {
+			w.WriteHeader(http.StatusForbidden)
+
+			return
+		}
+
+		content, _ := io.ReadAll(r.Body)
+
+		mu.Lock()
+		stored[r.URL.Path] = r.Header.Get("Content-Type") + ":" + string(content)
+		mu.Unlock()
+	}))
+	t.Cleanup(storage.Close)
+
+	var request Request
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "application/json; charset=utf-8", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		_, _ = w.Write([]byte(validData))
+	}))
+	t.Cleanup(server.Close)
+
+	sign := func(ctx context.Context, upload graphql.Upload) (string, any, error) {
+		signature := "ok"
+		if upload.Filename == "denied.txt" {
+			signature = "expired"
+		}
+
+		return storage.URL + "/" + upload.Filename + "?signature=" + signature, "files/" + upload.Filename, nil
+	}
+
+	c := NewClient(http.DefaultClient, server.URL, &Options{Uploader: NewSignedURLUploader(http.DefaultClient, sign)})
+
+	vars := map[string]any{
+		"avatar": &graphql.Upload{Filename: "avatar.png", ContentType: "image/png", File: strings.NewReader("png")},
+		"attachments": []*graphql.Upload{
+			{Filename: "a.txt", File: strings.NewReader("a")},
+			{Filename: "b.txt", File: strings.NewReader("b")},
+		},
+		"cover": (*graphql.Upload)(nil),
+	}
+
+	var res fakeRes
+	require.NoError(t, c.Post(context.Background(), "Upload", "mutation Upload($avatar: Upload, $attachments: [Upload!]!, $cover: Upload) { upload }", &res, vars))
+
+	require.Equal(t, map[string]any{
+		"avatar":      "files/avatar.png",
+		"attachments": []any{"files/a.txt", "files/b.txt"},
+		"cover":       nil,
+	}, request.Variables)
+	require.Equal(t, map[string]string{
+		"/avatar.png": "image/png:png",
+		"/a.txt":      ":a",
+		"/b.txt":      ":b",
+	}, stored)
+	require.IsType(t, &graphql.Upload{}, vars["avatar"], "the variables of the caller must not be modified")
+
+	err := c.Post(context.Background(), "Upload", "mutation Upload($avatar: Upload) { upload }", &res, map[string]any{
+		"avatar": graphql.Upload{Filename: "denied.txt", File: strings.NewReader("denied")},
+	})
+	require.ErrorContains(t, err, "Upload: upload: variable avatar: upload denied.txt: http status 403")
+
+	var httpErr *HTTPError
+	require.ErrorAs(t, err, &httpErr)
+}
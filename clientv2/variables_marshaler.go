@@ -0,0 +1,70 @@
+package clientv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// VariablesMarshaler encodes the variables of an operation, for servers that require specific
+// encodings such as int64 as strings.
+type VariablesMarshaler interface {
+	MarshalVariables(ctx context.Context, vars map[string]any) ([]byte, error)
+}
+
+// VariablesMarshalerFunc is a function implementing VariablesMarshaler.
+type VariablesMarshalerFunc func(ctx context.Context, vars map[string]any) ([]byte, error)
+
+func (f VariablesMarshalerFunc) MarshalVariables(ctx context.Context, vars map[string]any) ([]byte, error) {
+	return f(ctx, vars)
+}
+
+// DefaultVariablesMarshaler encodes variables with MarshalJSON, honoring gqlgen marshalers and
+// omittable fields. Custom marshalers can wrap it to only adjust some values.
+var DefaultVariablesMarshaler VariablesMarshaler = VariablesMarshalerFunc(func(ctx context.Context, vars map[string]any) ([]byte, error) {
+	return MarshalJSON(ctx, vars)
+})
+
+// encodedRequest is a Request whose variables are already encoded.
+type encodedRequest struct {
+	Query         string          `json:"query"`
+	Variables     json.RawMessage `json:"variables,omitempty"`
+	OperationName string          `json:"operationName,omitempty"`
+	Extensions    map[string]any  `json:"extensions,omitempty"`
+}
+
+// encodeRequest encodes r, using the client's VariablesMarshaler for the variables and its RequestFieldNames when set.
+func (c *Client) encodeRequest(ctx context.Context, r *Request) ([]byte, error) {
+	ctx = withScalarCodecs(ctx, c.ScalarCodecs)
+
+	if c.VariablesMarshaler == nil && c.RequestFieldNames.isStandard() {
+		return MarshalJSON(ctx, r)
+	}
+
+	var vars json.RawMessage
+
+	if len(r.Variables) > 0 {
+		marshaler := c.VariablesMarshaler
+		if marshaler == nil {
+			marshaler = DefaultVariablesMarshaler
+		}
+
+		encoded, err := marshaler.MarshalVariables(ctx, r.Variables)
+		if err != nil {
+			return nil, fmt.Errorf("marshal variables: %w", err)
+		}
+
+		vars = encoded
+	}
+
+	if !c.RequestFieldNames.isStandard() {
+		return c.RequestFieldNames.encode(r.Query, r.OperationName, vars, r.Extensions)
+	}
+
+	return json.Marshal(&encodedRequest{
+		Query:         r.Query,
+		Variables:     vars,
+		OperationName: r.OperationName,
+		Extensions:    r.Extensions,
+	})
+}
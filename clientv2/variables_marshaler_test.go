@@ -0,0 +1,47 @@
+package clientv2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVariablesMarshaler(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(validData))
+	}))
+	t.Cleanup(server.Close)
+
+	int64AsString := VariablesMarshalerFunc(func(ctx context.Context, vars map[string]any) ([]byte, error) {
+		converted := make(map[string]any, len(vars))
+		for key, value := range vars {
+			if i, ok := value.(int64); ok {
+				value = strconv.FormatInt(i, 10)
+			}
+
+			converted[key] = value
+		}
+
+		return DefaultVariablesMarshaler.MarshalVariables(ctx, converted)
+	})
+
+	c := NewClient(http.DefaultClient, server.URL, &Options{VariablesMarshaler: int64AsString})
+
+	var (
+		bodies RawBodies
+		res    fakeRes
+	)
+
+	vars := map[string]any{"id": int64(9007199254740993), "name": "alice"}
+	require.NoError(t, c.Post(WithRawBodies(context.Background(), &bodies), "GetSomething", "query GetSomething { something }", &res, vars))
+	require.JSONEq(t, `{"query":"query GetSomething { something }","variables":{"id":"9007199254740993","name":"alice"},"operationName":"GetSomething"}`, string(bodies.Request))
+
+	require.NoError(t, c.Post(WithRawBodies(context.Background(), &bodies), "GetSomething", "query GetSomething { something }", &res, nil))
+	require.JSONEq(t, `{"query":"query GetSomething { something }","operationName":"GetSomething"}`, string(bodies.Request))
+}
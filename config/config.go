@@ -3,7 +3,11 @@ package config
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
+	"maps"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -20,6 +24,7 @@ import (
 
 	"github.com/vektah/gqlparser/v2"
 	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
 	"github.com/vektah/gqlparser/v2/validator"
 )
 
@@ -37,6 +42,33 @@ type Config struct {
 
 	Query []string `yaml:"query"`
 
+	// ClientSchemaFilename are schema files extending the schema of the server with client-only types and fields,
+	// merged into the local or remote schema queries are validated against
+	ClientSchemaFilename StringList `yaml:"clientSchema,omitempty"`
+
+	// clientSchemaSources are the contents of ClientSchemaFilename
+	clientSchemaSources []*ast.Source
+
+	// OverlaySchemaFilename are schema files adding the types and fields the server does not serve yet, merged into
+	// the local or remote schema like ClientSchemaFilename but sent to the server
+	OverlaySchemaFilename StringList `yaml:"overlaySchema,omitempty"`
+
+	// overlaySchemaSources are the contents of OverlaySchemaFilename
+	overlaySchemaSources []*ast.Source
+
+	// NextSchemaFilename are the files of the upcoming version of the schema of the server, which queries are
+	// validated against too to report the operations it breaks
+	NextSchemaFilename StringList `yaml:"nextSchema,omitempty"`
+
+	// nextSchemaSources are the contents of NextSchemaFilename
+	nextSchemaSources []*ast.Source
+
+	// Progress is where the phases of generation and the time each operation took are reported, when set
+	Progress io.Writer `yaml:"-"`
+
+	// SchemaHash is the introspection.SchemaHash of the server schema loaded by LoadSchema, without the client schema
+	SchemaHash string `yaml:"-"`
+
 	// gqlgen config struct
 	GQLConfig *config.Config `yaml:"-"`
 }
@@ -52,9 +84,14 @@ func (a StringList) Has(file string) bool {
 }
 
 // LoadConfigFromDefaultLocations looks for a config file in the specified directory, and all parent directories
-// walking up the tree. The closest config file will be returned.
+// walking up the tree. The closest config file will be returned. When there is none and the directory has
+// a schema.graphqls file, the ConventionConfig of the directory is returned.
 func LoadConfigFromDefaultLocations(dir string) (*Config, error) {
 	cfgFile, err := findCfg(dir)
+	if errors.Is(err, os.ErrNotExist) && hasConventionSchema(dir) {
+		return ConventionConfig(dir)
+	}
+
 	if err != nil {
 		return nil, fmt.Errorf("not found Config. Config could not be found. Please make sure the name of the file is correct. want={.gqlgenc.yml, gqlgenc.yml, gqlgenc.yaml}, got=%s: %w", dir, err)
 	}
@@ -62,6 +99,33 @@ func LoadConfigFromDefaultLocations(dir string) (*Config, error) {
 	return LoadConfig(cfgFile)
 }
 
+// Files and package of the zero-config mode, used when no config file is found.
+const (
+	ConventionSchemaFilename = "schema.graphqls"
+	ConventionQueryDir       = "queries"
+	ConventionPackage        = "gen"
+)
+
+// ConventionConfig returns the config of the zero-config mode for dir: the schema of dir/schema.graphqls and the
+// queries of dir/queries/*.graphql generate the models and the client into the gen package of dir.
+func ConventionConfig(dir string) (*Config, error) {
+	packageDir := filepath.Join(dir, ConventionPackage)
+
+	return completeConfig(Config{
+		SchemaFilename: StringList{filepath.Join(dir, ConventionSchemaFilename)},
+		Query:          []string{filepath.Join(dir, ConventionQueryDir, "*.graphql")},
+		Model:          config.PackageConfig{Filename: filepath.Join(packageDir, "models_gen.go"), Package: ConventionPackage},
+		Client:         config.PackageConfig{Filename: filepath.Join(packageDir, "client.go"), Package: ConventionPackage},
+		Generate:       &GenerateConfig{ClientV2: true},
+	})
+}
+
+func hasConventionSchema(dir string) bool {
+	info, err := os.Stat(filepath.Join(dir, ConventionSchemaFilename))
+
+	return err == nil && !info.IsDir()
+}
+
 // EndPointConfig are the allowed options for the 'endpoint' config
 type EndPointConfig struct {
 	URL     string            `yaml:"url"`
@@ -139,6 +203,11 @@ func LoadConfig(filename string) (*Config, error) {
 		return nil, fmt.Errorf("unable to parse config: %w", err)
 	}
 
+	return completeConfig(cfg)
+}
+
+// completeConfig checks cfg, loads its schema sources and fills in the defaults and the gqlgen config.
+func completeConfig(cfg Config) (*Config, error) {
 	if cfg.SchemaFilename != nil && cfg.Endpoint != nil {
 		return nil, fmt.Errorf("'schema' and 'endpoint' both specified. Use schema to load from a local file, use endpoint to load from a remote server (using introspection)")
 	}
@@ -147,47 +216,9 @@ func LoadConfig(filename string) (*Config, error) {
 		return nil, fmt.Errorf("neither 'schema' nor 'endpoint' specified. Use schema to load from a local file, use endpoint to load from a remote server (using introspection)")
 	}
 
-	// https://github.com/99designs/gqlgen/blob/3a31a752df764738b1f6e99408df3b169d514784/codegen/config/config.go#L120
-	files := StringList{}
-
-	for _, f := range cfg.SchemaFilename {
-		var matches []string
-
-		// for ** we want to override default globbing patterns and walk all
-		// subdirectories to match schema files.
-		if strings.Contains(f, "**") {
-			pathParts := strings.SplitN(f, "**", 2)
-			rest := strings.TrimPrefix(strings.TrimPrefix(pathParts[1], `\`), `/`)
-			// turn the rest of the glob into a regex, anchored only at the end because ** allows
-			// for any number of dirs in between and walk will let us match against the full path name
-			globRe := regexp.MustCompile(path2regex.Replace(rest) + `$`)
-
-			err := filepath.Walk(pathParts[0], func(path string, info os.FileInfo, err error) error {
-				if err != nil {
-					return err
-				}
-
-				if globRe.MatchString(strings.TrimPrefix(path, pathParts[0])) {
-					matches = append(matches, path)
-				}
-
-				return nil
-			})
-			if err != nil {
-				return nil, fmt.Errorf("failed to walk schema at root %s: %w", pathParts[0], err)
-			}
-		} else {
-			matches, err = filepath.Glob(f)
-			if err != nil {
-				return nil, fmt.Errorf("failed to glob schema filename %s: %w", f, err)
-			}
-		}
-
-		for _, m := range matches {
-			if !files.Has(m) {
-				files = append(files, m)
-			}
-		}
+	files, err := expandSchemaFilenames(cfg.SchemaFilename)
+	if err != nil {
+		return nil, err
 	}
 
 	if len(files) > 0 {
@@ -199,22 +230,39 @@ func LoadConfig(filename string) (*Config, error) {
 		models = cfg.Models
 	}
 
-	sources := []*ast.Source{}
+	sources, err := loadSchemaSources(cfg.SchemaFilename)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, filename := range cfg.SchemaFilename {
-		filename = filepath.ToSlash(filename)
+	clientSchemaFiles, err := expandSchemaFilenames(cfg.ClientSchemaFilename)
+	if err != nil {
+		return nil, fmt.Errorf("config.clientSchema: %w", err)
+	}
 
-		var (
-			err       error
-			schemaRaw []byte
-		)
+	cfg.clientSchemaSources, err = loadSchemaSources(clientSchemaFiles)
+	if err != nil {
+		return nil, fmt.Errorf("config.clientSchema: %w", err)
+	}
 
-		schemaRaw, err = os.ReadFile(filename)
-		if err != nil {
-			return nil, fmt.Errorf("unable to open schema: %w", err)
-		}
+	overlaySchemaFiles, err := expandSchemaFilenames(cfg.OverlaySchemaFilename)
+	if err != nil {
+		return nil, fmt.Errorf("config.overlaySchema: %w", err)
+	}
+
+	cfg.overlaySchemaSources, err = loadSchemaSources(overlaySchemaFiles)
+	if err != nil {
+		return nil, fmt.Errorf("config.overlaySchema: %w", err)
+	}
 
-		sources = append(sources, &ast.Source{Name: filename, Input: string(schemaRaw)})
+	nextSchemaFiles, err := expandSchemaFilenames(cfg.NextSchemaFilename)
+	if err != nil {
+		return nil, fmt.Errorf("config.nextSchema: %w", err)
+	}
+
+	cfg.nextSchemaSources, err = loadSchemaSources(nextSchemaFiles)
+	if err != nil {
+		return nil, fmt.Errorf("config.nextSchema: %w", err)
 	}
 
 	structFieldsAlwaysPointers := true
@@ -268,9 +316,220 @@ func LoadConfig(filename string) (*Config, error) {
 		return nil, fmt.Errorf("config.exec: %w", err)
 	}
 
+	if interfacePackage := cfg.Generate.ClientInterfacePackage; interfacePackage != nil {
+		if cfg.Generate.ClientInterfaceName == nil {
+			return nil, errors.New("config.generate.clientInterfacePackage: clientInterfaceName must be specified")
+		}
+
+		err = interfacePackage.Check()
+		if err != nil {
+			return nil, fmt.Errorf("config.generate.clientInterfacePackage: %w", err)
+		}
+
+		if interfacePackage.Dir() == cfg.Client.Dir() {
+			return nil, errors.New("config.generate.clientInterfacePackage: must be another directory than client")
+		}
+	}
+
+	if defaultClient := cfg.Generate.GetDefaultClient(); defaultClient != nil {
+		if err := defaultClient.Check(); err != nil {
+			return nil, fmt.Errorf("config.generate.defaultClient: %w", err)
+		}
+	}
+
+	endpointClients := cfg.Generate.GetEndpointClients()
+	for _, name := range slices.Sorted(maps.Keys(endpointClients)) {
+		endpointClient := endpointClients[name]
+		if endpointClient == nil {
+			return nil, fmt.Errorf("config.generate.endpointClients.%s: endpoint or endpointEnv must be specified", name)
+		}
+
+		if err := endpointClient.Check(); err != nil {
+			return nil, fmt.Errorf("config.generate.endpointClients.%s: %w", name, err)
+		}
+	}
+
+	if errorWrapping := cfg.Generate.GetErrorWrapping(); errorWrapping != nil {
+		if err := errorWrapping.Check(); err != nil {
+			return nil, fmt.Errorf("config.generate.errorWrapping: %w", err)
+		}
+	}
+
+	if complexityHints := cfg.Generate.GetComplexityHints(); complexityHints != nil {
+		if err := complexityHints.Check(); err != nil {
+			return nil, fmt.Errorf("config.generate.complexityHints: %w", err)
+		}
+	}
+
+	batches := cfg.Generate.GetBatches()
+	for _, name := range slices.Sorted(maps.Keys(batches)) {
+		if len(batches[name]) < 2 {
+			return nil, fmt.Errorf("config.generate.batches.%s: at least two operations must be specified", name)
+		}
+
+		seen := make(map[string]bool, len(batches[name]))
+		for _, operation := range batches[name] {
+			if seen[operation] {
+				return nil, fmt.Errorf("config.generate.batches.%s: %s is specified twice", name, operation)
+			}
+
+			seen[operation] = true
+		}
+	}
+
+	switch mode := cfg.Generate.GetNullableLists(); mode {
+	case NullableListSlice, NullableListPointer, NullableListWrapper:
+	default:
+		return nil, fmt.Errorf("config.generate.nullableLists: unknown mode %q, want slice, pointer or wrapper", mode)
+	}
+
+	switch order := cfg.Generate.GetFieldOrder(); order {
+	case "", FieldOrderAlphabetical, FieldOrderSchema, FieldOrderSize:
+	default:
+		return nil, fmt.Errorf("config.generate.fieldOrder: unknown order %q, want alphabetical, schema or size", order)
+	}
+
+	switch receiver := cfg.Generate.GetGetterReceiver(); receiver {
+	case GetterReceiverPointer, GetterReceiverValue:
+	default:
+		return nil, fmt.Errorf("config.generate.getterReceiver: unknown receiver %q, want pointer or value", receiver)
+	}
+
+	switch mode := cfg.Generate.GetGetterNil(); mode {
+	case GetterNilAllocate, GetterNilZero, GetterNilPanic, GetterNilOk:
+		if mode != GetterNilAllocate && cfg.Generate.GetGetterReceiver() == GetterReceiverValue {
+			return nil, fmt.Errorf("config.generate.getterNil: mode %s needs getterReceiver pointer, value receivers are never nil", mode)
+		}
+	default:
+		return nil, fmt.Errorf("config.generate.getterNil: unknown mode %q, want allocate, zero, panic or ok", mode)
+	}
+
 	return &cfg, nil
 }
 
+// expandSchemaFilenames expands the globs of patterns, and the directories they match to the schema files they contain.
+func expandSchemaFilenames(patterns StringList) (StringList, error) {
+	// https://github.com/99designs/gqlgen/blob/3a31a752df764738b1f6e99408df3b169d514784/codegen/config/config.go#L120
+	files := StringList{}
+
+	for _, f := range patterns {
+		var (
+			matches []string
+			err     error
+		)
+
+		// for ** we want to override default globbing patterns and walk all
+		// subdirectories to match schema files.
+		if strings.Contains(f, "**") {
+			pathParts := strings.SplitN(f, "**", 2)
+			rest := strings.TrimPrefix(strings.TrimPrefix(pathParts[1], `\`), `/`)
+			// turn the rest of the glob into a regex, anchored only at the end because ** allows
+			// for any number of dirs in between and walk will let us match against the full path name
+			globRe := regexp.MustCompile(path2regex.Replace(rest) + `$`)
+
+			err := filepath.Walk(pathParts[0], func(path string, info os.FileInfo, err error) error {
+				if err != nil {
+					return err
+				}
+
+				if globRe.MatchString(strings.TrimPrefix(path, pathParts[0])) {
+					matches = append(matches, path)
+				}
+
+				return nil
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to walk schema at root %s: %w", pathParts[0], err)
+			}
+		} else {
+			matches, err = filepath.Glob(f)
+			if err != nil {
+				return nil, fmt.Errorf("failed to glob schema filename %s: %w", f, err)
+			}
+		}
+
+		for _, m := range matches {
+			// a directory stands for the schema files it contains, whose extend blocks complete each other
+			if info, err := os.Stat(m); err == nil && info.IsDir() {
+				dirFiles, err := schemaFilesInDir(m)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, file := range dirFiles {
+					file = normalizePath(file)
+					if !files.Has(file) {
+						files = append(files, file)
+					}
+				}
+
+				continue
+			}
+
+			if m = normalizePath(m); !files.Has(m) {
+				files = append(files, m)
+			}
+		}
+	}
+
+	return files, nil
+}
+
+// schemaFilesInDir returns the .graphql, .graphqls and .gql files in dir and its subdirectories, in lexical order.
+func schemaFilesInDir(dir string) ([]string, error) {
+	var files []string
+
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		switch filepath.Ext(path) {
+		case ".graphql", ".graphqls", ".gql":
+			if !entry.IsDir() {
+				files = append(files, path)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk schema directory %s: %w", dir, err)
+	}
+
+	return files, nil
+}
+
+// loadSchemaSources reads the schema files.
+func loadSchemaSources(filenames StringList) ([]*ast.Source, error) {
+	sources := []*ast.Source{}
+
+	for _, filename := range filenames {
+		filename = filepath.ToSlash(filename)
+
+		schemaRaw, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, fmt.Errorf("unable to open schema: %w", err)
+		}
+
+		sources = append(sources, &ast.Source{Name: filename, Input: normalizeLineEndings(schemaRaw)})
+	}
+
+	return sources, nil
+}
+
+// normalizePath cleans path and uses forward slashes, so the same file matched by several patterns is loaded once
+// and named the same on every platform.
+func normalizePath(path string) string {
+	return filepath.ToSlash(filepath.Clean(path))
+}
+
+// normalizeLineEndings converts the CRLF line endings of files checked out on Windows to LF,
+// so the schema does not depend on the platform.
+func normalizeLineEndings(content []byte) string {
+	return strings.ReplaceAll(string(content), "\r\n", "\n")
+}
+
 // LoadSchema load and parses the schema from a local file or a remote server
 func (c *Config) LoadSchema(ctx context.Context) error {
 	var schema *ast.Schema
@@ -315,26 +574,105 @@ func (c *Config) loadRemoteSchema(ctx context.Context) (*ast.Schema, error) {
 
 	gqlclient := clientv2.NewClient(http.DefaultClient, c.Endpoint.URL, nil, addHeaderInterceptor)
 
-	var res introspection.Query
-
-	err := gqlclient.Post(ctx, "Query", introspection.Introspection, &res, nil)
+	res, err := gqlclient.Introspect(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("introspection query failed: %w", err)
 	}
 
-	schema, err := validator.ValidateSchemaDocument(introspection.ParseIntrospectionQuery(c.Endpoint.URL, res))
+	document := introspection.ParseIntrospectionQuery(c.Endpoint.URL, *res)
+
+	if extensionSources := c.schemaExtensionSources(); len(extensionSources) > 0 {
+		// validating the document changes its definitions, so the server schema is validated from a copy
+		serverSchema, err := validator.ValidateSchemaDocument(introspection.ParseIntrospectionQuery(c.Endpoint.URL, *res))
+		if err != nil {
+			return nil, fmt.Errorf("validation error: %w", err)
+		}
+
+		c.SchemaHash = introspection.SchemaHash(serverSchema)
+
+		extensionDocument, err := parser.ParseSchemas(extensionSources...)
+		if err != nil {
+			return nil, fmt.Errorf("client or overlay schema: %w", err)
+		}
+
+		document.Merge(extensionDocument)
+	}
+
+	schema, err := validator.ValidateSchemaDocument(document)
 	if err != nil {
 		return nil, fmt.Errorf("validation error: %w", err)
 	}
 
+	if c.SchemaHash == "" {
+		c.SchemaHash = introspection.SchemaHash(schema)
+	}
+
 	return schema, nil
 }
 
 func (c *Config) loadLocalSchema() (*ast.Schema, error) {
-	schema, err := gqlparser.LoadSchema(c.GQLConfig.Sources...)
+	schema, err := gqlparser.LoadSchema(append(slices.Clone(c.GQLConfig.Sources), c.schemaExtensionSources()...)...)
 	if err != nil {
 		return nil, fmt.Errorf("loadLocalSchema: %w", err)
 	}
 
+	serverSchema := schema
+	if len(c.schemaExtensionSources()) > 0 {
+		serverSchema, err = gqlparser.LoadSchema(c.GQLConfig.Sources...)
+		if err != nil {
+			return nil, fmt.Errorf("loadLocalSchema: %w", err)
+		}
+	}
+
+	c.SchemaHash = introspection.SchemaHash(serverSchema)
+
+	return schema, nil
+}
+
+// schemaExtensionSources returns the sources of the client and overlay schemas, which extend the schema of the server.
+func (c *Config) schemaExtensionSources() []*ast.Source {
+	return append(slices.Clone(c.clientSchemaSources), c.overlaySchemaSources...)
+}
+
+// OverlayFields returns the fields of the schema loaded by LoadSchema that are defined in the overlay schema,
+// as <Type>.<field>.
+func (c *Config) OverlayFields() map[string]bool {
+	if len(c.overlaySchemaSources) == 0 || c.GQLConfig == nil || c.GQLConfig.Schema == nil {
+		return nil
+	}
+
+	fields := make(map[string]bool)
+
+	for _, definition := range c.GQLConfig.Schema.Types {
+		for _, field := range definition.Fields {
+			if field.Position != nil && slices.Contains(c.overlaySchemaSources, field.Position.Src) {
+				fields[definition.Name+"."+field.Name] = true
+			}
+		}
+	}
+
+	return fields
+}
+
+// LoadNextSchema loads the schema of NextSchemaFilename, extended with the client schema, or returns nil when
+// there is no next schema.
+func (c *Config) LoadNextSchema() (*ast.Schema, error) {
+	if len(c.nextSchemaSources) == 0 {
+		return nil, nil
+	}
+
+	schema, err := gqlparser.LoadSchema(append(slices.Clone(c.nextSchemaSources), c.clientSchemaSources...)...)
+	if err != nil {
+		return nil, fmt.Errorf("load next schema: %w", err)
+	}
+
+	if schema.Query == nil {
+		schema.Query = &ast.Definition{
+			Kind: ast.Object,
+			Name: "Query",
+		}
+		schema.Types["Query"] = schema.Query
+	}
+
 	return schema, nil
 }
@@ -8,12 +8,15 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"runtime"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/99designs/gqlgen/codegen/config"
+
+	"github.com/vektah/gqlparser/v2/ast"
 )
 
 func TestLoadConfig(t *testing.T) {
@@ -68,6 +71,21 @@ func TestLoadConfig(t *testing.T) {
 		}
 	})
 
+	t.Run("schema directory and client schema", func(t *testing.T) {
+		t.Parallel()
+
+		loadConfig, err := LoadConfig("testdata/cfg/schema_dir.yml")
+		require.NoError(t, err)
+		require.Equal(t, StringList{filepath.Join("testdata", "cfg", "schema_dir", "nested", "user.graphqls"), filepath.Join("testdata", "cfg", "schema_dir", "query.graphql")}, loadConfig.SchemaFilename)
+
+		require.NoError(t, loadConfig.LoadSchema(context.Background()))
+
+		schema := loadConfig.GQLConfig.Schema
+		require.NotNil(t, schema.Query.Fields.ForName("user"))
+		require.NotNil(t, schema.Query.Fields.ForName("users"))
+		require.NotNil(t, schema.Types["User"].Fields.ForName("isSelected"))
+	})
+
 	t.Run("unwalkable path", func(t *testing.T) {
 		t.Parallel()
 
@@ -101,6 +119,62 @@ func TestLoadConfig(t *testing.T) {
 		require.Equal(t, false, c.Generate.ShouldGenerateClient())
 	})
 
+	t.Run("client interface package without client interface name", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := LoadConfig("testdata/cfg/client_interface_package_without_name.yml")
+		require.EqualError(t, err, "config.generate.clientInterfacePackage: clientInterfaceName must be specified")
+	})
+
+	t.Run("default client without endpoint", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := LoadConfig("testdata/cfg/default_client_without_endpoint.yml")
+		require.EqualError(t, err, "config.generate.defaultClient: endpoint or endpointEnv must be specified")
+	})
+
+	t.Run("endpoint client with invalid timeout", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := LoadConfig("testdata/cfg/endpoint_client_invalid_timeout.yml")
+		require.EqualError(t, err, `config.generate.endpointClients.eu: invalid timeout "soon"`)
+	})
+
+	t.Run("complexity hints with invalid value template", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := LoadConfig("testdata/cfg/complexity_hints_invalid_value.yml")
+		require.ErrorContains(t, err, "config.generate.complexityHints: invalid value template:")
+	})
+
+	t.Run("batch with one operation", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := LoadConfig("testdata/cfg/batch_with_one_operation.yml")
+		require.EqualError(t, err, "config.generate.batches.Dashboard: at least two operations must be specified")
+	})
+
+	t.Run("unknown nullable lists mode", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := LoadConfig("testdata/cfg/unknown_nullable_lists.yml")
+		require.EqualError(t, err, `config.generate.nullableLists: unknown mode "optional", want slice, pointer or wrapper`)
+	})
+
+	t.Run("getter nil mode with value receiver", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := LoadConfig("testdata/cfg/getter_nil_with_value_receiver.yml")
+		require.EqualError(t, err, "config.generate.getterNil: mode panic needs getterReceiver pointer, value receivers are never nil")
+	})
+
+	t.Run("error wrapping without constructor", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := LoadConfig("testdata/cfg/error_wrapping_without_constructor.yml")
+		require.EqualError(t, err, "config.generate.errorWrapping: constructor must be specified with style constructor")
+	})
+
 	t.Run("nullable input omittable", func(t *testing.T) {
 		t.Parallel()
 
@@ -120,6 +194,25 @@ func TestLoadConfig(t *testing.T) {
 	})
 }
 
+func TestLoadConfigFromDefaultLocations_convention(t *testing.T) {
+	t.Parallel()
+
+	c, err := LoadConfigFromDefaultLocations("testdata/convention")
+	require.NoError(t, err)
+
+	require.Equal(t, StringList{"testdata/convention/schema.graphqls"}, c.SchemaFilename)
+	require.Equal(t, []string{"testdata/convention/queries/*.graphql"}, c.Query)
+	require.Equal(t, config.PackageConfig{Filename: "testdata/convention/gen/models_gen.go", Package: "gen"}, c.Model)
+	clientFilename, err := filepath.Abs("testdata/convention/gen/client.go")
+	require.NoError(t, err)
+	require.Equal(t, clientFilename, c.Client.Filename)
+	require.Equal(t, "gen", c.Client.Package)
+	require.True(t, c.Generate.ShouldGenerateClient())
+
+	require.NoError(t, c.LoadSchema(context.Background()))
+	require.NotNil(t, c.GQLConfig.Schema.Types["User"])
+}
+
 func TestLoadConfig_LoadSchema(t *testing.T) {
 	t.Parallel()
 
@@ -140,6 +233,51 @@ func TestLoadConfig_LoadSchema(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("client schema", func(t *testing.T) {
+		t.Parallel()
+
+		mockServer, closeServer := newMockRemoteServer(t, responseFromFile("testdata/remote/response_ok.json"))
+		defer closeServer()
+
+		config := &Config{
+			GQLConfig: &config.Config{},
+			Endpoint: &EndPointConfig{
+				URL: mockServer.URL,
+			},
+			clientSchemaSources: []*ast.Source{{Name: "client.graphql", Input: "extend type Query { isLoggedIn: Boolean! }"}},
+		}
+
+		err := config.LoadSchema(context.Background())
+		require.NoError(t, err)
+		require.NotNil(t, config.GQLConfig.Schema.Query.Fields.ForName("isLoggedIn"))
+	})
+
+	t.Run("schema hash without the client schema", func(t *testing.T) {
+		t.Parallel()
+
+		mockServer, closeServer := newMockRemoteServer(t, responseFromFile("testdata/remote/response_ok.json"))
+		defer closeServer()
+
+		server := &Config{
+			GQLConfig: &config.Config{},
+			Endpoint: &EndPointConfig{
+				URL: mockServer.URL,
+			},
+		}
+		require.NoError(t, server.LoadSchema(context.Background()))
+		require.Len(t, server.SchemaHash, 64)
+
+		withClientSchema := &Config{
+			GQLConfig: &config.Config{},
+			Endpoint: &EndPointConfig{
+				URL: mockServer.URL,
+			},
+			clientSchemaSources: []*ast.Source{{Name: "client.graphql", Input: "extend type Query { isLoggedIn: Boolean! }"}},
+		}
+		require.NoError(t, withClientSchema.LoadSchema(context.Background()))
+		require.Equal(t, server.SchemaHash, withClientSchema.SchemaHash)
+	})
+
 	t.Run("invalid schema", func(t *testing.T) {
 		t.Parallel()
 
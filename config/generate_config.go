@@ -1,5 +1,15 @@
 package config
 
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"text/template"
+	"time"
+
+	"github.com/99designs/gqlgen/codegen/config"
+)
+
 type GenerateConfig struct {
 	Prefix        *NamingConfig `yaml:"prefix,omitempty"`
 	Suffix        *NamingConfig `yaml:"suffix,omitempty"`
@@ -7,12 +17,14 @@ type GenerateConfig struct {
 	// Deprecated: not working because it is generated by gqlgen
 	Query *bool `yaml:"query,omitempty"`
 	// Deprecated: not working because it is generated by gqlgen
-	Mutation                     *bool   `yaml:"mutation,omitempty"`
-	Client                       *bool   `yaml:"client,omitempty"`
-	ClientInterfaceName          *string `yaml:"clientInterfaceName,omitempty"`
-	NullableInputOmittable       bool    `yaml:"nullableInputOmittable,omitempty"`
-	EnableClientJsonOmitemptyTag *bool   `yaml:"enableClientJsonOmitemptyTag,omitempty"`
-	EnableClientJsonOmitzeroTag  *bool   `yaml:"enableClientJsonOmitzeroTag,omitempty"`
+	Mutation            *bool   `yaml:"mutation,omitempty"`
+	Client              *bool   `yaml:"client,omitempty"`
+	ClientInterfaceName *string `yaml:"clientInterfaceName,omitempty"`
+	// where the client interface is generated instead of the client package, which can then be internal
+	ClientInterfacePackage       *config.PackageConfig `yaml:"clientInterfacePackage,omitempty"`
+	NullableInputOmittable       bool                  `yaml:"nullableInputOmittable,omitempty"`
+	EnableClientJsonOmitemptyTag *bool                 `yaml:"enableClientJsonOmitemptyTag,omitempty"`
+	EnableClientJsonOmitzeroTag  *bool                 `yaml:"enableClientJsonOmitzeroTag,omitempty"`
 
 	// Deprecated: not working because v1 is deleted. Must use ClientV2
 	// if true, used client v2 in generate code
@@ -20,6 +32,133 @@ type GenerateConfig struct {
 	StructFieldsAlwaysPointers   *bool `yaml:"structFieldsAlwaysPointers,omitempty"`
 	InlineFragmentAlwaysPointers *bool `yaml:"inlineFragmentAlwaysPointers,omitempty"`
 	OnlyUsedModels               *bool `yaml:"onlyUsedModels,omitempty"`
+	// if true, generate a Match method on types selecting inline fragments of a union or interface
+	UnionMatchers bool `yaml:"unionMatchers,omitempty"`
+	// if true, union and interface selections get an Unknown field capturing members no inline fragment covers
+	CaptureUnknownTypes bool `yaml:"captureUnknownTypes,omitempty"`
+	// if true, fragments spread exactly once are inlined into their parent instead of generating a separate type
+	InlineSingleUseFragments bool `yaml:"inlineSingleUseFragments,omitempty"`
+	// if true, fields under @skip or @include are generated as optional even when the schema declares them non-null
+	ConditionalFieldsOptional bool `yaml:"conditionalFieldsOptional,omitempty"`
+	// if true, subscription operations are generated like queries instead of being rejected
+	AllowSubscriptions bool `yaml:"allowSubscriptions,omitempty"`
+	// if true, generated operation methods call the clientv2.OperationHook configured on the client
+	OperationHooks bool `yaml:"operationHooks,omitempty"`
+	// if true, generate a <Operation>CacheKey function per operation returning a stable key for its variables
+	OperationCacheKeys bool `yaml:"operationCacheKeys,omitempty"`
+	// GraphQL object, interface or union member types modeling errors; their generated structs implement error
+	// and operation responses selecting them get an Errors method
+	ErrorTypes []string `yaml:"errorTypes,omitempty"`
+	// if true, generated operation methods return the errors of ErrorTypes selected in the response as a Go error
+	ReturnErrorTypes bool `yaml:"returnErrorTypes,omitempty"`
+	// GraphQL enum listing the extensions.code values of GraphQL errors; each value gets a sentinel error
+	// that errors returned by generated operation methods match with errors.Is
+	ErrorCodeEnum string `yaml:"errorCodeEnum,omitempty"`
+	// if true, comments in query files are kept in the documents sent to the server
+	DocumentComments bool `yaml:"documentComments,omitempty"`
+	// if false, the types generated for operation responses are unexported (default: true)
+	ExportQueryTypes *bool `yaml:"exportQueryTypes,omitempty"`
+	// overrides ExportQueryTypes per operation name
+	QueryTypeExports map[string]bool `yaml:"queryTypeExports,omitempty"`
+	// if true, fragments are generated into one <type>_fragments_gen.go file per GraphQL type next to the client
+	FragmentFilesByType bool `yaml:"fragmentFilesByType,omitempty"`
+	// if true, no Get<Fragment> conversion getters are generated for fragments spread into a struct
+	SkipFragmentSpreadGetters bool `yaml:"skipFragmentSpreadGetters,omitempty"`
+	// if true, no getters are generated for inline fragment fields
+	SkipInlineFragmentGetters bool `yaml:"skipInlineFragmentGetters,omitempty"`
+	// if true, generate Has<Field> and <Field>OrDefault helpers for the graphql.Omittable fields of input models
+	OmittableHelpers bool `yaml:"omittableHelpers,omitempty"`
+	// if true, generate Validate methods for input models from the @constraint, @length and @range directives
+	// of their fields, and call them in generated operation methods before sending the request
+	InputValidation bool `yaml:"inputValidation,omitempty"`
+	// maps generated struct names to qualified Go domain types; each mapped struct gets a ToDomain method and a
+	// <Name>FromDomain function converting the fields of the same name
+	DomainTypes map[string]string `yaml:"domainTypes,omitempty"`
+	// if true, generate a Build<Operation>Request method per operation returning its HTTP request without sending it
+	RequestBuilders bool `yaml:"requestBuilders,omitempty"`
+	// if true, only the documents, names and hashes of operations and structs of their variables are generated,
+	// without response types nor client
+	DocumentsOnly bool `yaml:"documentsOnly,omitempty"`
+	// if true, generate a <Mutation>Optimistic method per mutation taking the response that queries answered by a
+	// clientv2.NormalizedCache read until the mutation completes
+	OptimisticUpdates bool `yaml:"optimisticUpdates,omitempty"`
+	// if true, the files of a previous generation that are no longer generated, such as the fragment files of
+	// removed fragments, are deleted instead of kept
+	CleanStaleFiles bool `yaml:"cleanStaleFiles,omitempty"`
+	// file where a JSON object mapping the SHA-256 hash of every document the client sends to the document is
+	// written, for servers enforcing persisted queries
+	OperationManifest string `yaml:"operationManifest,omitempty"`
+	// if true, the hash of the server schema is recorded in the header of the generated client and in a SchemaHash
+	// constant, for clientv2.Client.VerifySchemaHash
+	SchemaHash bool `yaml:"schemaHash,omitempty"`
+	// file where a JSON description of the operations, their variables, response shapes and the types they
+	// reference is written, for tools generating documentation, clients in other languages or contract tests
+	OperationDescriptions string `yaml:"operationDescriptions,omitempty"`
+	// if true, the variables, input fields and response fields of custom scalars are encoded and decoded with
+	// the clientv2.ScalarCodec registered for the scalar in clientv2.Options, when there is one
+	ScalarCodecs bool `yaml:"scalarCodecs,omitempty"`
+	// severities of the rules queries are validated with by rule name, to turn off or downgrade to warnings
+	// rules of the GraphQL specification, or to enable the client rules of parsequery, which are off by default
+	ValidationRules map[string]RuleSeverity `yaml:"validationRules,omitempty"`
+	// if true, a <Operation><Enum>Values variable listing the values of every enum an operation sends or receives
+	// is generated, for exhaustive switches and UI choices
+	OperationEnumValues bool `yaml:"operationEnumValues,omitempty"`
+	// how nullable lists of response fields are represented: slice ([]*T, nil when null, the default),
+	// pointer (*[]*T) or wrapper (graphqljson.NullableList[*T])
+	NullableLists NullableListMode `yaml:"nullableLists,omitempty"`
+	// the receiver of the getters of generated structs: pointer (the default), whose getters can be called on nil
+	// and chained, or value, whose getters return the fields themselves
+	GetterReceiver GetterReceiverMode `yaml:"getterReceiver,omitempty"`
+	// what getters with pointer receivers do when called on nil: read the field of a zero struct they allocate
+	// (allocate, the default), return the zero value without allocating (zero), panic (panic), or return the zero
+	// value and false, the field being returned with true otherwise (ok)
+	GetterNil GetterNilMode `yaml:"getterNil,omitempty"`
+	// the order of the fields of generated response structs: alphabetical by response key, schema as their
+	// types declare them, or size by decreasing alignment, which leaves no padding; unset keeps the order of
+	// the query, sorted by response key where fragments are merged
+	FieldOrder FieldOrder `yaml:"fieldOrder,omitempty"`
+	// if set, a NewDefaultClient constructor wiring the endpoint, headers, timeout and retries it configures
+	// is generated next to NewClient
+	DefaultClient *DefaultClientConfig `yaml:"defaultClient,omitempty"`
+	// endpoints serving the schema by name, such as regional shards, for each of which a New<Name>Client
+	// constructor wiring the endpoint like DefaultClient is generated, all returning the same client type
+	EndpointClients map[string]*DefaultClientConfig `yaml:"endpointClients,omitempty"`
+	// if true, a <operation>_test.go file with a table-driven test of the operation against an httptest server
+	// is written next to the client for every operation that has none yet
+	TestSkeletons bool `yaml:"testSkeletons,omitempty"`
+	// if true, a file next to the client holds an Example function calling each generated method with
+	// placeholder arguments, shown by godoc and compiled by go test so signature changes are noticed
+	Examples bool `yaml:"examples,omitempty"`
+	// file where the hashes of the inputs and outputs of generation are recorded, so a generation whose config,
+	// schema, queries and outputs are unchanged since the last one is skipped instead of rewriting and formatting
+	// the same files
+	CacheFile string `yaml:"cacheFile,omitempty"`
+	// if true, the packages of the generated models and client are built for js/wasm after generation, which
+	// fails when they do not build there
+	WasmCheck bool `yaml:"wasmCheck,omitempty"`
+	// if true, the operations broken by the next schema fail generation instead of being reported as warnings
+	FailOnNextSchema bool `yaml:"failOnNextSchema,omitempty"`
+	// if true, a Count<Operation> method sending a query that selects only the totalCount of the field is
+	// generated for each root field of a query whose type has a totalCount field, like Relay connections
+	CountMethods bool `yaml:"countMethods,omitempty"`
+	// if true, a <Operation>Flat method returning the field itself is generated for each operation selecting
+	// a single root field, next to the method returning the response struct
+	FlattenResults bool `yaml:"flattenResults,omitempty"`
+	// if set, the errors returned by generated operation methods are wrapped with the name of the operation,
+	// in a sentinel error of the operation or by a function of the user
+	ErrorWrapping *ErrorWrappingConfig `yaml:"errorWrapping,omitempty"`
+	// if set, every operation sends an extension holding its complexity computed at generation time, for gateways
+	// doing admission control
+	ComplexityHints *ComplexityHintsConfig `yaml:"complexityHints,omitempty"`
+	// query operations merged into one query by the name of the query, for each of which a method of that name sends
+	// the query and returns the results of all its operations in one round-trip
+	Batches map[string][]string `yaml:"batches,omitempty"`
+	// if true, String methods redacting the sensitive fields are generated for the input models having some and
+	// for the variables structs of documentsOnly having @sensitive variables, and operation methods hide the
+	// sensitive variables, input fields and response fields from the transcripts of clientv2
+	RedactSensitive bool `yaml:"redactSensitive,omitempty"`
+	// input and object fields redacted in addition to those marked @sensitive in the schema, as <Type>.<field>
+	SensitiveFields []string `yaml:"sensitiveFields,omitempty"`
 }
 
 func (c *GenerateConfig) ShouldGenerateClient() bool {
@@ -42,7 +181,575 @@ func (c *GenerateConfig) GetClientInterfaceName() *string {
 	return c.ClientInterfaceName
 }
 
+func (c *GenerateConfig) GetClientInterfacePackage() *config.PackageConfig {
+	if c == nil {
+		return nil
+	}
+
+	return c.ClientInterfacePackage
+}
+
+func (c *GenerateConfig) ShouldGenerateUnionMatchers() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.UnionMatchers
+}
+
+func (c *GenerateConfig) ShouldAllowSubscriptions() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.AllowSubscriptions
+}
+
+func (c *GenerateConfig) ShouldGenerateOperationHooks() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.OperationHooks
+}
+
+func (c *GenerateConfig) ShouldGenerateOperationCacheKeys() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.OperationCacheKeys
+}
+
+func (c *GenerateConfig) IsErrorType(typeName string) bool {
+	if c == nil {
+		return false
+	}
+
+	return slices.Contains(c.ErrorTypes, typeName)
+}
+
+func (c *GenerateConfig) ShouldReturnErrorTypes() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.ReturnErrorTypes
+}
+
+func (c *GenerateConfig) GetErrorCodeEnum() string {
+	if c == nil {
+		return ""
+	}
+
+	return c.ErrorCodeEnum
+}
+
+func (c *GenerateConfig) ShouldKeepDocumentComments() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.DocumentComments
+}
+
+func (c *GenerateConfig) ShouldExportQueryTypes(operationName string) bool {
+	if c == nil {
+		return true
+	}
+
+	if export, ok := c.QueryTypeExports[operationName]; ok {
+		return export
+	}
+
+	return c.ExportQueryTypes == nil || *c.ExportQueryTypes
+}
+
+func (c *GenerateConfig) ShouldSplitFragmentFiles() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.FragmentFilesByType
+}
+
+func (c *GenerateConfig) ShouldSkipFragmentSpreadGetters() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.SkipFragmentSpreadGetters
+}
+
+func (c *GenerateConfig) ShouldSkipInlineFragmentGetters() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.SkipInlineFragmentGetters
+}
+
+func (c *GenerateConfig) ShouldGenerateOmittableHelpers() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.OmittableHelpers
+}
+
+func (c *GenerateConfig) ShouldGenerateInputValidation() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.InputValidation
+}
+
+func (c *GenerateConfig) GetDomainTypes() map[string]string {
+	if c == nil {
+		return nil
+	}
+
+	return c.DomainTypes
+}
+
+func (c *GenerateConfig) ShouldGenerateRequestBuilders() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.RequestBuilders
+}
+
+func (c *GenerateConfig) ShouldGenerateDocumentsOnly() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.DocumentsOnly
+}
+
+func (c *GenerateConfig) ShouldGenerateOptimisticUpdates() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.OptimisticUpdates
+}
+
+func (c *GenerateConfig) ShouldCleanStaleFiles() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.CleanStaleFiles
+}
+
+func (c *GenerateConfig) GetOperationManifest() string {
+	if c == nil {
+		return ""
+	}
+
+	return c.OperationManifest
+}
+
+func (c *GenerateConfig) ShouldRecordSchemaHash() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.SchemaHash
+}
+
+func (c *GenerateConfig) GetOperationDescriptions() string {
+	if c == nil {
+		return ""
+	}
+
+	return c.OperationDescriptions
+}
+
+func (c *GenerateConfig) ShouldGenerateScalarCodecs() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.ScalarCodecs
+}
+
+func (c *GenerateConfig) GetValidationRules() map[string]RuleSeverity {
+	if c == nil {
+		return nil
+	}
+
+	return c.ValidationRules
+}
+
+func (c *GenerateConfig) ShouldGenerateOperationEnumValues() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.OperationEnumValues
+}
+
+func (c *GenerateConfig) GetNullableLists() NullableListMode {
+	if c == nil || c.NullableLists == "" {
+		return NullableListSlice
+	}
+
+	return c.NullableLists
+}
+
+func (c *GenerateConfig) GetDefaultClient() *DefaultClientConfig {
+	if c == nil {
+		return nil
+	}
+
+	return c.DefaultClient
+}
+
+func (c *GenerateConfig) GetEndpointClients() map[string]*DefaultClientConfig {
+	if c == nil {
+		return nil
+	}
+
+	return c.EndpointClients
+}
+
+func (c *GenerateConfig) GetBatches() map[string][]string {
+	if c == nil {
+		return nil
+	}
+
+	return c.Batches
+}
+
+func (c *GenerateConfig) GetGetterReceiver() GetterReceiverMode {
+	if c == nil || c.GetterReceiver == "" {
+		return GetterReceiverPointer
+	}
+
+	return c.GetterReceiver
+}
+
+func (c *GenerateConfig) GetGetterNil() GetterNilMode {
+	if c == nil || c.GetterNil == "" {
+		return GetterNilAllocate
+	}
+
+	return c.GetterNil
+}
+
+func (c *GenerateConfig) GetFieldOrder() FieldOrder {
+	if c == nil {
+		return ""
+	}
+
+	return c.FieldOrder
+}
+
+func (c *GenerateConfig) ShouldGenerateTestSkeletons() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.TestSkeletons
+}
+
+func (c *GenerateConfig) ShouldGenerateExamples() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.Examples
+}
+
+func (c *GenerateConfig) GetCacheFile() string {
+	if c == nil {
+		return ""
+	}
+
+	return c.CacheFile
+}
+
+func (c *GenerateConfig) ShouldCheckWasm() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.WasmCheck
+}
+
+func (c *GenerateConfig) ShouldFailOnNextSchema() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.FailOnNextSchema
+}
+
+func (c *GenerateConfig) ShouldGenerateCountMethods() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.CountMethods
+}
+
+func (c *GenerateConfig) ShouldFlattenResults() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.FlattenResults
+}
+
+func (c *GenerateConfig) GetErrorWrapping() *ErrorWrappingConfig {
+	if c == nil {
+		return nil
+	}
+
+	return c.ErrorWrapping
+}
+
+func (c *GenerateConfig) GetComplexityHints() *ComplexityHintsConfig {
+	if c == nil {
+		return nil
+	}
+
+	return c.ComplexityHints
+}
+
+func (c *GenerateConfig) ShouldRedactSensitive() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.RedactSensitive
+}
+
+func (c *GenerateConfig) IsSensitiveField(typeName, fieldName string) bool {
+	if c == nil {
+		return false
+	}
+
+	return slices.Contains(c.SensitiveFields, typeName+"."+fieldName)
+}
+
+// DefaultClientConfig configures the NewDefaultClient constructor of generate.defaultClient and the constructors
+// of generate.endpointClients.
+type DefaultClientConfig struct {
+	// Endpoint is the URL of the GraphQL endpoint.
+	Endpoint string `yaml:"endpoint,omitempty"`
+	// EndpointEnv is the environment variable overriding Endpoint when it is set.
+	EndpointEnv string `yaml:"endpointEnv,omitempty"`
+	// Headers maps the names of the headers sent with every request to the environment variables holding their values.
+	Headers map[string]string `yaml:"headers,omitempty"`
+	// Timeout is the timeout of requests as a duration such as 30s, none when empty.
+	Timeout string `yaml:"timeout,omitempty"`
+	// Retries is how many times failed requests are retried with clientv2.NewRetryInterceptor.
+	Retries int `yaml:"retries,omitempty"`
+}
+
+// TimeoutDuration returns the parsed Timeout, 0 when it is empty.
+func (c *DefaultClientConfig) TimeoutDuration() (time.Duration, error) {
+	if c.Timeout == "" {
+		return 0, nil
+	}
+
+	return time.ParseDuration(c.Timeout)
+}
+
+// Check reports the errors of the config.
+func (c *DefaultClientConfig) Check() error {
+	if c.Endpoint == "" && c.EndpointEnv == "" {
+		return errors.New("endpoint or endpointEnv must be specified")
+	}
+
+	if timeout, err := c.TimeoutDuration(); err != nil || timeout < 0 {
+		return fmt.Errorf("invalid timeout %q", c.Timeout)
+	}
+
+	if c.Retries < 0 {
+		return fmt.Errorf("invalid retries %d", c.Retries)
+	}
+
+	return nil
+}
+
+// ErrorWrappingStyle is how the errors returned by generated operation methods are wrapped.
+type ErrorWrappingStyle string
+
+const (
+	// ErrorWrappingOperation wraps errors with fmt.Errorf("<Operation>: %w", err).
+	ErrorWrappingOperation ErrorWrappingStyle = "operation"
+	// ErrorWrappingSentinel wraps errors in an Err<Operation> sentinel error generated for each operation, so
+	// errors.Is tells which operation failed.
+	ErrorWrappingSentinel ErrorWrappingStyle = "sentinel"
+	// ErrorWrappingConstructor wraps errors with the function of ErrorWrappingConfig.Constructor.
+	ErrorWrappingConstructor ErrorWrappingStyle = "constructor"
+)
+
+// ErrorWrappingConfig configures how generate.errorWrapping wraps the errors of generated operation methods.
+type ErrorWrappingConfig struct {
+	// Style is how errors are wrapped.
+	Style ErrorWrappingStyle `yaml:"style,omitempty"`
+	// Constructor is the qualified name of the function wrapping errors with the constructor style, such as
+	// github.com/org/errs.Wrap, called with the name of the operation and the error: func(string, error) error.
+	Constructor string `yaml:"constructor,omitempty"`
+}
+
+// Check reports the errors of the config.
+func (c *ErrorWrappingConfig) Check() error {
+	switch c.Style {
+	case ErrorWrappingOperation, ErrorWrappingSentinel:
+		if c.Constructor != "" {
+			return fmt.Errorf("constructor must not be specified with style %s", c.Style)
+		}
+	case ErrorWrappingConstructor:
+		if c.Constructor == "" {
+			return errors.New("constructor must be specified with style constructor")
+		}
+	default:
+		return fmt.Errorf("unknown style %q, want operation, sentinel or constructor", c.Style)
+	}
+
+	return nil
+}
+
+// DefaultComplexityHintKey and DefaultComplexityHintValue are the templates of the extension of
+// generate.complexityHints when its key or value is not set.
+const (
+	DefaultComplexityHintKey   = "complexityHint"
+	DefaultComplexityHintValue = "{{ .Complexity }}"
+)
+
+// ComplexityHintsConfig configures the extension generate.complexityHints sends with every operation. Its key and
+// value are text/templates executed at generation time with the Operation name, its Kind (query, mutation or
+// subscription), its Complexity and its Depth; the value must render to JSON.
+type ComplexityHintsConfig struct {
+	// Key is the template of the name of the extension, DefaultComplexityHintKey when empty.
+	Key string `yaml:"key,omitempty"`
+	// Value is the template of the JSON value of the extension, DefaultComplexityHintValue when empty.
+	Value string `yaml:"value,omitempty"`
+	// ListSize is the number of items the fields of list types are counted for when no first, last or limit
+	// argument gives it, 1 when 0.
+	ListSize int `yaml:"listSize,omitempty"`
+}
+
+// KeyTemplate returns the parsed template of the key.
+func (c *ComplexityHintsConfig) KeyTemplate() (*template.Template, error) {
+	return parseComplexityHintTemplate("key", c.Key, DefaultComplexityHintKey)
+}
+
+// ValueTemplate returns the parsed template of the value.
+func (c *ComplexityHintsConfig) ValueTemplate() (*template.Template, error) {
+	return parseComplexityHintTemplate("value", c.Value, DefaultComplexityHintValue)
+}
+
+// GetListSize returns ListSize, 1 when it is 0.
+func (c *ComplexityHintsConfig) GetListSize() int {
+	if c.ListSize == 0 {
+		return 1
+	}
+
+	return c.ListSize
+}
+
+func parseComplexityHintTemplate(name, text, defaultText string) (*template.Template, error) {
+	if text == "" {
+		text = defaultText
+	}
+
+	t, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s template: %w", name, err)
+	}
+
+	return t, nil
+}
+
+// Check reports the errors of the config.
+func (c *ComplexityHintsConfig) Check() error {
+	if _, err := c.KeyTemplate(); err != nil {
+		return err
+	}
+
+	if _, err := c.ValueTemplate(); err != nil {
+		return err
+	}
+
+	if c.ListSize < 0 {
+		return fmt.Errorf("invalid listSize %d", c.ListSize)
+	}
+
+	return nil
+}
+
+// NullableListMode is how nullable lists of response fields are represented.
+type NullableListMode string
+
+const (
+	// NullableListSlice represents a nullable list as a slice, nil when the list is null.
+	NullableListSlice NullableListMode = "slice"
+	// NullableListPointer represents a nullable list as a pointer to a slice, nil when the list is null.
+	NullableListPointer NullableListMode = "pointer"
+	// NullableListWrapper represents a nullable list as a graphqljson.NullableList, not Valid when the list is null.
+	NullableListWrapper NullableListMode = "wrapper"
+)
+
+// RuleSeverity is how the errors of a validation rule are reported.
+type RuleSeverity string
+
+const (
+	// RuleError fails generation with the errors of the rule.
+	RuleError RuleSeverity = "error"
+	// RuleWarning reports the errors of the rule as warnings without failing generation.
+	RuleWarning RuleSeverity = "warning"
+	// RuleOff does not run the rule.
+	RuleOff RuleSeverity = "off"
+)
+
 type NamingConfig struct {
-	Query    string `yaml:"query,omitempty"`
-	Mutation string `yaml:"mutation,omitempty"`
+	Query        string `yaml:"query,omitempty"`
+	Mutation     string `yaml:"mutation,omitempty"`
+	Subscription string `yaml:"subscription,omitempty"`
+	Fragment     string `yaml:"fragment,omitempty"`
 }
+
+// GetterReceiverMode is the receiver of the getters of generated structs.
+type GetterReceiverMode string
+
+const (
+	// GetterReceiverPointer generates getters with pointer receivers, returning pointers to struct fields.
+	GetterReceiverPointer GetterReceiverMode = "pointer"
+	// GetterReceiverValue generates getters with value receivers, returning the fields themselves.
+	GetterReceiverValue GetterReceiverMode = "value"
+)
+
+// GetterNilMode is what getters with pointer receivers do when called on nil.
+type GetterNilMode string
+
+const (
+	// GetterNilAllocate reads the field of a zero struct allocated in place of the receiver.
+	GetterNilAllocate GetterNilMode = "allocate"
+	// GetterNilZero returns the zero value of the field without allocating.
+	GetterNilZero GetterNilMode = "zero"
+	// GetterNilPanic panics, so reading a field of a missing struct is not silently ignored.
+	GetterNilPanic GetterNilMode = "panic"
+	// GetterNilOk returns the zero value and false, getters returning the field and true otherwise.
+	GetterNilOk GetterNilMode = "ok"
+)
+
+// FieldOrder is the order of the fields of generated response structs.
+type FieldOrder string
+
+const (
+	// FieldOrderAlphabetical sorts fields by response key.
+	FieldOrderAlphabetical FieldOrder = "alphabetical"
+	// FieldOrderSchema sorts fields as the types of the schema declare them, fragments last.
+	FieldOrderSchema FieldOrder = "schema"
+	// FieldOrderSize sorts fields by decreasing alignment, so structs have no padding between fields.
+	FieldOrderSize FieldOrder = "size"
+)
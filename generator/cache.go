@@ -0,0 +1,136 @@
+package generator
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"runtime/debug"
+
+	"github.com/goccy/go-yaml"
+	"github.com/vektah/gqlparser/v2/formatter"
+
+	"github.com/gqlgo/gqlgenc/config"
+	"github.com/gqlgo/gqlgenc/parsequery"
+)
+
+// modulePath is the path of the gqlgenc module, whose version is part of the inputs of generation.
+const modulePath = "github.com/gqlgo/gqlgenc"
+
+// generationCache is the content of generate.cacheFile: the hashes of the inputs of the last generation and of
+// the files it wrote.
+type generationCache struct {
+	Inputs string `json:"inputs"`
+	// Outputs are the hashes of the files written by their paths.
+	Outputs map[string]string `json:"outputs"`
+}
+
+func hashBytes(b []byte) string {
+	sum := sha256.Sum256(b)
+
+	return hex.EncodeToString(sum[:])
+}
+
+// inputsHash returns the hash of what generation depends on: the version of gqlgenc, the config, the schema
+// loaded by LoadSchema and the query sources. The Go types models are bound to are left out.
+func inputsHash(cfg *config.Config) (string, error) {
+	var b bytes.Buffer
+
+	if info, ok := debug.ReadBuildInfo(); ok {
+		module := &info.Main
+		for _, dep := range info.Deps {
+			if dep.Path == modulePath {
+				module = dep
+			}
+		}
+
+		fmt.Fprintf(&b, "%s %s %s\n", module.Path, module.Version, module.Sum)
+	}
+
+	configYAML, err := yaml.Marshal(cfg)
+	if err != nil {
+		return "", fmt.Errorf("marshal config: %w", err)
+	}
+
+	b.Write(configYAML)
+
+	formatter.NewFormatter(&b).FormatSchema(cfg.GQLConfig.Schema)
+
+	querySources, err := parsequery.LoadQuerySources(cfg.Query)
+	if err != nil {
+		return "", fmt.Errorf("load query sources failed: %w", err)
+	}
+
+	for _, source := range querySources {
+		fmt.Fprintf(&b, "\n%s %d\n%s", source.Name, len(source.Input), source.Input)
+	}
+
+	return hashBytes(b.Bytes()), nil
+}
+
+// readCache reads the cache file at path, returning nil when there is none.
+func readCache(path string) (*generationCache, error) {
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("read cache: %w", err)
+	}
+
+	var cache generationCache
+	if err := json.Unmarshal(content, &cache); err != nil {
+		return nil, fmt.Errorf("parse cache %s: %w", path, err)
+	}
+
+	return &cache, nil
+}
+
+// upToDate reports whether the last generation had inputs and its outputs are unchanged since.
+func (c *generationCache) upToDate(inputs string) bool {
+	if c == nil || c.Inputs != inputs || len(c.Outputs) == 0 {
+		return false
+	}
+
+	for path, hash := range c.Outputs {
+		content, err := os.ReadFile(path)
+		if err != nil || hashBytes(content) != hash {
+			return false
+		}
+	}
+
+	return true
+}
+
+// writeCache records inputs and the hashes of the files of paths that exist in the cache file at path.
+func writeCache(path, inputs string, paths []string) error {
+	cache := generationCache{Inputs: inputs, Outputs: make(map[string]string, len(paths))}
+
+	for _, output := range paths {
+		content, err := os.ReadFile(output)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("hash %s: %w", output, err)
+		}
+
+		cache.Outputs[output] = hashBytes(content)
+	}
+
+	content, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal cache: %w", err)
+	}
+
+	if err := os.WriteFile(path, append(content, '\n'), 0o644); err != nil {
+		return fmt.Errorf("write cache: %w", err)
+	}
+
+	return nil
+}
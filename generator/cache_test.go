@@ -0,0 +1,35 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerationCache(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	paths := writeOutputs(t, dir, "client.go", "models_gen.go")
+	cacheFile := filepath.Join(dir, ".gqlgenc.cache")
+
+	cache, err := readCache(cacheFile)
+	require.NoError(t, err)
+	require.False(t, cache.upToDate("inputs"))
+
+	require.NoError(t, writeCache(cacheFile, "inputs", append(paths, filepath.Join(dir, "user_fragments_gen.go"))))
+
+	cache, err = readCache(cacheFile)
+	require.NoError(t, err)
+	require.Len(t, cache.Outputs, 2)
+	require.True(t, cache.upToDate("inputs"))
+	require.False(t, cache.upToDate("other inputs"))
+
+	require.NoError(t, os.WriteFile(paths[1], []byte("edited models_gen.go"), 0o600))
+	require.False(t, cache.upToDate("inputs"))
+
+	require.NoError(t, os.Remove(paths[1]))
+	require.False(t, cache.upToDate("inputs"))
+}
@@ -4,8 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"sort"
-	"syscall"
 
 	"github.com/99designs/gqlgen/api"
 	"github.com/99designs/gqlgen/plugin"
@@ -16,6 +16,8 @@ import (
 	"github.com/gqlgo/gqlgenc/config"
 	"github.com/gqlgo/gqlgenc/parsequery"
 	"github.com/gqlgo/gqlgenc/querydocument"
+
+	"github.com/vektah/gqlparser/v2/ast"
 )
 
 func mutateHook(cfg *config.Config, usedTypes map[string]bool) func(b *modelgen.ModelBuild) *modelgen.ModelBuild {
@@ -45,12 +47,97 @@ func mutateHook(cfg *config.Config, usedTypes map[string]bool) func(b *modelgen.
 	}
 }
 
-func Generate(ctx context.Context, cfg *config.Config) error {
-	_ = syscall.Unlink(cfg.Client.Filename)
-	if cfg.Model.IsDefined() {
-		_ = syscall.Unlink(cfg.Model.Filename)
+// fieldHook tags the fields of custom scalars of the models with their scalar when generate.scalarCodecs is set,
+// so they are encoded and decoded by the clientv2.ScalarCodec of the scalar.
+func fieldHook(cfg *config.Config) modelgen.FieldMutateHook {
+	return func(td *ast.Definition, fd *ast.FieldDefinition, f *modelgen.Field) (*modelgen.Field, error) {
+		f, err := modelgen.DefaultFieldMutateHook(td, fd, f)
+		if err != nil || !cfg.Generate.ShouldGenerateScalarCodecs() {
+			return f, err
+		}
+
+		if tag := clientgenv2.ScalarTag(cfg.GQLConfig.Schema, fd.Type); tag != "" {
+			f.Tag += " " + tag
+		}
+
+		return f, nil
+	}
+}
+
+// Generate generates the models and the client of cfg. The files of a previous generation are moved aside
+// meanwhile and put back if it fails, so a failed generation leaves the project as it was. With
+// generate.cacheFile, generation is skipped when its inputs and outputs are unchanged since the last one.
+func Generate(ctx context.Context, cfg *config.Config) (err error) {
+	progress := clientgenv2.NewProgress(cfg.Progress)
+	progress.Phase("loading the schema")
+
+	err = loadSchema(ctx, cfg)
+	if err != nil {
+		return err
 	}
 
+	progress.Phase("loaded %d types", len(cfg.GQLConfig.Schema.Types))
+
+	var inputs string
+
+	cacheFile := cfg.Generate.GetCacheFile()
+	if cacheFile != "" {
+		inputs, err = inputsHash(cfg)
+		if err != nil {
+			return err
+		}
+
+		cache, err := readCache(cacheFile)
+		if err != nil {
+			return err
+		}
+
+		if cache.upToDate(inputs) {
+			progress.Phase("%s is up to date, skipping generation", cacheFile)
+
+			return nil
+		}
+	}
+
+	paths, err := outputFiles(cfg)
+	if err != nil {
+		return err
+	}
+
+	stash, err := stashOutputs(paths)
+	if err != nil {
+		return errors.Join(err, stash.restore(nil))
+	}
+
+	defer func() {
+		if err != nil {
+			paths, globErr := outputFiles(cfg)
+			err = errors.Join(err, globErr, stash.restore(paths))
+
+			return
+		}
+
+		if commitErr := stash.commit(cfg.Generate.ShouldCleanStaleFiles()); commitErr != nil {
+			err = fmt.Errorf("failed to remove previous outputs: %w", commitErr)
+
+			return
+		}
+
+		if cacheFile != "" {
+			paths, err = outputFiles(cfg)
+			if err == nil {
+				err = writeCache(cacheFile, inputs, paths)
+			}
+		}
+
+		progress.Phase("done")
+	}()
+
+	return generate(ctx, cfg, progress)
+}
+
+// loadSchema loads the schema of cfg, with the directives of its federation version.
+func loadSchema(ctx context.Context, cfg *config.Config) error {
 	if cfg.Federation.Version != 0 {
 		var (
 			fedPlugin plugin.Plugin
@@ -83,7 +170,13 @@ func Generate(ctx context.Context, cfg *config.Config) error {
 		return fmt.Errorf("failed to load schema: %w", err)
 	}
 
-	err = cfg.GQLConfig.Init()
+	return nil
+}
+
+func generate(ctx context.Context, cfg *config.Config, progress *clientgenv2.Progress) error {
+	progress.Phase("loading packages")
+
+	err := cfg.GQLConfig.Init()
 	if err != nil {
 		return fmt.Errorf("generating core failed: %w", err)
 	}
@@ -93,24 +186,74 @@ func Generate(ctx context.Context, cfg *config.Config) error {
 		sort.Slice(v, func(i, j int) bool { return v[i].Name < v[j].Name })
 	}
 
+	progress.Phase("parsing queries")
+
 	querySources, err := parsequery.LoadQuerySources(cfg.Query)
 	if err != nil {
 		return fmt.Errorf("load query sources failed: %w", err)
 	}
 
-	queryDocument, err := parsequery.ParseQueryDocuments(cfg.GQLConfig.Schema, querySources)
+	queryDocument, warnings, err := parsequery.ParseQueryDocumentsWithRules(cfg.GQLConfig.Schema, querySources, cfg.Generate.GetValidationRules())
+	if err != nil {
+		return err
+	}
+
+	if len(warnings) > 0 {
+		fmt.Fprintln(os.Stderr, warnings)
+	}
+
+	nextSchema, err := cfg.LoadNextSchema()
+	if err != nil {
+		return err
+	}
+
+	if nextSchema != nil {
+		breaks, err := querydocument.ValidateNextSchema(nextSchema, querySources, queryDocument)
+		if err != nil {
+			return fmt.Errorf("validate queries against the next schema: %w", err)
+		}
+
+		if len(breaks) > 0 {
+			if cfg.Generate.ShouldFailOnNextSchema() {
+				return breaks
+			}
+
+			for _, err := range breaks {
+				err.Message = "warning: " + err.Message
+			}
+
+			fmt.Fprintln(os.Stderr, breaks)
+		}
+	}
+
+	if !cfg.Generate.ShouldAllowSubscriptions() {
+		err = parsequery.RejectSubscriptions(queryDocument)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = parsequery.DeriveSubsets(queryDocument)
 	if err != nil {
-		return fmt.Errorf(": %w", err)
+		return err
+	}
+
+	if cfg.Generate != nil && cfg.Generate.InlineSingleUseFragments {
+		querydocument.InlineSingleUseFragments(queryDocument)
 	}
 
-	operationQueryDocuments, err := querydocument.QueryDocumentsByOperations(cfg.GQLConfig.Schema, queryDocument.Operations)
+	operationQueryDocuments, err := querydocument.QueryDocumentsByOperations(cfg.GQLConfig.Schema, queryDocument.Operations, cfg.Generate.GetValidationRules())
 	if err != nil {
-		return fmt.Errorf(": %w", err)
+		return err
 	}
 
 	var clientGen api.Option
 	if cfg.Generate != nil {
-		clientGen = api.AddPlugin(clientgenv2.New(queryDocument, operationQueryDocuments, cfg.Client, cfg.Generate))
+		clientPlugin := clientgenv2.New(queryDocument, operationQueryDocuments, cfg.Client, cfg.Generate)
+		clientPlugin.SchemaHash = cfg.SchemaHash
+		clientPlugin.OverlayFields = cfg.OverlayFields()
+		clientPlugin.Progress = progress
+		clientGen = api.AddPlugin(clientPlugin)
 	}
 
 	var plugins []plugin.Plugin
@@ -119,7 +262,7 @@ func Generate(ctx context.Context, cfg *config.Config) error {
 		usedTypes := querydocument.CollectTypesFromQueryDocuments(cfg.GQLConfig.Schema, operationQueryDocuments)
 		p := &modelgen.Plugin{
 			MutateHook: mutateHook(cfg, usedTypes),
-			FieldHook:  modelgen.DefaultFieldMutateHook,
+			FieldHook:  fieldHook(cfg),
 		}
 
 		plugins = append(plugins, p)
@@ -129,12 +272,24 @@ func Generate(ctx context.Context, cfg *config.Config) error {
 
 	for _, p := range plugins {
 		if mut, ok := p.(plugin.ConfigMutator); ok {
+			progress.Phase("running %s", p.Name())
+
 			err := mut.MutateConfig(cfg.GQLConfig)
+			if errs, ok := parsequery.AsErrors(err); ok {
+				return errs
+			}
+
 			if err != nil {
 				return fmt.Errorf("%s failed: %w", p.Name(), err)
 			}
 		}
 	}
 
+	if cfg.Generate.ShouldCheckWasm() {
+		progress.Phase("building for js/wasm")
+
+		return checkWasm(ctx, cfg)
+	}
+
 	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -122,7 +123,8 @@ func (s *Suite) loadFiles(dir string) map[string]string {
 			return err
 		}
 
-		files[rel] = string(content)
+		// compare the same on Windows, where files may be checked out with CRLF line endings
+		files[filepath.ToSlash(rel)] = strings.ReplaceAll(string(content), "\r\n", "\n")
 
 		return nil
 	})
@@ -0,0 +1,151 @@
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/gqlgo/gqlgenc/clientgenv2"
+	"github.com/gqlgo/gqlgenc/config"
+)
+
+// backupSuffix ends the names of the previous outputs moved aside during generation. With the leading dot,
+// it keeps them out of the Go packages loaded while generating.
+const backupSuffix = ".gqlgenc-backup"
+
+// outputFiles returns the paths of the files generation may write, including the fragment files of a previous run.
+func outputFiles(cfg *config.Config) ([]string, error) {
+	paths := []string{cfg.Client.Filename, clientgenv2.ExamplesFilename(cfg.Client.Filename)}
+
+	if interfacePackage := cfg.Generate.GetClientInterfacePackage(); interfacePackage != nil {
+		paths = append(paths, interfacePackage.Filename)
+	}
+
+	if manifest := cfg.Generate.GetOperationManifest(); manifest != "" {
+		paths = append(paths, manifest)
+	}
+
+	if descriptions := cfg.Generate.GetOperationDescriptions(); descriptions != "" {
+		paths = append(paths, descriptions)
+	}
+
+	if cfg.Model.IsDefined() {
+		paths = append(paths,
+			cfg.Model.Filename,
+			filepath.Join(filepath.Dir(cfg.Model.Filename), clientgenv2.OmittableHelpersFilename),
+			filepath.Join(filepath.Dir(cfg.Model.Filename), clientgenv2.ValidationFilename),
+			filepath.Join(filepath.Dir(cfg.Model.Filename), clientgenv2.RedactionFilename),
+		)
+	}
+
+	fragmentFiles, err := filepath.Glob(filepath.Join(filepath.Dir(cfg.Client.Filename), "*"+clientgenv2.FragmentFileSuffix))
+	if err != nil {
+		return nil, fmt.Errorf("glob fragment files: %w", err)
+	}
+
+	return append(paths, fragmentFiles...), nil
+}
+
+// stashedOutputs are the previous outputs moved aside during generation, so stale generated code does not break
+// loading the packages it belongs to, and put back when generation fails.
+type stashedOutputs struct {
+	// backups are the paths the outputs were moved to by their paths.
+	backups map[string]string
+}
+
+func backupPath(path string) string {
+	return filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+backupSuffix)
+}
+
+// stashOutputs moves the existing files of paths aside.
+func stashOutputs(paths []string) (*stashedOutputs, error) {
+	stash := &stashedOutputs{backups: make(map[string]string, len(paths))}
+
+	for _, path := range paths {
+		if _, ok := stash.backups[path]; ok {
+			continue
+		}
+
+		backup := backupPath(path)
+
+		err := os.Rename(path, backup)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+
+		if err != nil {
+			return stash, fmt.Errorf("move %s aside: %w", path, err)
+		}
+
+		stash.backups[path] = backup
+	}
+
+	return stash, nil
+}
+
+// restore puts the previous outputs back after a failed generation, removing the files of paths
+// it wrote that did not exist before.
+func (s *stashedOutputs) restore(paths []string) error {
+	var errs []error
+
+	for _, path := range paths {
+		if _, ok := s.backups[path]; ok {
+			continue
+		}
+
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			errs = append(errs, fmt.Errorf("remove %s: %w", path, err))
+		}
+	}
+
+	for path, backup := range s.backups {
+		if err := os.Rename(backup, path); err != nil {
+			errs = append(errs, fmt.Errorf("restore %s: %w", path, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// commit drops the previous outputs replaced by a successful generation. The ones generated the same are put
+// back, so their modification time does not change, and the stale ones, no longer generated, are deleted when
+// clean is set and put back otherwise.
+func (s *stashedOutputs) commit(clean bool) error {
+	var errs []error
+
+	for path, backup := range s.backups {
+		_, err := os.Stat(path)
+
+		switch {
+		case err == nil && sameContent(path, backup):
+			err = os.Rename(backup, path)
+		case err == nil || clean:
+			err = os.Remove(backup)
+		case errors.Is(err, os.ErrNotExist):
+			err = os.Rename(backup, path)
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", path, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// sameContent reports whether the files at a and b can be read and have the same content.
+func sameContent(a, b string) bool {
+	contentA, err := os.ReadFile(a)
+	if err != nil {
+		return false
+	}
+
+	contentB, err := os.ReadFile(b)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(contentA, contentB)
+}
@@ -0,0 +1,127 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeOutputs(t *testing.T, dir string, names ...string) []string {
+	t.Helper()
+
+	paths := make([]string, 0, len(names))
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte("previous "+name), 0o600))
+
+		paths = append(paths, path)
+	}
+
+	return paths
+}
+
+func readDir(t *testing.T, dir string) map[string]string {
+	t.Helper()
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+
+	files := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		require.NoError(t, err)
+
+		files[entry.Name()] = string(content)
+	}
+
+	return files
+}
+
+func TestStashOutputs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("restore puts the previous outputs back", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		paths := writeOutputs(t, dir, "client.go", "user_fragments_gen.go")
+
+		stash, err := stashOutputs(append(paths, filepath.Join(dir, "models_gen.go")))
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{
+			".client.go.gqlgenc-backup":             "previous client.go",
+			".user_fragments_gen.go.gqlgenc-backup": "previous user_fragments_gen.go",
+		}, readDir(t, dir))
+
+		// a failed generation wrote part of its outputs
+		written := writeOutputs(t, dir, "client.go", "models_gen.go", "post_fragments_gen.go")
+
+		require.NoError(t, stash.restore(written))
+		require.Equal(t, map[string]string{
+			"client.go":             "previous client.go",
+			"user_fragments_gen.go": "previous user_fragments_gen.go",
+		}, readDir(t, dir))
+	})
+
+	t.Run("commit keeps stale outputs", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		paths := writeOutputs(t, dir, "client.go", "user_fragments_gen.go")
+
+		stash, err := stashOutputs(paths)
+		require.NoError(t, err)
+
+		require.NoError(t, os.WriteFile(paths[0], []byte("new client.go"), 0o600))
+
+		require.NoError(t, stash.commit(false))
+		require.Equal(t, map[string]string{
+			"client.go":             "new client.go",
+			"user_fragments_gen.go": "previous user_fragments_gen.go",
+		}, readDir(t, dir))
+	})
+
+	t.Run("commit cleans stale outputs", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		paths := writeOutputs(t, dir, "client.go", "user_fragments_gen.go")
+
+		stash, err := stashOutputs(paths)
+		require.NoError(t, err)
+
+		require.NoError(t, os.WriteFile(paths[0], []byte("new client.go"), 0o600))
+
+		require.NoError(t, stash.commit(true))
+		require.Equal(t, map[string]string{
+			"client.go": "new client.go",
+		}, readDir(t, dir))
+	})
+
+	t.Run("commit keeps unchanged outputs", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		paths := writeOutputs(t, dir, "client.go")
+
+		modTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+		require.NoError(t, os.Chtimes(paths[0], modTime, modTime))
+
+		stash, err := stashOutputs(paths)
+		require.NoError(t, err)
+
+		require.NoError(t, os.WriteFile(paths[0], []byte("previous client.go"), 0o600))
+
+		require.NoError(t, stash.commit(false))
+		require.Equal(t, map[string]string{
+			"client.go": "previous client.go",
+		}, readDir(t, dir))
+
+		info, err := os.Stat(paths[0])
+		require.NoError(t, err)
+		require.True(t, info.ModTime().Equal(modTime))
+	})
+}
@@ -0,0 +1,173 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type GetUsers_Me struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetUsers_Me) GetID() string {
+	if t == nil {
+		t = &GetUsers_Me{}
+	}
+	return t.ID
+}
+func (t *GetUsers_Me) GetName() string {
+	if t == nil {
+		t = &GetUsers_Me{}
+	}
+	return t.Name
+}
+
+type GetUsers_Friend struct {
+	Email string "json:\"email\" graphql:\"email\""
+	ID    string "json:\"id\" graphql:\"id\""
+}
+
+func (t *GetUsers_Friend) GetEmail() string {
+	if t == nil {
+		t = &GetUsers_Friend{}
+	}
+	return t.Email
+}
+func (t *GetUsers_Friend) GetID() string {
+	if t == nil {
+		t = &GetUsers_Friend{}
+	}
+	return t.ID
+}
+
+type GetUsers_UserOne struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetUsers_UserOne) GetID() string {
+	if t == nil {
+		t = &GetUsers_UserOne{}
+	}
+	return t.ID
+}
+func (t *GetUsers_UserOne) GetName() string {
+	if t == nil {
+		t = &GetUsers_UserOne{}
+	}
+	return t.Name
+}
+
+type GetUsers_UserOne2_Friends struct {
+	ID string "json:\"id\" graphql:\"id\""
+}
+
+func (t *GetUsers_UserOne2_Friends) GetID() string {
+	if t == nil {
+		t = &GetUsers_UserOne2_Friends{}
+	}
+	return t.ID
+}
+
+type GetUsers_UserOne2 struct {
+	Email   string                       "json:\"email\" graphql:\"email\""
+	Friends []*GetUsers_UserOne2_Friends "json:\"friends\" graphql:\"friends\""
+}
+
+func (t *GetUsers_UserOne2) GetEmail() string {
+	if t == nil {
+		t = &GetUsers_UserOne2{}
+	}
+	return t.Email
+}
+func (t *GetUsers_UserOne2) GetFriends() []*GetUsers_UserOne2_Friends {
+	if t == nil {
+		t = &GetUsers_UserOne2{}
+	}
+	return t.Friends
+}
+
+type GetUsers struct {
+	Me       *GetUsers_Me       "json:\"me,omitempty\" graphql:\"me\""
+	Friend   *GetUsers_Friend   "json:\"friend,omitempty\" graphql:\"friend\""
+	UserOne  *GetUsers_UserOne  "json:\"user_one,omitempty\" graphql:\"user_one\""
+	UserOne2 *GetUsers_UserOne2 "json:\"userOne,omitempty\" graphql:\"userOne\""
+}
+
+func (t *GetUsers) GetMe() *GetUsers_Me {
+	if t == nil {
+		t = &GetUsers{}
+	}
+	return t.Me
+}
+func (t *GetUsers) GetFriend() *GetUsers_Friend {
+	if t == nil {
+		t = &GetUsers{}
+	}
+	return t.Friend
+}
+func (t *GetUsers) GetUserOne() *GetUsers_UserOne {
+	if t == nil {
+		t = &GetUsers{}
+	}
+	return t.UserOne
+}
+func (t *GetUsers) GetUserOne2() *GetUsers_UserOne2 {
+	if t == nil {
+		t = &GetUsers{}
+	}
+	return t.UserOne2
+}
+
+const GetUsersDocument = `query GetUsers {
+	me: user(id: "1") {
+		id
+		name
+	}
+	friend: user(id: "2") {
+		id
+		email
+	}
+	user_one: user(id: "3") {
+		id
+		name
+	}
+	userOne: user(id: "4") {
+		email
+		friends {
+			id
+		}
+	}
+}
+`
+
+func (c *Client) GetUsers(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetUsers, error) {
+	vars := map[string]any{}
+
+	var res GetUsers
+	if err := c.Client.Post(ctx, "GetUsers", GetUsersDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUsersDocument: "GetUsers",
+}
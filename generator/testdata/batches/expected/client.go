@@ -0,0 +1,214 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type BatchClient interface {
+	GetUser(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser, error)
+	ListUsers(ctx context.Context, first *int, role *string, interceptors ...clientv2.RequestInterceptor) (*ListUsers, error)
+	UserPage(ctx context.Context, getUserID string, listUsersFirst *int, listUsersRole *string, interceptors ...clientv2.RequestInterceptor) (*UserPageResults, error)
+}
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) BatchClient {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type UserFields struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *UserFields) GetID() string {
+	if t == nil {
+		t = &UserFields{}
+	}
+	return t.ID
+}
+func (t *UserFields) GetName() string {
+	if t == nil {
+		t = &UserFields{}
+	}
+	return t.Name
+}
+
+type ListUsers_Users_Edges struct {
+	Node *UserFields "json:\"node\" graphql:\"node\""
+}
+
+func (t *ListUsers_Users_Edges) GetNode() *UserFields {
+	if t == nil {
+		t = &ListUsers_Users_Edges{}
+	}
+	return t.Node
+}
+
+type ListUsers_Users struct {
+	Edges []*ListUsers_Users_Edges "json:\"edges\" graphql:\"edges\""
+	Total int                      "json:\"total\" graphql:\"total\""
+}
+
+func (t *ListUsers_Users) GetEdges() []*ListUsers_Users_Edges {
+	if t == nil {
+		t = &ListUsers_Users{}
+	}
+	return t.Edges
+}
+func (t *ListUsers_Users) GetTotal() int {
+	if t == nil {
+		t = &ListUsers_Users{}
+	}
+	return t.Total
+}
+
+type GetUser struct {
+	User *UserFields "json:\"user,omitempty\" graphql:\"user\""
+}
+
+func (t *GetUser) GetUser() *UserFields {
+	if t == nil {
+		t = &GetUser{}
+	}
+	return t.User
+}
+
+type ListUsers struct {
+	Users ListUsers_Users "json:\"users\" graphql:\"users\""
+}
+
+func (t *ListUsers) GetUsers() *ListUsers_Users {
+	if t == nil {
+		t = &ListUsers{}
+	}
+	return &t.Users
+}
+
+const GetUserDocument = `query GetUser ($id: ID!) {
+	user(id: $id) {
+		... UserFields
+	}
+}
+fragment UserFields on User {
+	id
+	name
+}
+`
+
+func (c *Client) GetUser(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetUser
+	if err := c.Client.Post(ctx, "GetUser", GetUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const ListUsersDocument = `query ListUsers ($first: Int, $role: String) {
+	users(first: $first, role: $role) {
+		edges {
+			node {
+				... UserFields
+			}
+		}
+		total: totalCount
+	}
+}
+fragment UserFields on User {
+	id
+	name
+}
+`
+
+func (c *Client) ListUsers(ctx context.Context, first *int, role *string, interceptors ...clientv2.RequestInterceptor) (*ListUsers, error) {
+	vars := map[string]any{
+		"first": first,
+		"role":  role,
+	}
+
+	var res ListUsers
+	if err := c.Client.Post(ctx, "ListUsers", ListUsersDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const UserPageDocument = `query UserPage ($GetUser_id: ID!, $ListUsers_first: Int, $ListUsers_role: String) {
+	GetUser_user: user(id: $GetUser_id) {
+		... UserFields
+	}
+	ListUsers_users: users(first: $ListUsers_first, role: $ListUsers_role) {
+		edges {
+			node {
+				... UserFields
+			}
+		}
+		total: totalCount
+	}
+}
+fragment UserFields on User {
+	id
+	name
+}
+`
+
+// UserPageResults are the results of the operations of the UserPage batch.
+type UserPageResults struct {
+	GetUser   *GetUser
+	ListUsers *ListUsers
+}
+
+// UserPage sends the operations of the UserPage batch as a single query and returns their results:
+// GetUser, ListUsers.
+func (c *Client) UserPage(ctx context.Context, getUserID string, listUsersFirst *int, listUsersRole *string, interceptors ...clientv2.RequestInterceptor) (*UserPageResults, error) {
+	vars := map[string]any{
+		"GetUser_id":      getUserID,
+		"ListUsers_first": listUsersFirst,
+		"ListUsers_role":  listUsersRole,
+	}
+
+	res := UserPageResults{
+		GetUser:   &GetUser{},
+		ListUsers: &ListUsers{},
+	}
+	results := map[string]any{
+		"GetUser_":   res.GetUser,
+		"ListUsers_": res.ListUsers,
+	}
+
+	if err := c.Client.PostBatch(ctx, "UserPage", UserPageDocument, results, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUserDocument:   "GetUser",
+	ListUsersDocument: "ListUsers",
+	UserPageDocument:  "UserPage",
+}
@@ -0,0 +1,266 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+// Sentinel errors of the operations, which the errors returned by their Client methods wrap.
+var (
+	ErrGetAccount   = errors.New("GetAccount")
+	ErrListSessions = errors.New("ListSessions")
+	ErrAccountPage  = errors.New("AccountPage")
+)
+
+type GetAccount_Account struct {
+	APIKey string "json:\"apiKey\" graphql:\"apiKey\""
+	Email  string "json:\"email\" graphql:\"email\""
+	ID     string "json:\"id\" graphql:\"id\""
+}
+
+func (t *GetAccount_Account) GetAPIKey() string {
+	if t == nil {
+		t = &GetAccount_Account{}
+	}
+	return t.APIKey
+}
+func (t *GetAccount_Account) GetEmail() string {
+	if t == nil {
+		t = &GetAccount_Account{}
+	}
+	return t.Email
+}
+func (t *GetAccount_Account) GetID() string {
+	if t == nil {
+		t = &GetAccount_Account{}
+	}
+	return t.ID
+}
+
+type ListSessions_Sessions struct {
+	ID    string "json:\"id\" graphql:\"id\""
+	Token string "json:\"token\" graphql:\"token\""
+}
+
+func (t *ListSessions_Sessions) GetID() string {
+	if t == nil {
+		t = &ListSessions_Sessions{}
+	}
+	return t.ID
+}
+func (t *ListSessions_Sessions) GetToken() string {
+	if t == nil {
+		t = &ListSessions_Sessions{}
+	}
+	return t.Token
+}
+
+type GetAccount struct {
+	Account *GetAccount_Account "json:\"account,omitempty\" graphql:\"account\""
+}
+
+func (t *GetAccount) GetAccount() *GetAccount_Account {
+	if t == nil {
+		t = &GetAccount{}
+	}
+	return t.Account
+}
+
+type ListSessions struct {
+	Sessions []*ListSessions_Sessions "json:\"sessions\" graphql:\"sessions\""
+}
+
+func (t *ListSessions) GetSessions() []*ListSessions_Sessions {
+	if t == nil {
+		t = &ListSessions{}
+	}
+	return t.Sessions
+}
+
+const GetAccountDocument = `query GetAccount ($id: ID!) {
+	account(id: $id) {
+		id
+		email
+		apiKey
+	}
+}
+`
+
+// GetAccountSensitiveFields are the sensitive variables and fields of GetAccount, hidden from transcripts.
+var GetAccountSensitiveFields = clientv2.SensitiveFields{
+	Response: []string{"account.apiKey"},
+}
+
+// GetAccountExtensions are the extensions sent with GetAccount.
+var GetAccountExtensions = map[string]any{
+	"admission": json.RawMessage("{\"operation\": \"GetAccount\", \"complexity\": 4}"),
+}
+
+func (c *Client) GetAccount(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetAccount, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	ctx = clientv2.WithSensitiveFields(ctx, GetAccountSensitiveFields)
+
+	ctx = clientv2.WithRequestExtensions(ctx, GetAccountExtensions)
+
+	ctx, err := c.Client.BeforeOperation(ctx, "GetAccount", vars)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGetAccount, err)
+	}
+
+	var res GetAccount
+	err = c.Client.Post(ctx, "GetAccount", GetAccountDocument, &res, vars, interceptors...)
+	if err = c.Client.AfterOperation(ctx, "GetAccount", vars, &res, err); err != nil {
+		err = fmt.Errorf("%w: %w", ErrGetAccount, err)
+
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const ListSessionsDocument = `query ListSessions ($accountId: ID!, $token: String) {
+	sessions(accountId: $accountId, token: $token) {
+		id
+		token
+	}
+}
+`
+
+// ListSessionsSensitiveFields are the sensitive variables and fields of ListSessions, hidden from transcripts.
+var ListSessionsSensitiveFields = clientv2.SensitiveFields{
+	Variables: []string{"token"},
+	Response:  []string{"sessions.token"},
+}
+
+// ListSessionsExtensions are the extensions sent with ListSessions.
+var ListSessionsExtensions = map[string]any{
+	"admission": json.RawMessage("{\"operation\": \"ListSessions\", \"complexity\": 3}"),
+}
+
+func (c *Client) ListSessions(ctx context.Context, accountID string, token *string, interceptors ...clientv2.RequestInterceptor) (*ListSessions, error) {
+	vars := map[string]any{
+		"accountId": accountID,
+		"token":     token,
+	}
+
+	ctx = clientv2.WithSensitiveFields(ctx, ListSessionsSensitiveFields)
+
+	ctx = clientv2.WithRequestExtensions(ctx, ListSessionsExtensions)
+
+	ctx, err := c.Client.BeforeOperation(ctx, "ListSessions", vars)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrListSessions, err)
+	}
+
+	var res ListSessions
+	err = c.Client.Post(ctx, "ListSessions", ListSessionsDocument, &res, vars, interceptors...)
+	if err = c.Client.AfterOperation(ctx, "ListSessions", vars, &res, err); err != nil {
+		err = fmt.Errorf("%w: %w", ErrListSessions, err)
+
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const AccountPageDocument = `query AccountPage ($GetAccount_id: ID!, $ListSessions_accountId: ID!, $ListSessions_token: String) {
+	GetAccount_account: account(id: $GetAccount_id) {
+		id
+		email
+		apiKey
+	}
+	ListSessions_sessions: sessions(accountId: $ListSessions_accountId, token: $ListSessions_token) {
+		id
+		token
+	}
+}
+`
+
+// AccountPageSensitiveFields are the sensitive variables and fields of the AccountPage batch, hidden from transcripts.
+var AccountPageSensitiveFields = clientv2.SensitiveFields{
+	Variables: []string{"ListSessions_token"},
+	Response:  []string{"GetAccount_account.apiKey", "ListSessions_sessions.token"},
+}
+
+// AccountPageExtensions are the extensions sent with the AccountPage batch.
+var AccountPageExtensions = map[string]any{
+	"admission": json.RawMessage("{\"operation\": \"AccountPage\", \"complexity\": 7}"),
+}
+
+// AccountPageResults are the results of the operations of the AccountPage batch.
+type AccountPageResults struct {
+	GetAccount   *GetAccount
+	ListSessions *ListSessions
+}
+
+// AccountPage sends the operations of the AccountPage batch as a single query and returns their results:
+// GetAccount, ListSessions.
+func (c *Client) AccountPage(ctx context.Context, getAccountID string, listSessionsAccountID string, listSessionsToken *string, interceptors ...clientv2.RequestInterceptor) (*AccountPageResults, error) {
+	vars := map[string]any{
+		"GetAccount_id":          getAccountID,
+		"ListSessions_accountId": listSessionsAccountID,
+		"ListSessions_token":     listSessionsToken,
+	}
+
+	res := AccountPageResults{
+		GetAccount:   &GetAccount{},
+		ListSessions: &ListSessions{},
+	}
+	results := map[string]any{
+		"GetAccount_":   res.GetAccount,
+		"ListSessions_": res.ListSessions,
+	}
+
+	ctx = clientv2.WithSensitiveFields(ctx, AccountPageSensitiveFields)
+
+	ctx = clientv2.WithRequestExtensions(ctx, AccountPageExtensions)
+
+	ctx, err := c.Client.BeforeOperation(ctx, "AccountPage", vars)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrAccountPage, err)
+	}
+
+	err = c.Client.PostBatch(ctx, "AccountPage", AccountPageDocument, results, vars, interceptors...)
+	if err = c.Client.AfterOperation(ctx, "AccountPage", vars, &res, err); err != nil {
+		err = fmt.Errorf("%w: %w", ErrAccountPage, err)
+
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	GetAccountDocument:   "GetAccount",
+	ListSessionsDocument: "ListSessions",
+	AccountPageDocument:  "AccountPage",
+}
@@ -0,0 +1,17 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package generated
+
+type Account struct {
+	ID     string `json:"id"`
+	Email  string `json:"email"`
+	APIKey string `json:"apiKey"`
+}
+
+type Query struct {
+}
+
+type Session struct {
+	ID    string `json:"id"`
+	Token string `json:"token"`
+}
@@ -0,0 +1,318 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+	"github.com/gqlgo/gqlgenc/graphqljson"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type SearchResultFragment struct {
+	Typename *string                      "json:\"__typename,omitempty\" graphql:\"__typename\""
+	Article  SearchResultFragment_Article "graphql:\"... on Article\""
+	Unknown  *graphqljson.Unknown         "json:\"-\" graphql:\"-\" typenames:\"Article\""
+}
+
+func (t *SearchResultFragment) GetTypename() *string {
+	if t == nil {
+		t = &SearchResultFragment{}
+	}
+	return t.Typename
+}
+func (t *SearchResultFragment) GetArticle() *SearchResultFragment_Article {
+	if t == nil {
+		t = &SearchResultFragment{}
+	}
+	return &t.Article
+}
+func (t *SearchResultFragment) GetUnknown() *graphqljson.Unknown {
+	if t == nil {
+		t = &SearchResultFragment{}
+	}
+	return t.Unknown
+}
+
+type SearchResultFragment_Article struct {
+	Title string "json:\"title\" graphql:\"title\""
+}
+
+func (t *SearchResultFragment_Article) GetTitle() string {
+	if t == nil {
+		t = &SearchResultFragment_Article{}
+	}
+	return t.Title
+}
+
+type Search_Search_Article struct {
+	ID    string "json:\"id\" graphql:\"id\""
+	Title string "json:\"title\" graphql:\"title\""
+}
+
+func (t *Search_Search_Article) GetID() string {
+	if t == nil {
+		t = &Search_Search_Article{}
+	}
+	return t.ID
+}
+func (t *Search_Search_Article) GetTitle() string {
+	if t == nil {
+		t = &Search_Search_Article{}
+	}
+	return t.Title
+}
+
+type Search_Search_Video struct {
+	ID       string "json:\"id\" graphql:\"id\""
+	Duration int    "json:\"duration\" graphql:\"duration\""
+}
+
+func (t *Search_Search_Video) GetID() string {
+	if t == nil {
+		t = &Search_Search_Video{}
+	}
+	return t.ID
+}
+func (t *Search_Search_Video) GetDuration() int {
+	if t == nil {
+		t = &Search_Search_Video{}
+	}
+	return t.Duration
+}
+
+type Search_Search struct {
+	Article  Search_Search_Article "graphql:\"... on Article\""
+	Video    Search_Search_Video   "graphql:\"... on Video\""
+	Typename *string               "json:\"__typename,omitempty\" graphql:\"__typename\""
+	Unknown  *graphqljson.Unknown  "json:\"-\" graphql:\"-\" typenames:\"Article,Video\""
+}
+
+func (t *Search_Search) GetArticle() *Search_Search_Article {
+	if t == nil {
+		t = &Search_Search{}
+	}
+	return &t.Article
+}
+func (t *Search_Search) GetVideo() *Search_Search_Video {
+	if t == nil {
+		t = &Search_Search{}
+	}
+	return &t.Video
+}
+func (t *Search_Search) GetTypename() *string {
+	if t == nil {
+		t = &Search_Search{}
+	}
+	return t.Typename
+}
+func (t *Search_Search) GetUnknown() *graphqljson.Unknown {
+	if t == nil {
+		t = &Search_Search{}
+	}
+	return t.Unknown
+}
+
+type GetNode_Node_Article struct {
+	Title string "json:\"title\" graphql:\"title\""
+}
+
+func (t *GetNode_Node_Article) GetTitle() string {
+	if t == nil {
+		t = &GetNode_Node_Article{}
+	}
+	return t.Title
+}
+
+type GetNode_Node_Media struct {
+	Duration int "json:\"duration\" graphql:\"duration\""
+}
+
+func (t *GetNode_Node_Media) GetDuration() int {
+	if t == nil {
+		t = &GetNode_Node_Media{}
+	}
+	return t.Duration
+}
+
+type GetNode_Node struct {
+	Article  GetNode_Node_Article "graphql:\"... on Article\""
+	Media    GetNode_Node_Media   "graphql:\"... on Media\""
+	Typename *string              "json:\"__typename,omitempty\" graphql:\"__typename\""
+	Unknown  *graphqljson.Unknown "json:\"-\" graphql:\"-\" typenames:\"Article,Podcast,Video\""
+}
+
+func (t *GetNode_Node) GetArticle() *GetNode_Node_Article {
+	if t == nil {
+		t = &GetNode_Node{}
+	}
+	return &t.Article
+}
+func (t *GetNode_Node) GetMedia() *GetNode_Node_Media {
+	if t == nil {
+		t = &GetNode_Node{}
+	}
+	return &t.Media
+}
+func (t *GetNode_Node) GetTypename() *string {
+	if t == nil {
+		t = &GetNode_Node{}
+	}
+	return t.Typename
+}
+func (t *GetNode_Node) GetUnknown() *graphqljson.Unknown {
+	if t == nil {
+		t = &GetNode_Node{}
+	}
+	return t.Unknown
+}
+
+type SearchWithFragment_Search_SearchResultFragment_Article struct {
+	Title string "json:\"title\" graphql:\"title\""
+}
+
+func (t *SearchWithFragment_Search_SearchResultFragment_Article) GetTitle() string {
+	if t == nil {
+		t = &SearchWithFragment_Search_SearchResultFragment_Article{}
+	}
+	return t.Title
+}
+
+type Search struct {
+	Search []*Search_Search "json:\"search\" graphql:\"search\""
+}
+
+func (t *Search) GetSearch() []*Search_Search {
+	if t == nil {
+		t = &Search{}
+	}
+	return t.Search
+}
+
+type GetNode struct {
+	Node *GetNode_Node "json:\"node,omitempty\" graphql:\"node\""
+}
+
+func (t *GetNode) GetNode() *GetNode_Node {
+	if t == nil {
+		t = &GetNode{}
+	}
+	return t.Node
+}
+
+type SearchWithFragment struct {
+	Search []*SearchResultFragment "json:\"search\" graphql:\"search\""
+}
+
+func (t *SearchWithFragment) GetSearch() []*SearchResultFragment {
+	if t == nil {
+		t = &SearchWithFragment{}
+	}
+	return t.Search
+}
+
+const SearchDocument = `query Search ($query: String!) {
+	search(query: $query) {
+		__typename
+		... on Article {
+			id
+			title
+		}
+		... on Video {
+			id
+			duration
+		}
+	}
+}
+`
+
+func (c *Client) Search(ctx context.Context, query string, interceptors ...clientv2.RequestInterceptor) (*Search, error) {
+	vars := map[string]any{
+		"query": query,
+	}
+
+	var res Search
+	if err := c.Client.Post(ctx, "Search", SearchDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetNodeDocument = `query GetNode ($id: ID!) {
+	node(id: $id) {
+		__typename
+		... on Article {
+			title
+		}
+		... on Media {
+			duration
+		}
+	}
+}
+`
+
+func (c *Client) GetNode(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetNode, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetNode
+	if err := c.Client.Post(ctx, "GetNode", GetNodeDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const SearchWithFragmentDocument = `query SearchWithFragment ($query: String!) {
+	search(query: $query) {
+		... SearchResultFragment
+	}
+}
+fragment SearchResultFragment on SearchResult {
+	__typename
+	... on Article {
+		title
+	}
+}
+`
+
+func (c *Client) SearchWithFragment(ctx context.Context, query string, interceptors ...clientv2.RequestInterceptor) (*SearchWithFragment, error) {
+	vars := map[string]any{
+		"query": query,
+	}
+
+	var res SearchWithFragment
+	if err := c.Client.Post(ctx, "SearchWithFragment", SearchWithFragmentDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	SearchDocument:             "Search",
+	GetNodeDocument:            "GetNode",
+	SearchWithFragmentDocument: "SearchWithFragment",
+}
@@ -0,0 +1,57 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package generated
+
+type Media interface {
+	IsMedia()
+	GetDuration() int
+}
+
+type Node interface {
+	IsNode()
+	GetID() string
+}
+
+type SearchResult interface {
+	IsSearchResult()
+}
+
+type Article struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+func (Article) IsNode()            {}
+func (this Article) GetID() string { return this.ID }
+
+func (Article) IsSearchResult() {}
+
+type Podcast struct {
+	ID       string `json:"id"`
+	Duration int    `json:"duration"`
+	Host     string `json:"host"`
+}
+
+func (Podcast) IsMedia()              {}
+func (this Podcast) GetDuration() int { return this.Duration }
+
+func (Podcast) IsNode()            {}
+func (this Podcast) GetID() string { return this.ID }
+
+func (Podcast) IsSearchResult() {}
+
+type Query struct {
+}
+
+type Video struct {
+	ID       string `json:"id"`
+	Duration int    `json:"duration"`
+}
+
+func (Video) IsMedia()              {}
+func (this Video) GetDuration() int { return this.Duration }
+
+func (Video) IsNode()            {}
+func (this Video) GetID() string { return this.ID }
+
+func (Video) IsSearchResult() {}
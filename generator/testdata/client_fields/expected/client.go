@@ -0,0 +1,148 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type GetUser_User struct {
+	FirstName string "json:\"firstName\" graphql:\"firstName\""
+	FullName  string "json:\"fullName\" graphql:\"fullName\" client:\"User.fullName\""
+	ID        string "json:\"id\" graphql:\"id\""
+	LastName  string "json:\"lastName\" graphql:\"lastName\""
+	Selected  *bool  "json:\"selected,omitempty\" graphql:\"selected\" client:\"User.selected\""
+}
+
+func (t *GetUser_User) GetFirstName() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.FirstName
+}
+func (t *GetUser_User) GetFullName() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.FullName
+}
+func (t *GetUser_User) GetID() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.ID
+}
+func (t *GetUser_User) GetLastName() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.LastName
+}
+func (t *GetUser_User) GetSelected() *bool {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Selected
+}
+
+type GetUserName_User struct {
+	FirstName string "json:\"firstName\" graphql:\"firstName\""
+}
+
+func (t *GetUserName_User) GetFirstName() string {
+	if t == nil {
+		t = &GetUserName_User{}
+	}
+	return t.FirstName
+}
+
+type GetUser struct {
+	User *GetUser_User "json:\"user,omitempty\" graphql:\"user\""
+}
+
+func (t *GetUser) GetUser() *GetUser_User {
+	if t == nil {
+		t = &GetUser{}
+	}
+	return t.User
+}
+
+type GetUserName struct {
+	User *GetUserName_User "json:\"user,omitempty\" graphql:\"user\""
+}
+
+func (t *GetUserName) GetUser() *GetUserName_User {
+	if t == nil {
+		t = &GetUserName{}
+	}
+	return t.User
+}
+
+const GetUserDocument = `query GetUser ($id: ID!) {
+	user(id: $id) {
+		id
+		firstName
+		lastName
+	}
+}
+`
+
+func (c *Client) GetUser(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetUser
+	if err := c.Client.Post(ctx, "GetUser", GetUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	if err := c.Client.ResolveClientFields(ctx, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetUserNameDocument = `query GetUserName ($id: ID!) {
+	user(id: $id) {
+		firstName
+	}
+}
+`
+
+func (c *Client) GetUserName(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUserName, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetUserName
+	if err := c.Client.Post(ctx, "GetUserName", GetUserNameDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUserDocument:     "GetUser",
+	GetUserNameDocument: "GetUserName",
+}
@@ -0,0 +1,332 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type UserFields struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *UserFields) GetID() string {
+	if t == nil {
+		t = &UserFields{}
+	}
+	return t.ID
+}
+func (t *UserFields) GetName() string {
+	if t == nil {
+		t = &UserFields{}
+	}
+	return t.Name
+}
+
+type GetUser_User struct {
+	Typename *string "json:\"__typename,omitempty\" graphql:\"__typename\""
+	ID       string  "json:\"id\" graphql:\"id\""
+	Name     string  "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetUser_User) GetTypename() *string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Typename
+}
+func (t *GetUser_User) GetID() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.ID
+}
+func (t *GetUser_User) GetName() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Name
+}
+
+type ListUsers_Users_Edges struct {
+	Cursor string      "json:\"cursor\" graphql:\"cursor\""
+	Node   *UserFields "json:\"node\" graphql:\"node\""
+}
+
+func (t *ListUsers_Users_Edges) GetCursor() string {
+	if t == nil {
+		t = &ListUsers_Users_Edges{}
+	}
+	return t.Cursor
+}
+func (t *ListUsers_Users_Edges) GetNode() *UserFields {
+	if t == nil {
+		t = &ListUsers_Users_Edges{}
+	}
+	return t.Node
+}
+
+type ListUsers_Users_PageInfo struct {
+	EndCursor   *string "json:\"endCursor,omitempty\" graphql:\"endCursor\""
+	HasNextPage bool    "json:\"hasNextPage\" graphql:\"hasNextPage\""
+}
+
+func (t *ListUsers_Users_PageInfo) GetEndCursor() *string {
+	if t == nil {
+		t = &ListUsers_Users_PageInfo{}
+	}
+	return t.EndCursor
+}
+func (t *ListUsers_Users_PageInfo) GetHasNextPage() bool {
+	if t == nil {
+		t = &ListUsers_Users_PageInfo{}
+	}
+	return t.HasNextPage
+}
+
+type ListUsers_Users struct {
+	Edges    []*ListUsers_Users_Edges "json:\"edges\" graphql:\"edges\""
+	PageInfo ListUsers_Users_PageInfo "json:\"pageInfo\" graphql:\"pageInfo\""
+}
+
+func (t *ListUsers_Users) GetEdges() []*ListUsers_Users_Edges {
+	if t == nil {
+		t = &ListUsers_Users{}
+	}
+	return t.Edges
+}
+func (t *ListUsers_Users) GetPageInfo() *ListUsers_Users_PageInfo {
+	if t == nil {
+		t = &ListUsers_Users{}
+	}
+	return &t.PageInfo
+}
+
+type Dashboard_Users struct {
+	TotalCount int "json:\"totalCount\" graphql:\"totalCount\""
+}
+
+func (t *Dashboard_Users) GetTotalCount() int {
+	if t == nil {
+		t = &Dashboard_Users{}
+	}
+	return t.TotalCount
+}
+
+type Dashboard_Repos struct {
+	TotalCount *int "json:\"totalCount,omitempty\" graphql:\"totalCount\""
+}
+
+func (t *Dashboard_Repos) GetTotalCount() *int {
+	if t == nil {
+		t = &Dashboard_Repos{}
+	}
+	return t.TotalCount
+}
+
+type GetUser struct {
+	User *GetUser_User "json:\"user,omitempty\" graphql:\"user\""
+}
+
+func (t *GetUser) GetUser() *GetUser_User {
+	if t == nil {
+		t = &GetUser{}
+	}
+	return t.User
+}
+
+type ListUsers struct {
+	Users ListUsers_Users "json:\"users\" graphql:\"users\""
+}
+
+func (t *ListUsers) GetUsers() *ListUsers_Users {
+	if t == nil {
+		t = &ListUsers{}
+	}
+	return &t.Users
+}
+
+type Dashboard struct {
+	Users Dashboard_Users  "json:\"users\" graphql:\"users\""
+	Repos *Dashboard_Repos "json:\"repos,omitempty\" graphql:\"repos\""
+}
+
+func (t *Dashboard) GetUsers() *Dashboard_Users {
+	if t == nil {
+		t = &Dashboard{}
+	}
+	return &t.Users
+}
+func (t *Dashboard) GetRepos() *Dashboard_Repos {
+	if t == nil {
+		t = &Dashboard{}
+	}
+	return t.Repos
+}
+
+const GetUserDocument = `query GetUser ($id: ID!) {
+	user(id: $id) {
+		__typename
+		id
+		name
+	}
+}
+`
+
+// GetUserExtensions are the extensions sent with GetUser.
+var GetUserExtensions = map[string]any{
+	"admission": json.RawMessage("{\"operation\": \"GetUser\", \"kind\": \"query\", \"complexity\": 3, \"depth\": 2}"),
+}
+
+func (c *Client) GetUser(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	ctx = clientv2.WithRequestExtensions(ctx, GetUserExtensions)
+
+	var res GetUser
+	if err := c.Client.Post(ctx, "GetUser", GetUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// BuildGetUserRequest returns the HTTP request of GetUser without sending it, for sending it through another HTTP stack.
+func (c *Client) BuildGetUserRequest(ctx context.Context, id string) (*http.Request, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	ctx = clientv2.WithRequestExtensions(ctx, GetUserExtensions)
+
+	return c.Client.NewRequest(ctx, "GetUser", GetUserDocument, vars)
+}
+
+const ListUsersDocument = `query ListUsers ($first: Int = 20, $after: String) {
+	users(first: $first, after: $after) {
+		edges {
+			cursor
+			node {
+				... UserFields
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
+}
+fragment UserFields on User {
+	id
+	name
+}
+`
+
+// ListUsersExtensions are the extensions sent with ListUsers.
+var ListUsersExtensions = map[string]any{
+	"admission": json.RawMessage("{\"operation\": \"ListUsers\", \"kind\": \"query\", \"complexity\": 161, \"depth\": 4}"),
+}
+
+func (c *Client) ListUsers(ctx context.Context, first *int, after *string, interceptors ...clientv2.RequestInterceptor) (*ListUsers, error) {
+	vars := map[string]any{
+		"first": first,
+		"after": after,
+	}
+
+	ctx = clientv2.WithRequestExtensions(ctx, ListUsersExtensions)
+
+	var res ListUsers
+	if err := c.Client.Post(ctx, "ListUsers", ListUsersDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// BuildListUsersRequest returns the HTTP request of ListUsers without sending it, for sending it through another HTTP stack.
+func (c *Client) BuildListUsersRequest(ctx context.Context, first *int, after *string) (*http.Request, error) {
+	vars := map[string]any{
+		"first": first,
+		"after": after,
+	}
+
+	ctx = clientv2.WithRequestExtensions(ctx, ListUsersExtensions)
+
+	return c.Client.NewRequest(ctx, "ListUsers", ListUsersDocument, vars)
+}
+
+const DashboardDocument = `query Dashboard ($owner: ID!, $first: Int) {
+	users(first: 10) {
+		totalCount
+	}
+	repos: repositories(owner: $owner, first: $first) {
+		totalCount
+	}
+}
+`
+
+// DashboardExtensions are the extensions sent with Dashboard.
+var DashboardExtensions = map[string]any{
+	"admission": json.RawMessage("{\"operation\": \"Dashboard\", \"kind\": \"query\", \"complexity\": 13, \"depth\": 2}"),
+}
+
+func (c *Client) Dashboard(ctx context.Context, owner string, first *int, interceptors ...clientv2.RequestInterceptor) (*Dashboard, error) {
+	vars := map[string]any{
+		"owner": owner,
+		"first": first,
+	}
+
+	ctx = clientv2.WithRequestExtensions(ctx, DashboardExtensions)
+
+	var res Dashboard
+	if err := c.Client.Post(ctx, "Dashboard", DashboardDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// BuildDashboardRequest returns the HTTP request of Dashboard without sending it, for sending it through another HTTP stack.
+func (c *Client) BuildDashboardRequest(ctx context.Context, owner string, first *int) (*http.Request, error) {
+	vars := map[string]any{
+		"owner": owner,
+		"first": first,
+	}
+
+	ctx = clientv2.WithRequestExtensions(ctx, DashboardExtensions)
+
+	return c.Client.NewRequest(ctx, "Dashboard", DashboardDocument, vars)
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUserDocument:   "GetUser",
+	ListUsersDocument: "ListUsers",
+	DashboardDocument: "Dashboard",
+}
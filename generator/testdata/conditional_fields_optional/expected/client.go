@@ -0,0 +1,114 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type GetUser_User_Profile struct {
+	Bio string "json:\"bio\" graphql:\"bio\""
+}
+
+func (t *GetUser_User_Profile) GetBio() string {
+	if t == nil {
+		t = &GetUser_User_Profile{}
+	}
+	return t.Bio
+}
+
+type GetUser_User struct {
+	Age     *int                  "json:\"age,omitempty\" graphql:\"age\""
+	ID      string                "json:\"id\" graphql:\"id\""
+	Name    *string               "json:\"name,omitempty\" graphql:\"name\""
+	Profile *GetUser_User_Profile "json:\"profile,omitempty\" graphql:\"profile\""
+	Tags    []string              "json:\"tags,omitempty\" graphql:\"tags\""
+}
+
+func (t *GetUser_User) GetAge() *int {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Age
+}
+func (t *GetUser_User) GetID() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.ID
+}
+func (t *GetUser_User) GetName() *string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Name
+}
+func (t *GetUser_User) GetProfile() *GetUser_User_Profile {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Profile
+}
+func (t *GetUser_User) GetTags() []string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Tags
+}
+
+type GetUser struct {
+	User GetUser_User "json:\"user\" graphql:\"user\""
+}
+
+func (t *GetUser) GetUser() *GetUser_User {
+	if t == nil {
+		t = &GetUser{}
+	}
+	return &t.User
+}
+
+const GetUserDocument = `query GetUser ($id: ID!, $withDetails: Boolean!, $skipProfile: Boolean!) {
+	user(id: $id) {
+		id
+		name @include(if: $withDetails)
+		age @include(if: $withDetails)
+		tags @include(if: $withDetails)
+		profile @skip(if: $skipProfile) {
+			bio
+		}
+	}
+}
+`
+
+func (c *Client) GetUser(ctx context.Context, id string, withDetails bool, skipProfile bool, interceptors ...clientv2.RequestInterceptor) (*GetUser, error) {
+	vars := map[string]any{
+		"id":          id,
+		"withDetails": withDetails,
+		"skipProfile": skipProfile,
+	}
+
+	var res GetUser
+	if err := c.Client.Post(ctx, "GetUser", GetUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUserDocument: "GetUser",
+}
@@ -0,0 +1,18 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package generated
+
+type Profile struct {
+	Bio string `json:"bio"`
+}
+
+type Query struct {
+}
+
+type User struct {
+	ID      string   `json:"id"`
+	Name    string   `json:"name"`
+	Age     *int     `json:"age,omitempty"`
+	Tags    []string `json:"tags"`
+	Profile *Profile `json:"profile"`
+}
@@ -0,0 +1,603 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type CountClient interface {
+	GetUser(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser, error)
+	ListUsers(ctx context.Context, first *int, after *string, role *string, interceptors ...clientv2.RequestInterceptor) (*ListUsers, error)
+	CountListUsers(ctx context.Context, role *string, interceptors ...clientv2.RequestInterceptor) (int, error)
+	Dashboard(ctx context.Context, owner string, first *int, interceptors ...clientv2.RequestInterceptor) (*Dashboard, error)
+	CountDashboardUsers(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (int, error)
+	CountDashboardRepos(ctx context.Context, owner string, interceptors ...clientv2.RequestInterceptor) (*int, error)
+	SearchUsers(ctx context.Context, filter UserSearch, interceptors ...clientv2.RequestInterceptor) (*SearchUsers, error)
+	CountSearchUsers(ctx context.Context, filter UserSearch, interceptors ...clientv2.RequestInterceptor) (int, error)
+}
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) CountClient {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+// Sentinel errors for the values of the ErrorCode enum. Errors returned by Client methods match them
+// with errors.Is when a GraphQL error has the value as its extensions.code.
+var (
+	ErrNotFound        = errors.New("NOT_FOUND")
+	ErrUnauthenticated = errors.New("UNAUTHENTICATED")
+)
+
+var errorCodes = map[string]error{
+	"NOT_FOUND":       ErrNotFound,
+	"UNAUTHENTICATED": ErrUnauthenticated,
+}
+
+// WrapErrorCodes wraps err so errors.Is reports the sentinel error of the extensions.code of each of its GraphQL errors.
+func WrapErrorCodes(err error) error {
+	return clientv2.WrapErrorCodes(err, errorCodes)
+}
+
+type GetUser_User struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetUser_User) GetID() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.ID
+}
+func (t *GetUser_User) GetName() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Name
+}
+
+type ListUsers_Users_Edges_Node struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *ListUsers_Users_Edges_Node) GetID() string {
+	if t == nil {
+		t = &ListUsers_Users_Edges_Node{}
+	}
+	return t.ID
+}
+func (t *ListUsers_Users_Edges_Node) GetName() string {
+	if t == nil {
+		t = &ListUsers_Users_Edges_Node{}
+	}
+	return t.Name
+}
+
+type ListUsers_Users_Edges struct {
+	Cursor string                     "json:\"cursor\" graphql:\"cursor\""
+	Node   ListUsers_Users_Edges_Node "json:\"node\" graphql:\"node\""
+}
+
+func (t *ListUsers_Users_Edges) GetCursor() string {
+	if t == nil {
+		t = &ListUsers_Users_Edges{}
+	}
+	return t.Cursor
+}
+func (t *ListUsers_Users_Edges) GetNode() *ListUsers_Users_Edges_Node {
+	if t == nil {
+		t = &ListUsers_Users_Edges{}
+	}
+	return &t.Node
+}
+
+type ListUsers_Users_PageInfo struct {
+	EndCursor   *string "json:\"endCursor,omitempty\" graphql:\"endCursor\""
+	HasNextPage bool    "json:\"hasNextPage\" graphql:\"hasNextPage\""
+}
+
+func (t *ListUsers_Users_PageInfo) GetEndCursor() *string {
+	if t == nil {
+		t = &ListUsers_Users_PageInfo{}
+	}
+	return t.EndCursor
+}
+func (t *ListUsers_Users_PageInfo) GetHasNextPage() bool {
+	if t == nil {
+		t = &ListUsers_Users_PageInfo{}
+	}
+	return t.HasNextPage
+}
+
+type ListUsers_Users struct {
+	Edges      []*ListUsers_Users_Edges "json:\"edges\" graphql:\"edges\""
+	PageInfo   ListUsers_Users_PageInfo "json:\"pageInfo\" graphql:\"pageInfo\""
+	TotalCount int                      "json:\"totalCount\" graphql:\"totalCount\""
+}
+
+func (t *ListUsers_Users) GetEdges() []*ListUsers_Users_Edges {
+	if t == nil {
+		t = &ListUsers_Users{}
+	}
+	return t.Edges
+}
+func (t *ListUsers_Users) GetPageInfo() *ListUsers_Users_PageInfo {
+	if t == nil {
+		t = &ListUsers_Users{}
+	}
+	return &t.PageInfo
+}
+func (t *ListUsers_Users) GetTotalCount() int {
+	if t == nil {
+		t = &ListUsers_Users{}
+	}
+	return t.TotalCount
+}
+
+type Dashboard_Users_Edges_Node struct {
+	ID string "json:\"id\" graphql:\"id\""
+}
+
+func (t *Dashboard_Users_Edges_Node) GetID() string {
+	if t == nil {
+		t = &Dashboard_Users_Edges_Node{}
+	}
+	return t.ID
+}
+
+type Dashboard_Users_Edges struct {
+	Node Dashboard_Users_Edges_Node "json:\"node\" graphql:\"node\""
+}
+
+func (t *Dashboard_Users_Edges) GetNode() *Dashboard_Users_Edges_Node {
+	if t == nil {
+		t = &Dashboard_Users_Edges{}
+	}
+	return &t.Node
+}
+
+type Dashboard_Users struct {
+	Edges []*Dashboard_Users_Edges "json:\"edges\" graphql:\"edges\""
+}
+
+func (t *Dashboard_Users) GetEdges() []*Dashboard_Users_Edges {
+	if t == nil {
+		t = &Dashboard_Users{}
+	}
+	return t.Edges
+}
+
+type Dashboard_Repos struct {
+	TotalCount *int "json:\"totalCount,omitempty\" graphql:\"totalCount\""
+}
+
+func (t *Dashboard_Repos) GetTotalCount() *int {
+	if t == nil {
+		t = &Dashboard_Repos{}
+	}
+	return t.TotalCount
+}
+
+type SearchUsers_SearchUsers_Edges_Node struct {
+	ID string "json:\"id\" graphql:\"id\""
+}
+
+func (t *SearchUsers_SearchUsers_Edges_Node) GetID() string {
+	if t == nil {
+		t = &SearchUsers_SearchUsers_Edges_Node{}
+	}
+	return t.ID
+}
+
+type SearchUsers_SearchUsers_Edges struct {
+	Node SearchUsers_SearchUsers_Edges_Node "json:\"node\" graphql:\"node\""
+}
+
+func (t *SearchUsers_SearchUsers_Edges) GetNode() *SearchUsers_SearchUsers_Edges_Node {
+	if t == nil {
+		t = &SearchUsers_SearchUsers_Edges{}
+	}
+	return &t.Node
+}
+
+type SearchUsers_SearchUsers struct {
+	Edges      []*SearchUsers_SearchUsers_Edges "json:\"edges\" graphql:\"edges\""
+	TotalCount int                              "json:\"totalCount\" graphql:\"totalCount\""
+}
+
+func (t *SearchUsers_SearchUsers) GetEdges() []*SearchUsers_SearchUsers_Edges {
+	if t == nil {
+		t = &SearchUsers_SearchUsers{}
+	}
+	return t.Edges
+}
+func (t *SearchUsers_SearchUsers) GetTotalCount() int {
+	if t == nil {
+		t = &SearchUsers_SearchUsers{}
+	}
+	return t.TotalCount
+}
+
+type GetUser struct {
+	User *GetUser_User "json:\"user,omitempty\" graphql:\"user\""
+}
+
+func (t *GetUser) GetUser() *GetUser_User {
+	if t == nil {
+		t = &GetUser{}
+	}
+	return t.User
+}
+
+type ListUsers struct {
+	Users ListUsers_Users "json:\"users\" graphql:\"users\""
+}
+
+func (t *ListUsers) GetUsers() *ListUsers_Users {
+	if t == nil {
+		t = &ListUsers{}
+	}
+	return &t.Users
+}
+
+type Dashboard struct {
+	Users Dashboard_Users  "json:\"users\" graphql:\"users\""
+	Repos *Dashboard_Repos "json:\"repos,omitempty\" graphql:\"repos\""
+}
+
+func (t *Dashboard) GetUsers() *Dashboard_Users {
+	if t == nil {
+		t = &Dashboard{}
+	}
+	return &t.Users
+}
+func (t *Dashboard) GetRepos() *Dashboard_Repos {
+	if t == nil {
+		t = &Dashboard{}
+	}
+	return t.Repos
+}
+
+type SearchUsers struct {
+	SearchUsers SearchUsers_SearchUsers "json:\"searchUsers\" graphql:\"searchUsers\""
+}
+
+func (t *SearchUsers) GetSearchUsers() *SearchUsers_SearchUsers {
+	if t == nil {
+		t = &SearchUsers{}
+	}
+	return &t.SearchUsers
+}
+
+const GetUserDocument = `query GetUser ($id: ID!) {
+	user(id: $id) {
+		id
+		name
+	}
+}
+`
+
+func (c *Client) GetUser(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	ctx, err := c.Client.BeforeOperation(ctx, "GetUser", vars)
+	if err != nil {
+		return nil, err
+	}
+
+	var res GetUser
+	err = c.Client.Post(ctx, "GetUser", GetUserDocument, &res, vars, interceptors...)
+	if err = c.Client.AfterOperation(ctx, "GetUser", vars, &res, err); err != nil {
+		err = WrapErrorCodes(err)
+
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const ListUsersDocument = `query ListUsers ($first: Int, $after: String, $role: String) {
+	users(first: $first, after: $after, role: $role) {
+		edges {
+			cursor
+			node {
+				id
+				name
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		totalCount
+	}
+}
+`
+const CountListUsersDocument = `query CountListUsers ($role: String) {
+	users(role: $role) {
+		totalCount
+	}
+}
+`
+
+func (c *Client) ListUsers(ctx context.Context, first *int, after *string, role *string, interceptors ...clientv2.RequestInterceptor) (*ListUsers, error) {
+	vars := map[string]any{
+		"first": first,
+		"after": after,
+		"role":  role,
+	}
+
+	ctx, err := c.Client.BeforeOperation(ctx, "ListUsers", vars)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ListUsers
+	err = c.Client.Post(ctx, "ListUsers", ListUsersDocument, &res, vars, interceptors...)
+	if err = c.Client.AfterOperation(ctx, "ListUsers", vars, &res, err); err != nil {
+		err = WrapErrorCodes(err)
+
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// CountListUsers returns the totalCount of users in ListUsers with a query selecting nothing else.
+func (c *Client) CountListUsers(ctx context.Context, role *string, interceptors ...clientv2.RequestInterceptor) (int, error) {
+	var zero int
+
+	vars := map[string]any{
+		"role": role,
+	}
+
+	var res struct {
+		Users struct {
+			TotalCount int `json:"totalCount"`
+		} `json:"users"`
+	}
+
+	ctx, err := c.Client.BeforeOperation(ctx, "CountListUsers", vars)
+	if err != nil {
+		return zero, err
+	}
+
+	err = c.Client.Post(ctx, "CountListUsers", CountListUsersDocument, &res, vars, interceptors...)
+	if err = c.Client.AfterOperation(ctx, "CountListUsers", vars, &res, err); err != nil {
+		err = WrapErrorCodes(err)
+
+		if c.Client.ParseDataWhenErrors {
+			return res.Users.TotalCount, err
+		}
+
+		return zero, err
+	}
+
+	return res.Users.TotalCount, nil
+}
+
+const DashboardDocument = `query Dashboard ($owner: ID!, $first: Int) {
+	users(first: $first) {
+		edges {
+			node {
+				id
+			}
+		}
+	}
+	repos: repositories(owner: $owner, first: $first) {
+		totalCount
+	}
+}
+`
+const CountDashboardUsersDocument = `query CountDashboardUsers {
+	users {
+		totalCount
+	}
+}
+`
+const CountDashboardReposDocument = `query CountDashboardRepos ($owner: ID!) {
+	repos: repositories(owner: $owner) {
+		totalCount
+	}
+}
+`
+
+func (c *Client) Dashboard(ctx context.Context, owner string, first *int, interceptors ...clientv2.RequestInterceptor) (*Dashboard, error) {
+	vars := map[string]any{
+		"owner": owner,
+		"first": first,
+	}
+
+	ctx, err := c.Client.BeforeOperation(ctx, "Dashboard", vars)
+	if err != nil {
+		return nil, err
+	}
+
+	var res Dashboard
+	err = c.Client.Post(ctx, "Dashboard", DashboardDocument, &res, vars, interceptors...)
+	if err = c.Client.AfterOperation(ctx, "Dashboard", vars, &res, err); err != nil {
+		err = WrapErrorCodes(err)
+
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// CountDashboardUsers returns the totalCount of users in Dashboard with a query selecting nothing else.
+func (c *Client) CountDashboardUsers(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (int, error) {
+	var zero int
+
+	vars := map[string]any{}
+
+	var res struct {
+		Users struct {
+			TotalCount int `json:"totalCount"`
+		} `json:"users"`
+	}
+
+	ctx, err := c.Client.BeforeOperation(ctx, "CountDashboardUsers", vars)
+	if err != nil {
+		return zero, err
+	}
+
+	err = c.Client.Post(ctx, "CountDashboardUsers", CountDashboardUsersDocument, &res, vars, interceptors...)
+	if err = c.Client.AfterOperation(ctx, "CountDashboardUsers", vars, &res, err); err != nil {
+		err = WrapErrorCodes(err)
+
+		if c.Client.ParseDataWhenErrors {
+			return res.Users.TotalCount, err
+		}
+
+		return zero, err
+	}
+
+	return res.Users.TotalCount, nil
+}
+
+// CountDashboardRepos returns the totalCount of repos in Dashboard with a query selecting nothing else.
+func (c *Client) CountDashboardRepos(ctx context.Context, owner string, interceptors ...clientv2.RequestInterceptor) (*int, error) {
+	var zero *int
+
+	vars := map[string]any{
+		"owner": owner,
+	}
+
+	var res struct {
+		Repos struct {
+			TotalCount *int `json:"totalCount"`
+		} `json:"repos"`
+	}
+
+	ctx, err := c.Client.BeforeOperation(ctx, "CountDashboardRepos", vars)
+	if err != nil {
+		return zero, err
+	}
+
+	err = c.Client.Post(ctx, "CountDashboardRepos", CountDashboardReposDocument, &res, vars, interceptors...)
+	if err = c.Client.AfterOperation(ctx, "CountDashboardRepos", vars, &res, err); err != nil {
+		err = WrapErrorCodes(err)
+
+		if c.Client.ParseDataWhenErrors {
+			return res.Repos.TotalCount, err
+		}
+
+		return zero, err
+	}
+
+	return res.Repos.TotalCount, nil
+}
+
+const SearchUsersDocument = `query SearchUsers ($filter: UserSearch!) {
+	searchUsers(filter: $filter) {
+		edges {
+			node {
+				id
+			}
+		}
+		totalCount
+	}
+}
+`
+const CountSearchUsersDocument = `query CountSearchUsers ($filter: UserSearch!) {
+	searchUsers(filter: $filter) {
+		totalCount
+	}
+}
+`
+
+func (c *Client) SearchUsers(ctx context.Context, filter UserSearch, interceptors ...clientv2.RequestInterceptor) (*SearchUsers, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("filter: %w", err)
+	}
+
+	vars := map[string]any{
+		"filter": filter,
+	}
+
+	ctx, err := c.Client.BeforeOperation(ctx, "SearchUsers", vars)
+	if err != nil {
+		return nil, err
+	}
+
+	var res SearchUsers
+	err = c.Client.Post(ctx, "SearchUsers", SearchUsersDocument, &res, vars, interceptors...)
+	if err = c.Client.AfterOperation(ctx, "SearchUsers", vars, &res, err); err != nil {
+		err = WrapErrorCodes(err)
+
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// CountSearchUsers returns the totalCount of searchUsers in SearchUsers with a query selecting nothing else.
+func (c *Client) CountSearchUsers(ctx context.Context, filter UserSearch, interceptors ...clientv2.RequestInterceptor) (int, error) {
+	var zero int
+
+	if err := filter.Validate(); err != nil {
+		return zero, fmt.Errorf("filter: %w", err)
+	}
+
+	vars := map[string]any{
+		"filter": filter,
+	}
+
+	var res struct {
+		SearchUsers struct {
+			TotalCount int `json:"totalCount"`
+		} `json:"searchUsers"`
+	}
+
+	ctx, err := c.Client.BeforeOperation(ctx, "CountSearchUsers", vars)
+	if err != nil {
+		return zero, err
+	}
+
+	err = c.Client.Post(ctx, "CountSearchUsers", CountSearchUsersDocument, &res, vars, interceptors...)
+	if err = c.Client.AfterOperation(ctx, "CountSearchUsers", vars, &res, err); err != nil {
+		err = WrapErrorCodes(err)
+
+		if c.Client.ParseDataWhenErrors {
+			return res.SearchUsers.TotalCount, err
+		}
+
+		return zero, err
+	}
+
+	return res.SearchUsers.TotalCount, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUserDocument:     "GetUser",
+	ListUsersDocument:   "ListUsers",
+	DashboardDocument:   "Dashboard",
+	SearchUsersDocument: "SearchUsers",
+}
@@ -0,0 +1,25 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"errors"
+	"unicode/utf8"
+)
+
+// Validate reports the values of UserSearch violating the constraints declared on UserSearch in the schema.
+func (i *UserSearch) Validate() error {
+	if i == nil {
+		return nil
+	}
+
+	var errs []error
+	if utf8.RuneCountInString(string(i.Term)) < 1 {
+		errs = append(errs, errors.New("term: length must be at least 1"))
+	}
+	if utf8.RuneCountInString(string(i.Term)) > 64 {
+		errs = append(errs, errors.New("term: length must be at most 64"))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,157 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+// NewDefaultClient returns a client of the endpoint, sending the headers, with the timeout and retries set in
+// generate.defaultClient, and options and interceptors in addition.
+func NewDefaultClient(options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	baseURL := "https://api.example.com/graphql"
+	if endpoint := os.Getenv("EXAMPLE_GRAPHQL_URL"); endpoint != "" {
+		baseURL = endpoint
+	}
+
+	defaults := []clientv2.RequestInterceptor{
+		clientv2.NewEnvHeaderInterceptor(map[string]string{
+			"Authorization": "EXAMPLE_TOKEN",
+			"X-Tenant":      "EXAMPLE_TENANT",
+		}),
+		clientv2.NewRetryInterceptor(clientv2.RetryPolicy{
+			MaxAttempts: 4,
+			BaseDelay:   clientv2.DefaultRetryPolicy.BaseDelay,
+			MaxDelay:    clientv2.DefaultRetryPolicy.MaxDelay,
+		}),
+	}
+
+	return NewClient(&http.Client{Timeout: 30 * time.Second}, baseURL, options, append(defaults, interceptors...)...)
+}
+
+type GetUser_User struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetUser_User) GetID() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.ID
+}
+func (t *GetUser_User) GetName() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Name
+}
+
+type UpdateUser_UpdateUser struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *UpdateUser_UpdateUser) GetID() string {
+	if t == nil {
+		t = &UpdateUser_UpdateUser{}
+	}
+	return t.ID
+}
+func (t *UpdateUser_UpdateUser) GetName() string {
+	if t == nil {
+		t = &UpdateUser_UpdateUser{}
+	}
+	return t.Name
+}
+
+type GetUser struct {
+	User *GetUser_User "json:\"user,omitempty\" graphql:\"user\""
+}
+
+func (t *GetUser) GetUser() *GetUser_User {
+	if t == nil {
+		t = &GetUser{}
+	}
+	return t.User
+}
+
+type UpdateUser struct {
+	UpdateUser UpdateUser_UpdateUser "json:\"updateUser\" graphql:\"updateUser\""
+}
+
+func (t *UpdateUser) GetUpdateUser() *UpdateUser_UpdateUser {
+	if t == nil {
+		t = &UpdateUser{}
+	}
+	return &t.UpdateUser
+}
+
+const GetUserDocument = `query GetUser ($id: ID!) {
+	user(id: $id) {
+		id
+		name
+	}
+}
+`
+
+func (c *Client) GetUser(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetUser
+	if err := c.Client.Post(ctx, "GetUser", GetUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const UpdateUserDocument = `mutation UpdateUser ($id: ID!, $name: String!) {
+	updateUser(id: $id, name: $name) {
+		id
+		name
+	}
+}
+`
+
+func (c *Client) UpdateUser(ctx context.Context, id string, name string, interceptors ...clientv2.RequestInterceptor) (*UpdateUser, error) {
+	vars := map[string]any{
+		"id":   id,
+		"name": name,
+	}
+
+	var res UpdateUser
+	if err := c.Client.Post(ctx, "UpdateUser", UpdateUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUserDocument:    "GetUser",
+	UpdateUserDocument: "UpdateUser",
+}
@@ -0,0 +1,47 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+const GetUserDocument = `query GetUser ($id: ID!) {
+	user(id: $id) {
+		id
+		name
+	}
+}
+`
+
+// GetUserOperationName is the operation name of GetUserDocument.
+const GetUserOperationName = "GetUser"
+
+// GetUserDocumentHash is the hex encoded SHA-256 of GetUserDocument.
+const GetUserDocumentHash = "6e212daa32e294110d29a6ba504a3229028cc102b51bbd604c29dc1763f9f9c3"
+
+// GetUserVariables are the variables of GetUserDocument.
+type GetUserVariables struct {
+	ID string `json:"id"`
+}
+
+const UpdateUserDocument = `mutation UpdateUser ($id: ID!, $name: String!) {
+	updateUser(id: $id, name: $name) {
+		id
+		name
+	}
+}
+`
+
+// UpdateUserOperationName is the operation name of UpdateUserDocument.
+const UpdateUserOperationName = "UpdateUser"
+
+// UpdateUserDocumentHash is the hex encoded SHA-256 of UpdateUserDocument.
+const UpdateUserDocumentHash = "33a34dab36d8c05294d537974b1676dee0c1544d1a1e5334cf4485dce530e81d"
+
+// UpdateUserVariables are the variables of UpdateUserDocument.
+type UpdateUserVariables struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUserDocument:    "GetUser",
+	UpdateUserDocument: "UpdateUser",
+}
@@ -0,0 +1,20 @@
+package domain
+
+type User struct {
+	ID    string
+	Name  string
+	Age   int
+	Tags  []string
+	Posts []*Post
+	// Admin is not selected, so it is left zero.
+	Admin bool
+}
+
+type Post struct {
+	ID    string
+	Title *string
+}
+
+type UserList struct {
+	Users []User
+}
@@ -0,0 +1,218 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+	"github.com/gqlgo/gqlgenc/generator/testdata/domain_types/domain"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type PostParts struct {
+	ID    string  "json:\"id\" graphql:\"id\""
+	Title *string "json:\"title,omitempty\" graphql:\"title\""
+}
+
+func (t *PostParts) GetID() string {
+	if t == nil {
+		t = &PostParts{}
+	}
+	return t.ID
+}
+func (t *PostParts) GetTitle() *string {
+	if t == nil {
+		t = &PostParts{}
+	}
+	return t.Title
+}
+
+// ToDomain converts t to domain.Post.
+func (t *PostParts) ToDomain() domain.Post {
+	var d domain.Post
+	if t == nil {
+		return d
+	}
+	d.ID = t.ID
+	d.Title = t.Title
+	return d
+}
+
+// PostPartsFromDomain converts d to PostParts.
+func PostPartsFromDomain(d domain.Post) PostParts {
+	var t PostParts
+	t.ID = d.ID
+	t.Title = d.Title
+	return t
+}
+
+type UserParts struct {
+	ID    string       "json:\"id\" graphql:\"id\""
+	Name  string       "json:\"name\" graphql:\"name\""
+	Age   *int         "json:\"age,omitempty\" graphql:\"age\""
+	Tags  []string     "json:\"tags\" graphql:\"tags\""
+	Posts []*PostParts "json:\"posts\" graphql:\"posts\""
+}
+
+func (t *UserParts) GetID() string {
+	if t == nil {
+		t = &UserParts{}
+	}
+	return t.ID
+}
+func (t *UserParts) GetName() string {
+	if t == nil {
+		t = &UserParts{}
+	}
+	return t.Name
+}
+func (t *UserParts) GetAge() *int {
+	if t == nil {
+		t = &UserParts{}
+	}
+	return t.Age
+}
+func (t *UserParts) GetTags() []string {
+	if t == nil {
+		t = &UserParts{}
+	}
+	return t.Tags
+}
+func (t *UserParts) GetPosts() []*PostParts {
+	if t == nil {
+		t = &UserParts{}
+	}
+	return t.Posts
+}
+
+// ToDomain converts t to domain.User.
+func (t *UserParts) ToDomain() domain.User {
+	var d domain.User
+	if t == nil {
+		return d
+	}
+	d.ID = t.ID
+	d.Name = t.Name
+	if t.Age != nil {
+		d.Age = *t.Age
+	}
+	d.Tags = t.Tags
+	if t.Posts != nil {
+		d.Posts = make([]*domain.Post, len(t.Posts))
+		for i1, v1 := range t.Posts {
+			if v1 != nil {
+				v2 := v1.ToDomain()
+				d.Posts[i1] = &v2
+			}
+		}
+	}
+	return d
+}
+
+// UserPartsFromDomain converts d to UserParts.
+func UserPartsFromDomain(d domain.User) UserParts {
+	var t UserParts
+	t.ID = d.ID
+	t.Name = d.Name
+	v1 := d.Age
+	t.Age = &v1
+	t.Tags = d.Tags
+	if d.Posts != nil {
+		t.Posts = make([]*PostParts, len(d.Posts))
+		for i2, v2 := range d.Posts {
+			if v2 != nil {
+				v3 := PostPartsFromDomain(*v2)
+				t.Posts[i2] = &v3
+			}
+		}
+	}
+	return t
+}
+
+type GetUsers struct {
+	Users []*UserParts "json:\"users\" graphql:\"users\""
+}
+
+func (t *GetUsers) GetUsers() []*UserParts {
+	if t == nil {
+		t = &GetUsers{}
+	}
+	return t.Users
+}
+
+// ToDomain converts t to domain.UserList.
+func (t *GetUsers) ToDomain() domain.UserList {
+	var d domain.UserList
+	if t == nil {
+		return d
+	}
+	if t.Users != nil {
+		d.Users = make([]domain.User, len(t.Users))
+		for i1, v1 := range t.Users {
+			if v1 != nil {
+				d.Users[i1] = v1.ToDomain()
+			}
+		}
+	}
+	return d
+}
+
+// GetUsersFromDomain converts d to GetUsers.
+func GetUsersFromDomain(d domain.UserList) GetUsers {
+	var t GetUsers
+	if d.Users != nil {
+		t.Users = make([]*UserParts, len(d.Users))
+		for i1, v1 := range d.Users {
+			v2 := UserPartsFromDomain(v1)
+			t.Users[i1] = &v2
+		}
+	}
+	return t
+}
+
+const GetUsersDocument = `query GetUsers {
+	users {
+		... UserParts
+	}
+}
+fragment UserParts on User {
+	id
+	name
+	age
+	tags
+	posts {
+		... PostParts
+	}
+}
+fragment PostParts on Post {
+	id
+	title
+}
+`
+
+func (c *Client) GetUsers(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetUsers, error) {
+	vars := map[string]any{}
+
+	var res GetUsers
+	if err := c.Client.Post(ctx, "GetUsers", GetUsersDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUsersDocument: "GetUsers",
+}
@@ -0,0 +1,19 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package generated
+
+type Post struct {
+	ID    string  `json:"id"`
+	Title *string `json:"title,omitempty"`
+}
+
+type Query struct {
+}
+
+type User struct {
+	ID    string   `json:"id"`
+	Name  string   `json:"name"`
+	Age   *int     `json:"age,omitempty"`
+	Tags  []string `json:"tags"`
+	Posts []*Post  `json:"posts"`
+}
@@ -0,0 +1,142 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type GetUsers_User_Friends struct {
+	ID string "json:\"id\" graphql:\"id\""
+}
+
+func (t *GetUsers_User_Friends) GetID() string {
+	if t == nil {
+		t = &GetUsers_User_Friends{}
+	}
+	return t.ID
+}
+
+type GetUsers_User struct {
+	Friends []*GetUsers_User_Friends "json:\"friends\" graphql:\"friends\""
+	ID      string                   "json:\"id\" graphql:\"id\""
+	Name    string                   "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetUsers_User) GetFriends() []*GetUsers_User_Friends {
+	if t == nil {
+		t = &GetUsers_User{}
+	}
+	return t.Friends
+}
+func (t *GetUsers_User) GetID() string {
+	if t == nil {
+		t = &GetUsers_User{}
+	}
+	return t.ID
+}
+func (t *GetUsers_User) GetName() string {
+	if t == nil {
+		t = &GetUsers_User{}
+	}
+	return t.Name
+}
+
+type GetUsers_Me_Friends struct {
+	Email string "json:\"email\" graphql:\"email\""
+	ID    string "json:\"id\" graphql:\"id\""
+}
+
+func (t *GetUsers_Me_Friends) GetEmail() string {
+	if t == nil {
+		t = &GetUsers_Me_Friends{}
+	}
+	return t.Email
+}
+func (t *GetUsers_Me_Friends) GetID() string {
+	if t == nil {
+		t = &GetUsers_Me_Friends{}
+	}
+	return t.ID
+}
+
+type GetUsers_Me struct {
+	Friends []*GetUsers_Me_Friends "json:\"friends\" graphql:\"friends\""
+}
+
+func (t *GetUsers_Me) GetFriends() []*GetUsers_Me_Friends {
+	if t == nil {
+		t = &GetUsers_Me{}
+	}
+	return t.Friends
+}
+
+type GetUsers struct {
+	User *GetUsers_User "json:\"user,omitempty\" graphql:\"user\""
+	Me   *GetUsers_Me   "json:\"me,omitempty\" graphql:\"me\""
+}
+
+func (t *GetUsers) GetUser() *GetUsers_User {
+	if t == nil {
+		t = &GetUsers{}
+	}
+	return t.User
+}
+func (t *GetUsers) GetMe() *GetUsers_Me {
+	if t == nil {
+		t = &GetUsers{}
+	}
+	return t.Me
+}
+
+const GetUsersDocument = `query GetUsers {
+	user(id: "1") {
+		id
+	}
+	user(id: "1") {
+		name
+		friends {
+			id
+		}
+	}
+	me: user(id: "2") {
+		friends {
+			id
+		}
+	}
+	me: user(id: "2") {
+		friends {
+			email
+		}
+	}
+}
+`
+
+func (c *Client) GetUsers(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetUsers, error) {
+	vars := map[string]any{}
+
+	var res GetUsers
+	if err := c.Client.Post(ctx, "GetUsers", GetUsersDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUsersDocument: "GetUsers",
+}
@@ -0,0 +1,97 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+// Sentinel errors for the values of the ErrorCode enum. Errors returned by Client methods match them
+// with errors.Is when a GraphQL error has the value as its extensions.code.
+var (
+	ErrNotFound            = errors.New("NOT_FOUND")
+	ErrUnauthenticated     = errors.New("UNAUTHENTICATED")
+	ErrInternalServerError = errors.New("INTERNAL_SERVER_ERROR")
+)
+
+var errorCodes = map[string]error{
+	"NOT_FOUND":             ErrNotFound,
+	"UNAUTHENTICATED":       ErrUnauthenticated,
+	"INTERNAL_SERVER_ERROR": ErrInternalServerError,
+}
+
+// WrapErrorCodes wraps err so errors.Is reports the sentinel error of the extensions.code of each of its GraphQL errors.
+func WrapErrorCodes(err error) error {
+	return clientv2.WrapErrorCodes(err, errorCodes)
+}
+
+type GetUser_User struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetUser_User) GetID() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.ID
+}
+func (t *GetUser_User) GetName() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Name
+}
+
+type GetUser struct {
+	User *GetUser_User "json:\"user,omitempty\" graphql:\"user\""
+}
+
+func (t *GetUser) GetUser() *GetUser_User {
+	if t == nil {
+		t = &GetUser{}
+	}
+	return t.User
+}
+
+const GetUserDocument = `query GetUser ($id: ID!) {
+	user(id: $id) {
+		id
+		name
+	}
+}
+`
+
+func (c *Client) GetUser(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetUser
+	if err := c.Client.Post(ctx, "GetUser", GetUserDocument, &res, vars, interceptors...); err != nil {
+		err = WrapErrorCodes(err)
+
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUserDocument: "GetUser",
+}
@@ -0,0 +1,259 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type UserErrorFields struct {
+	Field   []string "json:\"field,omitempty\" graphql:\"field\""
+	Message string   "json:\"message\" graphql:\"message\""
+}
+
+func (t *UserErrorFields) GetField() []string {
+	if t == nil {
+		t = &UserErrorFields{}
+	}
+	return t.Field
+}
+func (t *UserErrorFields) GetMessage() string {
+	if t == nil {
+		t = &UserErrorFields{}
+	}
+	return t.Message
+}
+
+func (t *UserErrorFields) Error() string {
+	if t == nil {
+		return "UserError"
+	}
+	if t.Message != "" {
+		return t.Message
+	}
+	return "UserError"
+}
+
+type GetUser_User_User struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetUser_User_User) GetID() string {
+	if t == nil {
+		t = &GetUser_User_User{}
+	}
+	return t.ID
+}
+func (t *GetUser_User_User) GetName() string {
+	if t == nil {
+		t = &GetUser_User_User{}
+	}
+	return t.Name
+}
+
+type GetUser_User_NotFoundError struct {
+	ID string "json:\"id\" graphql:\"id\""
+}
+
+func (t *GetUser_User_NotFoundError) GetID() string {
+	if t == nil {
+		t = &GetUser_User_NotFoundError{}
+	}
+	return t.ID
+}
+
+func (t *GetUser_User_NotFoundError) Error() string {
+	if t == nil {
+		return "NotFoundError"
+	}
+	return "NotFoundError"
+}
+
+type GetUser_User struct {
+	NotFoundError GetUser_User_NotFoundError "graphql:\"... on NotFoundError\""
+	User          GetUser_User_User          "graphql:\"... on User\""
+	Typename      *string                    "json:\"__typename,omitempty\" graphql:\"__typename\""
+}
+
+func (t *GetUser_User) GetNotFoundError() *GetUser_User_NotFoundError {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return &t.NotFoundError
+}
+func (t *GetUser_User) GetUser() *GetUser_User_User {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return &t.User
+}
+func (t *GetUser_User) GetTypename() *string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Typename
+}
+
+type CreateUser_CreateUser_User struct {
+	ID string "json:\"id\" graphql:\"id\""
+}
+
+func (t *CreateUser_CreateUser_User) GetID() string {
+	if t == nil {
+		t = &CreateUser_CreateUser_User{}
+	}
+	return t.ID
+}
+
+type CreateUser_CreateUser struct {
+	User       *CreateUser_CreateUser_User "json:\"user,omitempty\" graphql:\"user\""
+	UserErrors []*UserErrorFields          "json:\"userErrors\" graphql:\"userErrors\""
+}
+
+func (t *CreateUser_CreateUser) GetUser() *CreateUser_CreateUser_User {
+	if t == nil {
+		t = &CreateUser_CreateUser{}
+	}
+	return t.User
+}
+func (t *CreateUser_CreateUser) GetUserErrors() []*UserErrorFields {
+	if t == nil {
+		t = &CreateUser_CreateUser{}
+	}
+	return t.UserErrors
+}
+
+type GetUser struct {
+	User GetUser_User "json:\"user\" graphql:\"user\""
+}
+
+func (t *GetUser) GetUser() *GetUser_User {
+	if t == nil {
+		t = &GetUser{}
+	}
+	return &t.User
+}
+
+func (t *GetUser) Errors() []error {
+	if t == nil {
+		return nil
+	}
+	var errs []error
+	if t.User.Typename != nil && *t.User.Typename == "NotFoundError" {
+		errs = append(errs, &t.User.NotFoundError)
+	}
+	return errs
+}
+
+type CreateUser struct {
+	CreateUser CreateUser_CreateUser "json:\"createUser\" graphql:\"createUser\""
+}
+
+func (t *CreateUser) GetCreateUser() *CreateUser_CreateUser {
+	if t == nil {
+		t = &CreateUser{}
+	}
+	return &t.CreateUser
+}
+
+func (t *CreateUser) Errors() []error {
+	if t == nil {
+		return nil
+	}
+	var errs []error
+	for i0 := range t.CreateUser.UserErrors {
+		if t.CreateUser.UserErrors[i0] != nil {
+			errs = append(errs, t.CreateUser.UserErrors[i0])
+		}
+	}
+	return errs
+}
+
+const GetUserDocument = `query GetUser ($id: ID!) {
+	user(id: $id) {
+		__typename
+		... on User {
+			id
+			name
+		}
+		... on NotFoundError {
+			id
+		}
+	}
+}
+`
+
+func (c *Client) GetUser(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetUser
+	if err := c.Client.Post(ctx, "GetUser", GetUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	if err := errors.Join(res.Errors()...); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+const CreateUserDocument = `mutation CreateUser ($input: CreateUserInput!) {
+	createUser(input: $input) {
+		user {
+			id
+		}
+		userErrors {
+			... UserErrorFields
+		}
+	}
+}
+fragment UserErrorFields on UserError {
+	field
+	message
+}
+`
+
+func (c *Client) CreateUser(ctx context.Context, input CreateUserInput, interceptors ...clientv2.RequestInterceptor) (*CreateUser, error) {
+	vars := map[string]any{
+		"input": input,
+	}
+
+	var res CreateUser
+	if err := c.Client.Post(ctx, "CreateUser", CreateUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	if err := errors.Join(res.Errors()...); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUserDocument:    "GetUser",
+	CreateUserDocument: "CreateUser",
+}
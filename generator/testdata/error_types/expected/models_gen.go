@@ -0,0 +1,40 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package generated
+
+type UserResult interface {
+	IsUserResult()
+}
+
+type CreateUserInput struct {
+	Name string `json:"name"`
+}
+
+type CreateUserPayload struct {
+	User       *User        `json:"user,omitempty"`
+	UserErrors []*UserError `json:"userErrors"`
+}
+
+type Mutation struct {
+}
+
+type NotFoundError struct {
+	ID string `json:"id"`
+}
+
+func (NotFoundError) IsUserResult() {}
+
+type Query struct {
+}
+
+type User struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (User) IsUserResult() {}
+
+type UserError struct {
+	Field   []string `json:"field,omitempty"`
+	Message string   `json:"message"`
+}
@@ -0,0 +1,8 @@
+package errs
+
+import "fmt"
+
+// Wrap wraps err with the name of the operation that failed.
+func Wrap(operation string, err error) error {
+	return fmt.Errorf("graphql %s: %w", operation, err)
+}
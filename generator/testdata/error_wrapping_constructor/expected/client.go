@@ -0,0 +1,137 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+	"github.com/gqlgo/gqlgenc/generator/testdata/error_wrapping_constructor/errs"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type GetUser_User struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetUser_User) GetID() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.ID
+}
+func (t *GetUser_User) GetName() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Name
+}
+
+type ListUsers_Users struct {
+	TotalCount int "json:\"totalCount\" graphql:\"totalCount\""
+}
+
+func (t *ListUsers_Users) GetTotalCount() int {
+	if t == nil {
+		t = &ListUsers_Users{}
+	}
+	return t.TotalCount
+}
+
+type GetUser struct {
+	User *GetUser_User "json:\"user,omitempty\" graphql:\"user\""
+}
+
+func (t *GetUser) GetUser() *GetUser_User {
+	if t == nil {
+		t = &GetUser{}
+	}
+	return t.User
+}
+
+type ListUsers struct {
+	Users ListUsers_Users "json:\"users\" graphql:\"users\""
+}
+
+func (t *ListUsers) GetUsers() *ListUsers_Users {
+	if t == nil {
+		t = &ListUsers{}
+	}
+	return &t.Users
+}
+
+const GetUserDocument = `query GetUser ($id: ID!) {
+	user(id: $id) {
+		id
+		name
+	}
+}
+`
+
+func (c *Client) GetUser(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	ctx, err := c.Client.BeforeOperation(ctx, "GetUser", vars)
+	if err != nil {
+		return nil, errs.Wrap("GetUser", err)
+	}
+
+	var res GetUser
+	err = c.Client.Post(ctx, "GetUser", GetUserDocument, &res, vars, interceptors...)
+	if err = c.Client.AfterOperation(ctx, "GetUser", vars, &res, err); err != nil {
+		err = errs.Wrap("GetUser", err)
+
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const ListUsersDocument = `query ListUsers {
+	users {
+		totalCount
+	}
+}
+`
+
+func (c *Client) ListUsers(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*ListUsers, error) {
+	vars := map[string]any{}
+
+	ctx, err := c.Client.BeforeOperation(ctx, "ListUsers", vars)
+	if err != nil {
+		return nil, errs.Wrap("ListUsers", err)
+	}
+
+	var res ListUsers
+	err = c.Client.Post(ctx, "ListUsers", ListUsersDocument, &res, vars, interceptors...)
+	if err = c.Client.AfterOperation(ctx, "ListUsers", vars, &res, err); err != nil {
+		err = errs.Wrap("ListUsers", err)
+
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUserDocument:   "GetUser",
+	ListUsersDocument: "ListUsers",
+}
@@ -0,0 +1,163 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+// Sentinel errors of the operations, which the errors returned by their Client methods wrap.
+var (
+	ErrGetUser        = errors.New("GetUser")
+	ErrListUsers      = errors.New("ListUsers")
+	ErrCountListUsers = errors.New("CountListUsers")
+)
+
+type GetUser_User struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetUser_User) GetID() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.ID
+}
+func (t *GetUser_User) GetName() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Name
+}
+
+type ListUsers_Users struct {
+	TotalCount int "json:\"totalCount\" graphql:\"totalCount\""
+}
+
+func (t *ListUsers_Users) GetTotalCount() int {
+	if t == nil {
+		t = &ListUsers_Users{}
+	}
+	return t.TotalCount
+}
+
+type GetUser struct {
+	User *GetUser_User "json:\"user,omitempty\" graphql:\"user\""
+}
+
+func (t *GetUser) GetUser() *GetUser_User {
+	if t == nil {
+		t = &GetUser{}
+	}
+	return t.User
+}
+
+type ListUsers struct {
+	Users ListUsers_Users "json:\"users\" graphql:\"users\""
+}
+
+func (t *ListUsers) GetUsers() *ListUsers_Users {
+	if t == nil {
+		t = &ListUsers{}
+	}
+	return &t.Users
+}
+
+const GetUserDocument = `query GetUser ($id: ID!) {
+	user(id: $id) {
+		id
+		name
+	}
+}
+`
+
+func (c *Client) GetUser(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetUser
+	if err := c.Client.Post(ctx, "GetUser", GetUserDocument, &res, vars, interceptors...); err != nil {
+		err = fmt.Errorf("%w: %w", ErrGetUser, err)
+
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const ListUsersDocument = `query ListUsers {
+	users {
+		totalCount
+	}
+}
+`
+const CountListUsersDocument = `query CountListUsers {
+	users {
+		totalCount
+	}
+}
+`
+
+func (c *Client) ListUsers(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*ListUsers, error) {
+	vars := map[string]any{}
+
+	var res ListUsers
+	if err := c.Client.Post(ctx, "ListUsers", ListUsersDocument, &res, vars, interceptors...); err != nil {
+		err = fmt.Errorf("%w: %w", ErrListUsers, err)
+
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// CountListUsers returns the totalCount of users in ListUsers with a query selecting nothing else.
+func (c *Client) CountListUsers(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (int, error) {
+	var zero int
+
+	vars := map[string]any{}
+
+	var res struct {
+		Users struct {
+			TotalCount int `json:"totalCount"`
+		} `json:"users"`
+	}
+	if err := c.Client.Post(ctx, "CountListUsers", CountListUsersDocument, &res, vars, interceptors...); err != nil {
+		err = fmt.Errorf("%w: %w", ErrCountListUsers, err)
+
+		if c.Client.ParseDataWhenErrors {
+			return res.Users.TotalCount, err
+		}
+
+		return zero, err
+	}
+
+	return res.Users.TotalCount, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUserDocument:   "GetUser",
+	ListUsersDocument: "ListUsers",
+}
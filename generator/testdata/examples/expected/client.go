@@ -0,0 +1,369 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type GetUser_User struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetUser_User) GetID() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.ID
+}
+func (t *GetUser_User) GetName() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Name
+}
+
+type ListUsers_Users struct {
+	ID string "json:\"id\" graphql:\"id\""
+}
+
+func (t *ListUsers_Users) GetID() string {
+	if t == nil {
+		t = &ListUsers_Users{}
+	}
+	return t.ID
+}
+
+type ListUserConnection_UserConnection_Nodes struct {
+	ID string "json:\"id\" graphql:\"id\""
+}
+
+func (t *ListUserConnection_UserConnection_Nodes) GetID() string {
+	if t == nil {
+		t = &ListUserConnection_UserConnection_Nodes{}
+	}
+	return t.ID
+}
+
+type ListUserConnection_UserConnection struct {
+	Nodes      []*ListUserConnection_UserConnection_Nodes "json:\"nodes\" graphql:\"nodes\""
+	TotalCount int                                        "json:\"totalCount\" graphql:\"totalCount\""
+}
+
+func (t *ListUserConnection_UserConnection) GetNodes() []*ListUserConnection_UserConnection_Nodes {
+	if t == nil {
+		t = &ListUserConnection_UserConnection{}
+	}
+	return t.Nodes
+}
+func (t *ListUserConnection_UserConnection) GetTotalCount() int {
+	if t == nil {
+		t = &ListUserConnection_UserConnection{}
+	}
+	return t.TotalCount
+}
+
+type CreateUser_CreateUser struct {
+	ID string "json:\"id\" graphql:\"id\""
+}
+
+func (t *CreateUser_CreateUser) GetID() string {
+	if t == nil {
+		t = &CreateUser_CreateUser{}
+	}
+	return t.ID
+}
+
+type GetUser struct {
+	User *GetUser_User "json:\"user,omitempty\" graphql:\"user\""
+}
+
+func (t *GetUser) GetUser() *GetUser_User {
+	if t == nil {
+		t = &GetUser{}
+	}
+	return t.User
+}
+
+type ListUsers struct {
+	Users []*ListUsers_Users "json:\"users\" graphql:\"users\""
+}
+
+func (t *ListUsers) GetUsers() []*ListUsers_Users {
+	if t == nil {
+		t = &ListUsers{}
+	}
+	return t.Users
+}
+
+type ListUserConnection struct {
+	UserConnection ListUserConnection_UserConnection "json:\"userConnection\" graphql:\"userConnection\""
+}
+
+func (t *ListUserConnection) GetUserConnection() *ListUserConnection_UserConnection {
+	if t == nil {
+		t = &ListUserConnection{}
+	}
+	return &t.UserConnection
+}
+
+type CreateUser struct {
+	CreateUser CreateUser_CreateUser "json:\"createUser\" graphql:\"createUser\""
+}
+
+func (t *CreateUser) GetCreateUser() *CreateUser_CreateUser {
+	if t == nil {
+		t = &CreateUser{}
+	}
+	return &t.CreateUser
+}
+
+const GetUserDocument = `query GetUser ($id: ID!) {
+	user(id: $id) {
+		id
+		name
+	}
+}
+`
+
+func (c *Client) GetUser(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetUser
+	if err := c.Client.Post(ctx, "GetUser", GetUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetUserFlat calls GetUser and returns its user field.
+func (c *Client) GetUserFlat(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser_User, error) {
+	res, err := c.GetUser(ctx, id, interceptors...)
+	if res == nil {
+		var zero *GetUser_User
+
+		return zero, err
+	}
+
+	return res.User, err
+}
+
+const ListUsersDocument = `query ListUsers ($limit: Int!, $offset: Int!) {
+	users(limit: $limit, offset: $offset) {
+		id
+	}
+}
+`
+
+func (c *Client) ListUsers(ctx context.Context, limit int, offset int, interceptors ...clientv2.RequestInterceptor) (*ListUsers, error) {
+	vars := map[string]any{
+		"limit":  limit,
+		"offset": offset,
+	}
+
+	var res ListUsers
+	if err := c.Client.Post(ctx, "ListUsers", ListUsersDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// ListUsersIterator ranges over the items of ListUsers, fetching pages of pageSize items until a page is not full.
+func (c *Client) ListUsersIterator(ctx context.Context, pageSize int, interceptors ...clientv2.RequestInterceptor) iter.Seq2[*ListUsers_Users, error] {
+	return func(yield func(*ListUsers_Users, error) bool) {
+		var zero *ListUsers_Users
+
+		if pageSize <= 0 {
+			yield(zero, fmt.Errorf("pageSize must be positive, got %d", pageSize))
+
+			return
+		}
+
+		var offset int
+
+		for {
+			limit := pageSize
+
+			res, err := c.ListUsers(ctx, limit, offset, interceptors...)
+			if err != nil {
+				yield(zero, err)
+
+				return
+			}
+
+			var count int
+
+			for _, item := range res.Users {
+				if !yield(item, nil) {
+					return
+				}
+
+				count++
+			}
+
+			if count < pageSize {
+				return
+			}
+
+			offset += pageSize
+		}
+	}
+}
+
+// ListUsersStream ranges over the items of ListUsers, decoding them one at a time from the response
+// instead of as a whole.
+func (c *Client) ListUsersStream(ctx context.Context, limit int, offset int, interceptors ...clientv2.RequestInterceptor) iter.Seq2[*ListUsers_Users, error] {
+	vars := map[string]any{
+		"limit":  limit,
+		"offset": offset,
+	}
+
+	return clientv2.PostStream[*ListUsers_Users](ctx, c.Client, "ListUsers", ListUsersDocument, vars, []string{"users"}, interceptors...)
+}
+
+// ListUsersFlat calls ListUsers and returns its users field.
+func (c *Client) ListUsersFlat(ctx context.Context, limit int, offset int, interceptors ...clientv2.RequestInterceptor) ([]*ListUsers_Users, error) {
+	res, err := c.ListUsers(ctx, limit, offset, interceptors...)
+	if res == nil {
+		var zero []*ListUsers_Users
+
+		return zero, err
+	}
+
+	return res.Users, err
+}
+
+const ListUserConnectionDocument = `query ListUserConnection ($first: Int) {
+	userConnection(first: $first) {
+		totalCount
+		nodes {
+			id
+		}
+	}
+}
+`
+const CountListUserConnectionDocument = `query CountListUserConnection {
+	userConnection {
+		totalCount
+	}
+}
+`
+
+func (c *Client) ListUserConnection(ctx context.Context, first *int, interceptors ...clientv2.RequestInterceptor) (*ListUserConnection, error) {
+	vars := map[string]any{
+		"first": first,
+	}
+
+	var res ListUserConnection
+	if err := c.Client.Post(ctx, "ListUserConnection", ListUserConnectionDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// CountListUserConnection returns the totalCount of userConnection in ListUserConnection with a query selecting nothing else.
+func (c *Client) CountListUserConnection(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (int, error) {
+	var zero int
+
+	vars := map[string]any{}
+
+	var res struct {
+		UserConnection struct {
+			TotalCount int `json:"totalCount"`
+		} `json:"userConnection"`
+	}
+	if err := c.Client.Post(ctx, "CountListUserConnection", CountListUserConnectionDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return res.UserConnection.TotalCount, err
+		}
+
+		return zero, err
+	}
+
+	return res.UserConnection.TotalCount, nil
+}
+
+// ListUserConnectionFlat calls ListUserConnection and returns its userConnection field.
+func (c *Client) ListUserConnectionFlat(ctx context.Context, first *int, interceptors ...clientv2.RequestInterceptor) (ListUserConnection_UserConnection, error) {
+	res, err := c.ListUserConnection(ctx, first, interceptors...)
+	if res == nil {
+		var zero ListUserConnection_UserConnection
+
+		return zero, err
+	}
+
+	return res.UserConnection, err
+}
+
+const CreateUserDocument = `mutation CreateUser ($input: CreateUserInput!) {
+	createUser(input: $input) {
+		id
+	}
+}
+`
+
+func (c *Client) CreateUser(ctx context.Context, input CreateUserInput, interceptors ...clientv2.RequestInterceptor) (*CreateUser, error) {
+	vars := map[string]any{
+		"input": input,
+	}
+
+	var res CreateUser
+	if err := c.Client.Post(ctx, "CreateUser", CreateUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// CreateUserFlat calls CreateUser and returns its createUser field.
+func (c *Client) CreateUserFlat(ctx context.Context, input CreateUserInput, interceptors ...clientv2.RequestInterceptor) (CreateUser_CreateUser, error) {
+	res, err := c.CreateUser(ctx, input, interceptors...)
+	if res == nil {
+		var zero CreateUser_CreateUser
+
+		return zero, err
+	}
+
+	return res.CreateUser, err
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUserDocument:            "GetUser",
+	ListUsersDocument:          "ListUsers",
+	ListUserConnectionDocument: "ListUserConnection",
+	CreateUserDocument:         "CreateUser",
+}
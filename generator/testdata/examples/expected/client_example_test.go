@@ -0,0 +1,181 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+func ExampleClient_GetUser() {
+	client := NewClient(http.DefaultClient, "https://example.com/graphql", nil)
+
+	var id string
+
+	res, err := client.GetUser(context.Background(), id)
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+
+	fmt.Println(res)
+}
+
+func ExampleClient_GetUserFlat() {
+	client := NewClient(http.DefaultClient, "https://example.com/graphql", nil)
+
+	var id string
+
+	res, err := client.GetUserFlat(context.Background(), id)
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+
+	fmt.Println(res)
+}
+
+func ExampleClient_ListUsers() {
+	client := NewClient(http.DefaultClient, "https://example.com/graphql", nil)
+
+	var (
+		limit  int
+		offset int
+	)
+
+	res, err := client.ListUsers(context.Background(), limit, offset)
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+
+	fmt.Println(res)
+}
+
+func ExampleClient_ListUsersIterator() {
+	client := NewClient(http.DefaultClient, "https://example.com/graphql", nil)
+
+	for item, err := range client.ListUsersIterator(context.Background(), 100) {
+		if err != nil {
+			fmt.Println(err)
+
+			return
+		}
+
+		fmt.Println(item)
+	}
+}
+
+func ExampleClient_ListUsersStream() {
+	client := NewClient(http.DefaultClient, "https://example.com/graphql", nil)
+
+	var (
+		limit  int
+		offset int
+	)
+
+	for item, err := range client.ListUsersStream(context.Background(), limit, offset) {
+		if err != nil {
+			fmt.Println(err)
+
+			return
+		}
+
+		fmt.Println(item)
+	}
+}
+
+func ExampleClient_ListUsersFlat() {
+	client := NewClient(http.DefaultClient, "https://example.com/graphql", nil)
+
+	var (
+		limit  int
+		offset int
+	)
+
+	res, err := client.ListUsersFlat(context.Background(), limit, offset)
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+
+	fmt.Println(res)
+}
+
+func ExampleClient_ListUserConnection() {
+	client := NewClient(http.DefaultClient, "https://example.com/graphql", nil)
+
+	var first *int
+
+	res, err := client.ListUserConnection(context.Background(), first)
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+
+	fmt.Println(res)
+}
+
+func ExampleClient_CountListUserConnection() {
+	client := NewClient(http.DefaultClient, "https://example.com/graphql", nil)
+
+	res, err := client.CountListUserConnection(context.Background())
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+
+	fmt.Println(res)
+}
+
+func ExampleClient_ListUserConnectionFlat() {
+	client := NewClient(http.DefaultClient, "https://example.com/graphql", nil)
+
+	var first *int
+
+	res, err := client.ListUserConnectionFlat(context.Background(), first)
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+
+	fmt.Println(res)
+}
+
+func ExampleClient_CreateUser() {
+	client := NewClient(http.DefaultClient, "https://example.com/graphql", nil)
+
+	var input CreateUserInput
+
+	res, err := client.CreateUser(context.Background(), input)
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+
+	fmt.Println(res)
+}
+
+func ExampleClient_CreateUserFlat() {
+	client := NewClient(http.DefaultClient, "https://example.com/graphql", nil)
+
+	var input CreateUserInput
+
+	res, err := client.CreateUserFlat(context.Background(), input)
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+
+	fmt.Println(res)
+}
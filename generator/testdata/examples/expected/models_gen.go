@@ -0,0 +1,23 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package generated
+
+type CreateUserInput struct {
+	Name string `json:"name"`
+}
+
+type Mutation struct {
+}
+
+type Query struct {
+}
+
+type User struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type UserConnection struct {
+	TotalCount int     `json:"totalCount"`
+	Nodes      []*User `json:"nodes"`
+}
@@ -0,0 +1,168 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type UserAddress struct {
+	Address *UserAddress_Address "json:\"address,omitempty\" graphql:\"address\""
+}
+
+func (t *UserAddress) GetAddress() *UserAddress_Address {
+	if t == nil {
+		t = &UserAddress{}
+	}
+	return t.Address
+}
+
+type UserAddress_Address struct {
+	City string "json:\"city\" graphql:\"city\""
+	Zip  *int   "json:\"zip,omitempty\" graphql:\"zip\""
+}
+
+func (t *UserAddress_Address) GetCity() string {
+	if t == nil {
+		t = &UserAddress_Address{}
+	}
+	return t.City
+}
+func (t *UserAddress_Address) GetZip() *int {
+	if t == nil {
+		t = &UserAddress_Address{}
+	}
+	return t.Zip
+}
+
+type GetUser_User_UserAddress_Address struct {
+	City string "json:\"city\" graphql:\"city\""
+	Zip  *int   "json:\"zip,omitempty\" graphql:\"zip\""
+}
+
+func (t *GetUser_User_UserAddress_Address) GetCity() string {
+	if t == nil {
+		t = &GetUser_User_UserAddress_Address{}
+	}
+	return t.City
+}
+func (t *GetUser_User_UserAddress_Address) GetZip() *int {
+	if t == nil {
+		t = &GetUser_User_UserAddress_Address{}
+	}
+	return t.Zip
+}
+
+type GetUser_User struct {
+	Typename *string                           "json:\"__typename,omitempty\" graphql:\"__typename\""
+	Address  *GetUser_User_UserAddress_Address "json:\"address,omitempty\" graphql:\"address\""
+	Age      int                               "json:\"age\" graphql:\"age\""
+	ID       string                            "json:\"id\" graphql:\"id\""
+	Name     string                            "json:\"name\" graphql:\"name\""
+	Score    *float64                          "json:\"score,omitempty\" graphql:\"score\""
+	Active   bool                              "json:\"active\" graphql:\"active\""
+}
+
+func (t *GetUser_User) GetTypename() *string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Typename
+}
+func (t *GetUser_User) GetAddress() *GetUser_User_UserAddress_Address {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Address
+}
+func (t *GetUser_User) GetAge() int {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Age
+}
+func (t *GetUser_User) GetID() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.ID
+}
+func (t *GetUser_User) GetName() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Name
+}
+func (t *GetUser_User) GetScore() *float64 {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Score
+}
+func (t *GetUser_User) GetActive() bool {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Active
+}
+
+type GetUser struct {
+	User *GetUser_User "json:\"user,omitempty\" graphql:\"user\""
+}
+
+func (t *GetUser) GetUser() *GetUser_User {
+	if t == nil {
+		t = &GetUser{}
+	}
+	return t.User
+}
+
+const GetUserDocument = `query GetUser ($id: ID!) {
+	user(id: $id) {
+		score
+		name
+		active
+		... UserAddress
+		age
+		id
+		__typename
+	}
+}
+fragment UserAddress on User {
+	address {
+		zip
+		city
+	}
+}
+`
+
+func (c *Client) GetUser(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetUser
+	if err := c.Client.Post(ctx, "GetUser", GetUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUserDocument: "GetUser",
+}
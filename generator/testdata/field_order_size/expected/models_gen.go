@@ -0,0 +1,20 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package generated
+
+type Address struct {
+	City string `json:"city"`
+	Zip  *int   `json:"zip,omitempty"`
+}
+
+type Query struct {
+}
+
+type User struct {
+	ID      string   `json:"id"`
+	Active  bool     `json:"active"`
+	Age     int      `json:"age"`
+	Name    string   `json:"name"`
+	Score   *float64 `json:"score,omitempty"`
+	Address *Address `json:"address,omitempty"`
+}
@@ -0,0 +1,321 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type FlatClient interface {
+	GetUser(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser, error)
+	GetUserFlat(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser_User, error)
+	ListUsers(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*ListUsers, error)
+	ListUsersFlat(ctx context.Context, interceptors ...clientv2.RequestInterceptor) ([]*ListUsers_Users, error)
+	CountUsers(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*CountUsers, error)
+	CountUsersFlat(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (int, error)
+	Overview(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*Overview, error)
+	RenameUser(ctx context.Context, id string, name string, interceptors ...clientv2.RequestInterceptor) (*RenameUser, error)
+	RenameUserFlat(ctx context.Context, id string, name string, interceptors ...clientv2.RequestInterceptor) (RenameUser_RenameUser, error)
+}
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) FlatClient {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type GetUser_User struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetUser_User) GetID() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.ID
+}
+func (t *GetUser_User) GetName() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Name
+}
+
+type ListUsers_Users struct {
+	ID string "json:\"id\" graphql:\"id\""
+}
+
+func (t *ListUsers_Users) GetID() string {
+	if t == nil {
+		t = &ListUsers_Users{}
+	}
+	return t.ID
+}
+
+type Overview_Users struct {
+	ID string "json:\"id\" graphql:\"id\""
+}
+
+func (t *Overview_Users) GetID() string {
+	if t == nil {
+		t = &Overview_Users{}
+	}
+	return t.ID
+}
+
+type RenameUser_RenameUser struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *RenameUser_RenameUser) GetID() string {
+	if t == nil {
+		t = &RenameUser_RenameUser{}
+	}
+	return t.ID
+}
+func (t *RenameUser_RenameUser) GetName() string {
+	if t == nil {
+		t = &RenameUser_RenameUser{}
+	}
+	return t.Name
+}
+
+type GetUser struct {
+	User *GetUser_User "json:\"user,omitempty\" graphql:\"user\""
+}
+
+func (t *GetUser) GetUser() *GetUser_User {
+	if t == nil {
+		t = &GetUser{}
+	}
+	return t.User
+}
+
+type ListUsers struct {
+	Users []*ListUsers_Users "json:\"users\" graphql:\"users\""
+}
+
+func (t *ListUsers) GetUsers() []*ListUsers_Users {
+	if t == nil {
+		t = &ListUsers{}
+	}
+	return t.Users
+}
+
+type CountUsers struct {
+	Total int "json:\"total\" graphql:\"total\""
+}
+
+func (t *CountUsers) GetTotal() int {
+	if t == nil {
+		t = &CountUsers{}
+	}
+	return t.Total
+}
+
+type Overview struct {
+	Users     []*Overview_Users "json:\"users\" graphql:\"users\""
+	UserCount int               "json:\"userCount\" graphql:\"userCount\""
+}
+
+func (t *Overview) GetUsers() []*Overview_Users {
+	if t == nil {
+		t = &Overview{}
+	}
+	return t.Users
+}
+func (t *Overview) GetUserCount() int {
+	if t == nil {
+		t = &Overview{}
+	}
+	return t.UserCount
+}
+
+type RenameUser struct {
+	RenameUser RenameUser_RenameUser "json:\"renameUser\" graphql:\"renameUser\""
+}
+
+func (t *RenameUser) GetRenameUser() *RenameUser_RenameUser {
+	if t == nil {
+		t = &RenameUser{}
+	}
+	return &t.RenameUser
+}
+
+const GetUserDocument = `query GetUser ($id: ID!) {
+	user(id: $id) {
+		id
+		name
+	}
+}
+`
+
+func (c *Client) GetUser(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetUser
+	if err := c.Client.Post(ctx, "GetUser", GetUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetUserFlat calls GetUser and returns its user field.
+func (c *Client) GetUserFlat(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser_User, error) {
+	res, err := c.GetUser(ctx, id, interceptors...)
+	if res == nil {
+		var zero *GetUser_User
+
+		return zero, err
+	}
+
+	return res.User, err
+}
+
+const ListUsersDocument = `query ListUsers {
+	users {
+		id
+	}
+}
+`
+
+func (c *Client) ListUsers(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*ListUsers, error) {
+	vars := map[string]any{}
+
+	var res ListUsers
+	if err := c.Client.Post(ctx, "ListUsers", ListUsersDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// ListUsersFlat calls ListUsers and returns its users field.
+func (c *Client) ListUsersFlat(ctx context.Context, interceptors ...clientv2.RequestInterceptor) ([]*ListUsers_Users, error) {
+	res, err := c.ListUsers(ctx, interceptors...)
+	if res == nil {
+		var zero []*ListUsers_Users
+
+		return zero, err
+	}
+
+	return res.Users, err
+}
+
+const CountUsersDocument = `query CountUsers {
+	total: userCount
+}
+`
+
+func (c *Client) CountUsers(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*CountUsers, error) {
+	vars := map[string]any{}
+
+	var res CountUsers
+	if err := c.Client.Post(ctx, "CountUsers", CountUsersDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// CountUsersFlat calls CountUsers and returns its total field.
+func (c *Client) CountUsersFlat(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (int, error) {
+	res, err := c.CountUsers(ctx, interceptors...)
+	if res == nil {
+		var zero int
+
+		return zero, err
+	}
+
+	return res.Total, err
+}
+
+const OverviewDocument = `query Overview {
+	users {
+		id
+	}
+	userCount
+}
+`
+
+func (c *Client) Overview(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*Overview, error) {
+	vars := map[string]any{}
+
+	var res Overview
+	if err := c.Client.Post(ctx, "Overview", OverviewDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const RenameUserDocument = `mutation RenameUser ($id: ID!, $name: String!) {
+	renameUser(id: $id, name: $name) {
+		id
+		name
+	}
+}
+`
+
+func (c *Client) RenameUser(ctx context.Context, id string, name string, interceptors ...clientv2.RequestInterceptor) (*RenameUser, error) {
+	vars := map[string]any{
+		"id":   id,
+		"name": name,
+	}
+
+	var res RenameUser
+	if err := c.Client.Post(ctx, "RenameUser", RenameUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// RenameUserFlat calls RenameUser and returns its renameUser field.
+func (c *Client) RenameUserFlat(ctx context.Context, id string, name string, interceptors ...clientv2.RequestInterceptor) (RenameUser_RenameUser, error) {
+	res, err := c.RenameUser(ctx, id, name, interceptors...)
+	if res == nil {
+		var zero RenameUser_RenameUser
+
+		return zero, err
+	}
+
+	return res.RenameUser, err
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUserDocument:    "GetUser",
+	ListUsersDocument:  "ListUsers",
+	CountUsersDocument: "CountUsers",
+	OverviewDocument:   "Overview",
+	RenameUserDocument: "RenameUser",
+}
@@ -0,0 +1,148 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type GetUser_User struct {
+	ID      string         "json:\"id\" graphql:\"id\""
+	Name    string         "json:\"name\" graphql:\"name\""
+	Profile *ProfileFields "json:\"profile\" graphql:\"profile\""
+}
+
+func (t *GetUser_User) GetID() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.ID
+}
+func (t *GetUser_User) GetName() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Name
+}
+func (t *GetUser_User) GetProfile() *ProfileFields {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Profile
+}
+
+type ListRequestLogs_RequestLogs_RequestLogFields_User struct {
+	ID string "json:\"id\" graphql:\"id\""
+}
+
+func (t *ListRequestLogs_RequestLogs_RequestLogFields_User) GetID() string {
+	if t == nil {
+		t = &ListRequestLogs_RequestLogs_RequestLogFields_User{}
+	}
+	return t.ID
+}
+
+type GetUser struct {
+	User *GetUser_User "json:\"user,omitempty\" graphql:\"user\""
+}
+
+func (t *GetUser) GetUser() *GetUser_User {
+	if t == nil {
+		t = &GetUser{}
+	}
+	return t.User
+}
+
+type ListRequestLogs struct {
+	RequestLogs []*RequestLogFields "json:\"requestLogs\" graphql:\"requestLogs\""
+}
+
+func (t *ListRequestLogs) GetRequestLogs() []*RequestLogFields {
+	if t == nil {
+		t = &ListRequestLogs{}
+	}
+	return t.RequestLogs
+}
+
+const GetUserDocument = `query GetUser ($id: ID!) {
+	user(id: $id) {
+		... UserSummary
+		... UserDetails
+	}
+}
+fragment UserSummary on User {
+	id
+	name
+}
+fragment UserDetails on User {
+	id
+	profile {
+		... ProfileFields
+	}
+}
+fragment ProfileFields on Profile {
+	bio
+	website
+}
+`
+
+func (c *Client) GetUser(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetUser
+	if err := c.Client.Post(ctx, "GetUser", GetUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const ListRequestLogsDocument = `query ListRequestLogs {
+	requestLogs {
+		... RequestLogFields
+	}
+}
+fragment RequestLogFields on HTTPRequestLog {
+	path
+	status
+	user {
+		id
+	}
+}
+`
+
+func (c *Client) ListRequestLogs(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*ListRequestLogs, error) {
+	vars := map[string]any{}
+
+	var res ListRequestLogs
+	if err := c.Client.Post(ctx, "ListRequestLogs", ListRequestLogsDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUserDocument:         "GetUser",
+	ListRequestLogsDocument: "ListRequestLogs",
+}
@@ -0,0 +1,39 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+type RequestLogFields struct {
+	Path   string                 "json:\"path\" graphql:\"path\""
+	Status int                    "json:\"status\" graphql:\"status\""
+	User   *RequestLogFields_User "json:\"user,omitempty\" graphql:\"user\""
+}
+
+func (t *RequestLogFields) GetPath() string {
+	if t == nil {
+		t = &RequestLogFields{}
+	}
+	return t.Path
+}
+func (t *RequestLogFields) GetStatus() int {
+	if t == nil {
+		t = &RequestLogFields{}
+	}
+	return t.Status
+}
+func (t *RequestLogFields) GetUser() *RequestLogFields_User {
+	if t == nil {
+		t = &RequestLogFields{}
+	}
+	return t.User
+}
+
+type RequestLogFields_User struct {
+	ID string "json:\"id\" graphql:\"id\""
+}
+
+func (t *RequestLogFields_User) GetID() string {
+	if t == nil {
+		t = &RequestLogFields_User{}
+	}
+	return t.ID
+}
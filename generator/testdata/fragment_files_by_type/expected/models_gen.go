@@ -0,0 +1,23 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package generated
+
+type HTTPRequestLog struct {
+	Path   string `json:"path"`
+	Status int    `json:"status"`
+	User   *User  `json:"user,omitempty"`
+}
+
+type Profile struct {
+	Bio     string  `json:"bio"`
+	Website *string `json:"website,omitempty"`
+}
+
+type Query struct {
+}
+
+type User struct {
+	ID      string   `json:"id"`
+	Name    string   `json:"name"`
+	Profile *Profile `json:"profile"`
+}
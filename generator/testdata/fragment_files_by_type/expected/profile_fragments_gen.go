@@ -0,0 +1,21 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+type ProfileFields struct {
+	Bio     string  "json:\"bio\" graphql:\"bio\""
+	Website *string "json:\"website,omitempty\" graphql:\"website\""
+}
+
+func (t *ProfileFields) GetBio() string {
+	if t == nil {
+		t = &ProfileFields{}
+	}
+	return t.Bio
+}
+func (t *ProfileFields) GetWebsite() *string {
+	if t == nil {
+		t = &ProfileFields{}
+	}
+	return t.Website
+}
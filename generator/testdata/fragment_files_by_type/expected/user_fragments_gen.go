@@ -0,0 +1,39 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+type UserDetails struct {
+	ID      string         "json:\"id\" graphql:\"id\""
+	Profile *ProfileFields "json:\"profile\" graphql:\"profile\""
+}
+
+func (t *UserDetails) GetID() string {
+	if t == nil {
+		t = &UserDetails{}
+	}
+	return t.ID
+}
+func (t *UserDetails) GetProfile() *ProfileFields {
+	if t == nil {
+		t = &UserDetails{}
+	}
+	return t.Profile
+}
+
+type UserSummary struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *UserSummary) GetID() string {
+	if t == nil {
+		t = &UserSummary{}
+	}
+	return t.ID
+}
+func (t *UserSummary) GetName() string {
+	if t == nil {
+		t = &UserSummary{}
+	}
+	return t.Name
+}
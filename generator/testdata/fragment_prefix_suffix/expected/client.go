@@ -0,0 +1,281 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type GqlUserPartsFields struct {
+	ID      string                                  "json:\"id\" graphql:\"id\""
+	Name    string                                  "json:\"name\" graphql:\"name\""
+	Profile GqlUserPartsFields_ProfileParts_Profile "json:\"profile\" graphql:\"profile\""
+}
+
+func (t *GqlUserPartsFields) GetID() string {
+	if t == nil {
+		t = &GqlUserPartsFields{}
+	}
+	return t.ID
+}
+func (t *GqlUserPartsFields) GetName() string {
+	if t == nil {
+		t = &GqlUserPartsFields{}
+	}
+	return t.Name
+}
+func (t *GqlUserPartsFields) GetProfile() *GqlUserPartsFields_ProfileParts_Profile {
+	if t == nil {
+		t = &GqlUserPartsFields{}
+	}
+	return &t.Profile
+}
+
+func (t *GqlUserPartsFields) GetGqlProfilePartsFields() *GqlProfilePartsFields {
+	if t == nil {
+		t = &GqlUserPartsFields{}
+	}
+	return &GqlProfilePartsFields{
+		Name: t.Name,
+		Profile: GqlProfilePartsFields_Profile{
+			Bio: t.Profile.Bio,
+		},
+	}
+}
+
+type GqlProfilePartsFields struct {
+	Name    string                        "json:\"name\" graphql:\"name\""
+	Profile GqlProfilePartsFields_Profile "json:\"profile\" graphql:\"profile\""
+}
+
+func (t *GqlProfilePartsFields) GetName() string {
+	if t == nil {
+		t = &GqlProfilePartsFields{}
+	}
+	return t.Name
+}
+func (t *GqlProfilePartsFields) GetProfile() *GqlProfilePartsFields_Profile {
+	if t == nil {
+		t = &GqlProfilePartsFields{}
+	}
+	return &t.Profile
+}
+
+type GqlUserPartsFields_ProfileParts_Profile struct {
+	Bio string "json:\"bio\" graphql:\"bio\""
+}
+
+func (t *GqlUserPartsFields_ProfileParts_Profile) GetBio() string {
+	if t == nil {
+		t = &GqlUserPartsFields_ProfileParts_Profile{}
+	}
+	return t.Bio
+}
+
+type GqlProfilePartsFields_Profile struct {
+	Bio string "json:\"bio\" graphql:\"bio\""
+}
+
+func (t *GqlProfilePartsFields_Profile) GetBio() string {
+	if t == nil {
+		t = &GqlProfilePartsFields_Profile{}
+	}
+	return t.Bio
+}
+
+type GetUser_User_UserParts_ProfileParts_Profile struct {
+	Bio string "json:\"bio\" graphql:\"bio\""
+}
+
+func (t *GetUser_User_UserParts_ProfileParts_Profile) GetBio() string {
+	if t == nil {
+		t = &GetUser_User_UserParts_ProfileParts_Profile{}
+	}
+	return t.Bio
+}
+
+type GetNode_Node_User_UserParts_ProfileParts_Profile struct {
+	Bio string "json:\"bio\" graphql:\"bio\""
+}
+
+func (t *GetNode_Node_User_UserParts_ProfileParts_Profile) GetBio() string {
+	if t == nil {
+		t = &GetNode_Node_User_UserParts_ProfileParts_Profile{}
+	}
+	return t.Bio
+}
+
+type GetNode_Node struct {
+	User GqlUserPartsFields "graphql:\"... on User\""
+}
+
+func (t *GetNode_Node) GetUser() *GqlUserPartsFields {
+	if t == nil {
+		t = &GetNode_Node{}
+	}
+	return &t.User
+}
+
+type UserUpdated_UserUpdated_UserParts_ProfileParts_Profile struct {
+	Bio string "json:\"bio\" graphql:\"bio\""
+}
+
+func (t *UserUpdated_UserUpdated_UserParts_ProfileParts_Profile) GetBio() string {
+	if t == nil {
+		t = &UserUpdated_UserUpdated_UserParts_ProfileParts_Profile{}
+	}
+	return t.Bio
+}
+
+type GetUser struct {
+	User *GqlUserPartsFields "json:\"user,omitempty\" graphql:\"user\""
+}
+
+func (t *GetUser) GetUser() *GqlUserPartsFields {
+	if t == nil {
+		t = &GetUser{}
+	}
+	return t.User
+}
+
+type GetNode struct {
+	Node *GetNode_Node "json:\"node,omitempty\" graphql:\"node\""
+}
+
+func (t *GetNode) GetNode() *GetNode_Node {
+	if t == nil {
+		t = &GetNode{}
+	}
+	return t.Node
+}
+
+type GqlUserUpdatedEvent struct {
+	UserUpdated *GqlUserPartsFields "json:\"userUpdated\" graphql:\"userUpdated\""
+}
+
+func (t *GqlUserUpdatedEvent) GetUserUpdated() *GqlUserPartsFields {
+	if t == nil {
+		t = &GqlUserUpdatedEvent{}
+	}
+	return t.UserUpdated
+}
+
+const GetUserDocument = `query GetUser ($id: ID!) {
+	user(id: $id) {
+		... UserParts
+	}
+}
+fragment UserParts on User {
+	id
+	... ProfileParts
+}
+fragment ProfileParts on User {
+	name
+	profile {
+		bio
+	}
+}
+`
+
+func (c *Client) GetUser(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetUser
+	if err := c.Client.Post(ctx, "GetUser", GetUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetNodeDocument = `query GetNode ($id: ID!) {
+	node(id: $id) {
+		... on User {
+			... UserParts
+		}
+	}
+}
+fragment UserParts on User {
+	id
+	... ProfileParts
+}
+fragment ProfileParts on User {
+	name
+	profile {
+		bio
+	}
+}
+`
+
+func (c *Client) GetNode(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetNode, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetNode
+	if err := c.Client.Post(ctx, "GetNode", GetNodeDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const UserUpdatedDocument = `subscription UserUpdated ($id: ID!) {
+	userUpdated(id: $id) {
+		... UserParts
+	}
+}
+fragment UserParts on User {
+	id
+	... ProfileParts
+}
+fragment ProfileParts on User {
+	name
+	profile {
+		bio
+	}
+}
+`
+
+func (c *Client) UserUpdated(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GqlUserUpdatedEvent, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GqlUserUpdatedEvent
+	if err := c.Client.Post(ctx, "UserUpdated", UserUpdatedDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUserDocument:     "GetUser",
+	GetNodeDocument:     "GetNode",
+	UserUpdatedDocument: "UserUpdated",
+}
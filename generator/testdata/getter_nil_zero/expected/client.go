@@ -0,0 +1,160 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type UserProfile struct {
+	Profile *UserProfile_Profile "json:\"profile,omitempty\" graphql:\"profile\""
+}
+
+func (t *UserProfile) GetProfile() *UserProfile_Profile {
+	if t == nil {
+		return nil
+	}
+	return t.Profile
+}
+
+type UserProfile_Profile struct {
+	Bio       *string "json:\"bio,omitempty\" graphql:\"bio\""
+	Followers int     "json:\"followers\" graphql:\"followers\""
+}
+
+func (t *UserProfile_Profile) GetBio() *string {
+	if t == nil {
+		return nil
+	}
+	return t.Bio
+}
+func (t *UserProfile_Profile) GetFollowers() int {
+	if t == nil {
+		return 0
+	}
+	return t.Followers
+}
+
+type GetUser_User_UserProfile_Profile struct {
+	Bio       *string "json:\"bio,omitempty\" graphql:\"bio\""
+	Followers int     "json:\"followers\" graphql:\"followers\""
+}
+
+func (t *GetUser_User_UserProfile_Profile) GetBio() *string {
+	if t == nil {
+		return nil
+	}
+	return t.Bio
+}
+func (t *GetUser_User_UserProfile_Profile) GetFollowers() int {
+	if t == nil {
+		return 0
+	}
+	return t.Followers
+}
+
+type GetUser_User struct {
+	Active  bool                              "json:\"active\" graphql:\"active\""
+	ID      string                            "json:\"id\" graphql:\"id\""
+	Name    string                            "json:\"name\" graphql:\"name\""
+	Profile *GetUser_User_UserProfile_Profile "json:\"profile,omitempty\" graphql:\"profile\""
+	Role    Role                              "json:\"role\" graphql:\"role\""
+	Tags    []string                          "json:\"tags\" graphql:\"tags\""
+}
+
+func (t *GetUser_User) GetActive() bool {
+	if t == nil {
+		return false
+	}
+	return t.Active
+}
+func (t *GetUser_User) GetID() string {
+	if t == nil {
+		return ""
+	}
+	return t.ID
+}
+func (t *GetUser_User) GetName() string {
+	if t == nil {
+		return ""
+	}
+	return t.Name
+}
+func (t *GetUser_User) GetProfile() *GetUser_User_UserProfile_Profile {
+	if t == nil {
+		return nil
+	}
+	return t.Profile
+}
+func (t *GetUser_User) GetRole() *Role {
+	if t == nil {
+		return nil
+	}
+	return &t.Role
+}
+func (t *GetUser_User) GetTags() []string {
+	if t == nil {
+		return nil
+	}
+	return t.Tags
+}
+
+type GetUser struct {
+	User *GetUser_User "json:\"user,omitempty\" graphql:\"user\""
+}
+
+func (t *GetUser) GetUser() *GetUser_User {
+	if t == nil {
+		return nil
+	}
+	return t.User
+}
+
+const GetUserDocument = `query GetUser ($id: ID!) {
+	user(id: $id) {
+		id
+		name
+		active
+		role
+		tags
+		... UserProfile
+	}
+}
+fragment UserProfile on User {
+	profile {
+		bio
+		followers
+	}
+}
+`
+
+func (c *Client) GetUser(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetUser
+	if err := c.Client.Post(ctx, "GetUser", GetUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUserDocument: "GetUser",
+}
@@ -0,0 +1,226 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type UserSummary struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *UserSummary) GetID() string {
+	if t == nil {
+		t = &UserSummary{}
+	}
+	return t.ID
+}
+func (t *UserSummary) GetName() string {
+	if t == nil {
+		t = &UserSummary{}
+	}
+	return t.Name
+}
+
+type GetUser_User struct {
+	Email string "json:\"email\" graphql:\"email\""
+	ID    string "json:\"id\" graphql:\"id\""
+	Name  string "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetUser_User) GetEmail() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Email
+}
+func (t *GetUser_User) GetID() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.ID
+}
+func (t *GetUser_User) GetName() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Name
+}
+
+type SearchUsers_Search_Group struct {
+	ID    string "json:\"id\" graphql:\"id\""
+	Title string "json:\"title\" graphql:\"title\""
+}
+
+func (t *SearchUsers_Search_Group) GetID() string {
+	if t == nil {
+		t = &SearchUsers_Search_Group{}
+	}
+	return t.ID
+}
+func (t *SearchUsers_Search_Group) GetTitle() string {
+	if t == nil {
+		t = &SearchUsers_Search_Group{}
+	}
+	return t.Title
+}
+
+type SearchUsers_Search struct {
+	Group SearchUsers_Search_Group "graphql:\"... on Group\""
+	ID    string                   "json:\"id\" graphql:\"id\""
+	Name  string                   "json:\"name\" graphql:\"name\""
+}
+
+func (t *SearchUsers_Search) GetGroup() *SearchUsers_Search_Group {
+	if t == nil {
+		t = &SearchUsers_Search{}
+	}
+	return &t.Group
+}
+func (t *SearchUsers_Search) GetID() string {
+	if t == nil {
+		t = &SearchUsers_Search{}
+	}
+	return t.ID
+}
+func (t *SearchUsers_Search) GetName() string {
+	if t == nil {
+		t = &SearchUsers_Search{}
+	}
+	return t.Name
+}
+
+type GetUser struct {
+	User GetUser_User "json:\"user\" graphql:\"user\""
+}
+
+func (t *GetUser) GetUser() *GetUser_User {
+	if t == nil {
+		t = &GetUser{}
+	}
+	return &t.User
+}
+
+type ListUsers struct {
+	Users []*UserSummary "json:\"users\" graphql:\"users\""
+}
+
+func (t *ListUsers) GetUsers() []*UserSummary {
+	if t == nil {
+		t = &ListUsers{}
+	}
+	return t.Users
+}
+
+type SearchUsers struct {
+	Search []*SearchUsers_Search "json:\"search\" graphql:\"search\""
+}
+
+func (t *SearchUsers) GetSearch() []*SearchUsers_Search {
+	if t == nil {
+		t = &SearchUsers{}
+	}
+	return t.Search
+}
+
+const GetUserDocument = `query GetUser ($id: ID!) {
+	user(id: $id) {
+		id
+		name
+		email
+	}
+}
+`
+
+func (c *Client) GetUser(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetUser
+	if err := c.Client.Post(ctx, "GetUser", GetUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const ListUsersDocument = `query ListUsers {
+	users {
+		... UserSummary
+	}
+}
+fragment UserSummary on User {
+	id
+	name
+}
+`
+
+func (c *Client) ListUsers(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*ListUsers, error) {
+	vars := map[string]any{}
+
+	var res ListUsers
+	if err := c.Client.Post(ctx, "ListUsers", ListUsersDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const SearchUsersDocument = `query SearchUsers ($query: String!) {
+	search(query: $query) {
+		... UserSummary
+		... on Group {
+			id
+			title
+		}
+	}
+}
+fragment UserSummary on User {
+	id
+	name
+}
+`
+
+func (c *Client) SearchUsers(ctx context.Context, query string, interceptors ...clientv2.RequestInterceptor) (*SearchUsers, error) {
+	vars := map[string]any{
+		"query": query,
+	}
+
+	var res SearchUsers
+	if err := c.Client.Post(ctx, "SearchUsers", SearchUsersDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUserDocument:     "GetUser",
+	ListUsersDocument:   "ListUsers",
+	SearchUsersDocument: "SearchUsers",
+}
@@ -0,0 +1,25 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package generated
+
+type SearchResult interface {
+	IsSearchResult()
+}
+
+type Group struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+func (Group) IsSearchResult() {}
+
+type Query struct {
+}
+
+type User struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+func (User) IsSearchResult() {}
@@ -0,0 +1,195 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type GetUser_User struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetUser_User) GetID() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.ID
+}
+func (t *GetUser_User) GetName() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Name
+}
+
+type CreateUser_CreateUser struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *CreateUser_CreateUser) GetID() string {
+	if t == nil {
+		t = &CreateUser_CreateUser{}
+	}
+	return t.ID
+}
+func (t *CreateUser_CreateUser) GetName() string {
+	if t == nil {
+		t = &CreateUser_CreateUser{}
+	}
+	return t.Name
+}
+
+type UpdateUser_UpdateUser struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *UpdateUser_UpdateUser) GetID() string {
+	if t == nil {
+		t = &UpdateUser_UpdateUser{}
+	}
+	return t.ID
+}
+func (t *UpdateUser_UpdateUser) GetName() string {
+	if t == nil {
+		t = &UpdateUser_UpdateUser{}
+	}
+	return t.Name
+}
+
+type GetUser struct {
+	User *GetUser_User "json:\"user,omitempty\" graphql:\"user\""
+}
+
+func (t *GetUser) GetUser() *GetUser_User {
+	if t == nil {
+		t = &GetUser{}
+	}
+	return t.User
+}
+
+type CreateUser struct {
+	CreateUser CreateUser_CreateUser "json:\"createUser\" graphql:\"createUser\""
+}
+
+func (t *CreateUser) GetCreateUser() *CreateUser_CreateUser {
+	if t == nil {
+		t = &CreateUser{}
+	}
+	return &t.CreateUser
+}
+
+type UpdateUser struct {
+	UpdateUser UpdateUser_UpdateUser "json:\"updateUser\" graphql:\"updateUser\""
+}
+
+func (t *UpdateUser) GetUpdateUser() *UpdateUser_UpdateUser {
+	if t == nil {
+		t = &UpdateUser{}
+	}
+	return &t.UpdateUser
+}
+
+const GetUserDocument = `query GetUser ($id: ID!) {
+	user(id: $id) {
+		id
+		name
+	}
+}
+`
+
+func (c *Client) GetUser(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetUser
+	if err := c.Client.Post(ctx, "GetUser", GetUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const CreateUserDocument = `mutation CreateUser ($input: CreateUserInput!) {
+	createUser(input: $input) {
+		id
+		name
+	}
+}
+`
+
+func (c *Client) CreateUser(ctx context.Context, input CreateUserInput, interceptors ...clientv2.RequestInterceptor) (*CreateUser, error) {
+	if err := input.Validate(); err != nil {
+		return nil, fmt.Errorf("input: %w", err)
+	}
+
+	vars := map[string]any{
+		"input": input,
+	}
+
+	var res CreateUser
+	if err := c.Client.Post(ctx, "CreateUser", CreateUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const UpdateUserDocument = `mutation UpdateUser ($id: ID!, $input: UpdateUserInput) {
+	updateUser(id: $id, input: $input) {
+		id
+		name
+	}
+}
+`
+
+func (c *Client) UpdateUser(ctx context.Context, id string, input *UpdateUserInput, interceptors ...clientv2.RequestInterceptor) (*UpdateUser, error) {
+	if err := input.Validate(); err != nil {
+		return nil, fmt.Errorf("input: %w", err)
+	}
+
+	vars := map[string]any{
+		"id":    id,
+		"input": input,
+	}
+
+	var res UpdateUser
+	if err := c.Client.Post(ctx, "UpdateUser", UpdateUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUserDocument:    "GetUser",
+	CreateUserDocument: "CreateUser",
+	UpdateUserDocument: "UpdateUser",
+}
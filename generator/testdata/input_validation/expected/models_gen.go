@@ -0,0 +1,42 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package generated
+
+import (
+	"github.com/99designs/gqlgen/graphql"
+)
+
+type AddressInput struct {
+	City string                     `json:"city"`
+	Zip  graphql.Omittable[*string] `json:"zip,omitempty"`
+}
+
+type ContactInput struct {
+	Value string `json:"value"`
+}
+
+type CreateUserInput struct {
+	Name     string                             `json:"name"`
+	Email    string                             `json:"email"`
+	Age      graphql.Omittable[*int]            `json:"age,omitempty"`
+	Score    graphql.Omittable[*float64]        `json:"score,omitempty"`
+	Tags     graphql.Omittable[[]string]        `json:"tags,omitempty"`
+	Address  graphql.Omittable[*AddressInput]   `json:"address,omitempty"`
+	Contacts graphql.Omittable[[]*ContactInput] `json:"contacts,omitempty"`
+}
+
+type Mutation struct {
+}
+
+type Query struct {
+}
+
+type UpdateUserInput struct {
+	Name    graphql.Omittable[*string]       `json:"name,omitempty"`
+	Address graphql.Omittable[*AddressInput] `json:"address,omitempty"`
+}
+
+type User struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
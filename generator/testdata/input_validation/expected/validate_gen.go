@@ -0,0 +1,131 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+)
+
+var validateAddressInputZipPattern = regexp.MustCompile("^[0-9]{3}-[0-9]{4}$")
+
+// Validate reports the values of AddressInput violating the constraints declared on AddressInput in the schema.
+func (i *AddressInput) Validate() error {
+	if i == nil {
+		return nil
+	}
+
+	var errs []error
+	if utf8.RuneCountInString(string(i.City)) < 1 {
+		errs = append(errs, errors.New("city: length must be at least 1"))
+	}
+	if v0, ok := i.Zip.ValueOK(); ok {
+		if v0 != nil {
+			if !validateAddressInputZipPattern.MatchString(string(*v0)) {
+				errs = append(errs, errors.New("zip: must match ^[0-9]{3}-[0-9]{4}$"))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Validate reports the values of ContactInput violating the constraints declared on ContactInput in the schema.
+func (i *ContactInput) Validate() error {
+	if i == nil {
+		return nil
+	}
+
+	var errs []error
+	if utf8.RuneCountInString(string(i.Value)) < 1 {
+		errs = append(errs, errors.New("value: length must be at least 1"))
+	}
+
+	return errors.Join(errs...)
+}
+
+var validateCreateUserInputEmailPattern = regexp.MustCompile("^[^@]+@[^@]+$")
+
+// Validate reports the values of CreateUserInput violating the constraints declared on CreateUserInput in the schema.
+func (i *CreateUserInput) Validate() error {
+	if i == nil {
+		return nil
+	}
+
+	var errs []error
+	if utf8.RuneCountInString(string(i.Name)) < 3 {
+		errs = append(errs, errors.New("name: length must be at least 3"))
+	}
+	if utf8.RuneCountInString(string(i.Name)) > 64 {
+		errs = append(errs, errors.New("name: length must be at most 64"))
+	}
+	if utf8.RuneCountInString(string(i.Email)) > 255 {
+		errs = append(errs, errors.New("email: length must be at most 255"))
+	}
+	if !validateCreateUserInputEmailPattern.MatchString(string(i.Email)) {
+		errs = append(errs, errors.New("email: must match ^[^@]+@[^@]+$"))
+	}
+	if v0, ok := i.Age.ValueOK(); ok {
+		if v0 != nil {
+			if *v0 < 0 {
+				errs = append(errs, errors.New("age: must be at least 0"))
+			}
+			if *v0 > 150 {
+				errs = append(errs, errors.New("age: must be at most 150"))
+			}
+		}
+	}
+	if v0, ok := i.Score.ValueOK(); ok {
+		if v0 != nil {
+			if *v0 < 0.5 {
+				errs = append(errs, errors.New("score: must be at least 0.5"))
+			}
+		}
+	}
+	if v0, ok := i.Tags.ValueOK(); ok {
+		for _, v1 := range v0 {
+			if utf8.RuneCountInString(string(v1)) > 16 {
+				errs = append(errs, errors.New("tags: length must be at most 16"))
+			}
+		}
+	}
+	if v0, ok := i.Address.ValueOK(); ok {
+		if err := v0.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("address: %w", err))
+		}
+	}
+	if v0, ok := i.Contacts.ValueOK(); ok {
+		for _, v1 := range v0 {
+			if err := v1.Validate(); err != nil {
+				errs = append(errs, fmt.Errorf("contacts: %w", err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Validate reports the values of UpdateUserInput violating the constraints declared on UpdateUserInput in the schema.
+func (i *UpdateUserInput) Validate() error {
+	if i == nil {
+		return nil
+	}
+
+	var errs []error
+	if v0, ok := i.Name.ValueOK(); ok {
+		if v0 != nil {
+			if utf8.RuneCountInString(string(*v0)) < 3 {
+				errs = append(errs, errors.New("name: length must be at least 3"))
+			}
+		}
+	}
+	if v0, ok := i.Address.ValueOK(); ok {
+		if err := v0.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("address: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,24 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"iter"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+	"github.com/gqlgo/gqlgenc/generator/testdata/internal_client/actual/internal/gen"
+)
+
+type UserClient interface {
+	// GetUser fetches a user by its id.
+	GetUser(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*gen.GetUser, error)
+	ListUsers(ctx context.Context, filter gen.UserFilter, limit int, offset int, interceptors ...clientv2.RequestInterceptor) (*gen.ListUsers, error)
+	ListUsersIterator(ctx context.Context, filter gen.UserFilter, pageSize int, interceptors ...clientv2.RequestInterceptor) iter.Seq2[*gen.ListUsers_Users, error]
+}
+
+var _ UserClient = (*gen.Client)(nil)
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) UserClient {
+	return gen.NewClient(cli, baseURL, options, interceptors...)
+}
@@ -0,0 +1,169 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type GetUser_User struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetUser_User) GetID() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.ID
+}
+func (t *GetUser_User) GetName() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Name
+}
+
+type ListUsers_Users struct {
+	ID string "json:\"id\" graphql:\"id\""
+}
+
+func (t *ListUsers_Users) GetID() string {
+	if t == nil {
+		t = &ListUsers_Users{}
+	}
+	return t.ID
+}
+
+type GetUser struct {
+	User *GetUser_User "json:\"user,omitempty\" graphql:\"user\""
+}
+
+func (t *GetUser) GetUser() *GetUser_User {
+	if t == nil {
+		t = &GetUser{}
+	}
+	return t.User
+}
+
+type ListUsers struct {
+	Users []*ListUsers_Users "json:\"users\" graphql:\"users\""
+}
+
+func (t *ListUsers) GetUsers() []*ListUsers_Users {
+	if t == nil {
+		t = &ListUsers{}
+	}
+	return t.Users
+}
+
+const GetUserDocument = `query GetUser ($id: ID!) {
+	user(id: $id) {
+		id
+		name
+	}
+}
+`
+
+// GetUser fetches a user by its id.
+func (c *Client) GetUser(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetUser
+	if err := c.Client.Post(ctx, "GetUser", GetUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const ListUsersDocument = `query ListUsers ($filter: UserFilter!, $limit: Int!, $offset: Int!) {
+	users(filter: $filter, limit: $limit, offset: $offset) {
+		id
+	}
+}
+`
+
+func (c *Client) ListUsers(ctx context.Context, filter UserFilter, limit int, offset int, interceptors ...clientv2.RequestInterceptor) (*ListUsers, error) {
+	vars := map[string]any{
+		"filter": filter,
+		"limit":  limit,
+		"offset": offset,
+	}
+
+	var res ListUsers
+	if err := c.Client.Post(ctx, "ListUsers", ListUsersDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// ListUsersIterator ranges over the items of ListUsers, fetching pages of pageSize items until a page is not full.
+func (c *Client) ListUsersIterator(ctx context.Context, filter UserFilter, pageSize int, interceptors ...clientv2.RequestInterceptor) iter.Seq2[*ListUsers_Users, error] {
+	return func(yield func(*ListUsers_Users, error) bool) {
+		var zero *ListUsers_Users
+
+		if pageSize <= 0 {
+			yield(zero, fmt.Errorf("pageSize must be positive, got %d", pageSize))
+
+			return
+		}
+
+		var offset int
+
+		for {
+			limit := pageSize
+
+			res, err := c.ListUsers(ctx, filter, limit, offset, interceptors...)
+			if err != nil {
+				yield(zero, err)
+
+				return
+			}
+
+			var count int
+
+			for _, item := range res.Users {
+				if !yield(item, nil) {
+					return
+				}
+
+				count++
+			}
+
+			if count < pageSize {
+				return
+			}
+
+			offset += pageSize
+		}
+	}
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUserDocument:   "GetUser",
+	ListUsersDocument: "ListUsers",
+}
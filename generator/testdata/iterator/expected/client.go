@@ -0,0 +1,226 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type IteratorClient interface {
+	ListUsers(ctx context.Context, role string, limit int, offset int, interceptors ...clientv2.RequestInterceptor) (*ListUsers, error)
+	ListUsersIterator(ctx context.Context, role string, pageSize int, interceptors ...clientv2.RequestInterceptor) iter.Seq2[*ListUsers_Users, error]
+	ListPosts(ctx context.Context, first *int, skip *int, interceptors ...clientv2.RequestInterceptor) (*ListPosts, error)
+	ListPostsIterator(ctx context.Context, pageSize int, interceptors ...clientv2.RequestInterceptor) iter.Seq2[*ListPosts_Posts, error]
+}
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) IteratorClient {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type ListUsers_Users struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *ListUsers_Users) GetID() string {
+	if t == nil {
+		t = &ListUsers_Users{}
+	}
+	return t.ID
+}
+func (t *ListUsers_Users) GetName() string {
+	if t == nil {
+		t = &ListUsers_Users{}
+	}
+	return t.Name
+}
+
+type ListPosts_Posts struct {
+	ID    string "json:\"id\" graphql:\"id\""
+	Title string "json:\"title\" graphql:\"title\""
+}
+
+func (t *ListPosts_Posts) GetID() string {
+	if t == nil {
+		t = &ListPosts_Posts{}
+	}
+	return t.ID
+}
+func (t *ListPosts_Posts) GetTitle() string {
+	if t == nil {
+		t = &ListPosts_Posts{}
+	}
+	return t.Title
+}
+
+type ListUsers struct {
+	Users []*ListUsers_Users "json:\"users\" graphql:\"users\""
+}
+
+func (t *ListUsers) GetUsers() []*ListUsers_Users {
+	if t == nil {
+		t = &ListUsers{}
+	}
+	return t.Users
+}
+
+type ListPosts struct {
+	Posts []*ListPosts_Posts "json:\"posts,omitempty\" graphql:\"posts\""
+}
+
+func (t *ListPosts) GetPosts() []*ListPosts_Posts {
+	if t == nil {
+		t = &ListPosts{}
+	}
+	return t.Posts
+}
+
+const ListUsersDocument = `query ListUsers ($role: String!, $limit: Int!, $offset: Int!) {
+	users(role: $role, limit: $limit, offset: $offset) {
+		id
+		name
+	}
+}
+`
+
+func (c *Client) ListUsers(ctx context.Context, role string, limit int, offset int, interceptors ...clientv2.RequestInterceptor) (*ListUsers, error) {
+	vars := map[string]any{
+		"role":   role,
+		"limit":  limit,
+		"offset": offset,
+	}
+
+	var res ListUsers
+	if err := c.Client.Post(ctx, "ListUsers", ListUsersDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// ListUsersIterator ranges over the items of ListUsers, fetching pages of pageSize items until a page is not full.
+func (c *Client) ListUsersIterator(ctx context.Context, role string, pageSize int, interceptors ...clientv2.RequestInterceptor) iter.Seq2[*ListUsers_Users, error] {
+	return func(yield func(*ListUsers_Users, error) bool) {
+		var zero *ListUsers_Users
+
+		if pageSize <= 0 {
+			yield(zero, fmt.Errorf("pageSize must be positive, got %d", pageSize))
+
+			return
+		}
+
+		var offset int
+
+		for {
+			limit := pageSize
+
+			res, err := c.ListUsers(ctx, role, limit, offset, interceptors...)
+			if err != nil {
+				yield(zero, err)
+
+				return
+			}
+
+			var count int
+
+			for _, item := range res.Users {
+				if !yield(item, nil) {
+					return
+				}
+
+				count++
+			}
+
+			if count < pageSize {
+				return
+			}
+
+			offset += pageSize
+		}
+	}
+}
+
+const ListPostsDocument = `query ListPosts ($first: Int, $skip: Int) {
+	posts(first: $first, skip: $skip) {
+		id
+		title
+	}
+}
+`
+
+func (c *Client) ListPosts(ctx context.Context, first *int, skip *int, interceptors ...clientv2.RequestInterceptor) (*ListPosts, error) {
+	vars := map[string]any{
+		"first": first,
+		"skip":  skip,
+	}
+
+	var res ListPosts
+	if err := c.Client.Post(ctx, "ListPosts", ListPostsDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// ListPostsIterator ranges over the items of ListPosts, fetching pages of pageSize items until a page is not full.
+func (c *Client) ListPostsIterator(ctx context.Context, pageSize int, interceptors ...clientv2.RequestInterceptor) iter.Seq2[*ListPosts_Posts, error] {
+	return func(yield func(*ListPosts_Posts, error) bool) {
+		var zero *ListPosts_Posts
+
+		if pageSize <= 0 {
+			yield(zero, fmt.Errorf("pageSize must be positive, got %d", pageSize))
+
+			return
+		}
+
+		var offset int
+
+		for {
+			limit := pageSize
+
+			res, err := c.ListPosts(ctx, &limit, &offset, interceptors...)
+			if err != nil {
+				yield(zero, err)
+
+				return
+			}
+
+			var count int
+
+			for _, item := range res.Posts {
+				if !yield(item, nil) {
+					return
+				}
+
+				count++
+			}
+
+			if count < pageSize {
+				return
+			}
+
+			offset += pageSize
+		}
+	}
+}
+
+var DocumentOperationNames = map[string]string{
+	ListUsersDocument: "ListUsers",
+	ListPostsDocument: "ListPosts",
+}
@@ -677,6 +677,7 @@ const GetPersonDirectDocument = `query GetPersonDirect {
 }
 `
 
+// Case 7: Non-fragment query coexists with fragment queries
 func (c *Client) GetPersonDirect(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetPersonDirect, error) {
 	vars := map[string]any{}
 
@@ -706,6 +707,7 @@ fragment NameFrag on Person {
 }
 `
 
+// Case 8: Fragment in list query
 func (c *Client) GetPeople(ctx context.Context, limit int, interceptors ...clientv2.RequestInterceptor) (*GetPeople, error) {
 	vars := map[string]any{
 		"limit": limit,
@@ -0,0 +1,120 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type GetUser_User_Friends struct {
+	ID   string     "json:\"id\" graphql:\"id\""
+	Tags *[]*string "json:\"tags,omitempty\" graphql:\"tags\""
+}
+
+func (t *GetUser_User_Friends) GetID() string {
+	if t == nil {
+		t = &GetUser_User_Friends{}
+	}
+	return t.ID
+}
+func (t *GetUser_User_Friends) GetTags() *[]*string {
+	if t == nil {
+		t = &GetUser_User_Friends{}
+	}
+	return t.Tags
+}
+
+type GetUser_User struct {
+	Friends   *[]*GetUser_User_Friends "json:\"friends,omitempty\" graphql:\"friends\""
+	Groups    *[]*[]*string            "json:\"groups,omitempty\" graphql:\"groups\""
+	ID        string                   "json:\"id\" graphql:\"id\""
+	Nicknames []string                 "json:\"nicknames\" graphql:\"nicknames\""
+	Tags      *[]*string               "json:\"tags,omitempty\" graphql:\"tags\""
+}
+
+func (t *GetUser_User) GetFriends() *[]*GetUser_User_Friends {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Friends
+}
+func (t *GetUser_User) GetGroups() *[]*[]*string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Groups
+}
+func (t *GetUser_User) GetID() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.ID
+}
+func (t *GetUser_User) GetNicknames() []string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Nicknames
+}
+func (t *GetUser_User) GetTags() *[]*string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Tags
+}
+
+type GetUser struct {
+	User *GetUser_User "json:\"user,omitempty\" graphql:\"user\""
+}
+
+func (t *GetUser) GetUser() *GetUser_User {
+	if t == nil {
+		t = &GetUser{}
+	}
+	return t.User
+}
+
+const GetUserDocument = `query GetUser ($id: ID!) {
+	user(id: $id) {
+		id
+		tags
+		nicknames
+		friends {
+			id
+			tags
+		}
+		groups
+	}
+}
+`
+
+func (c *Client) GetUser(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetUser
+	if err := c.Client.Post(ctx, "GetUser", GetUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUserDocument: "GetUser",
+}
@@ -0,0 +1,14 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package generated
+
+type Query struct {
+}
+
+type User struct {
+	ID        string      `json:"id"`
+	Tags      []*string   `json:"tags,omitempty"`
+	Nicknames []string    `json:"nicknames"`
+	Friends   []*User     `json:"friends,omitempty"`
+	Groups    [][]*string `json:"groups,omitempty"`
+}
@@ -0,0 +1,122 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type ListUsers_Users struct {
+	ID string "json:\"id\" graphql:\"id\""
+}
+
+func (t *ListUsers_Users) GetID() string {
+	if t == nil {
+		t = &ListUsers_Users{}
+	}
+	return t.ID
+}
+
+type UpdateUser_UpdateUser struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *UpdateUser_UpdateUser) GetID() string {
+	if t == nil {
+		t = &UpdateUser_UpdateUser{}
+	}
+	return t.ID
+}
+func (t *UpdateUser_UpdateUser) GetName() string {
+	if t == nil {
+		t = &UpdateUser_UpdateUser{}
+	}
+	return t.Name
+}
+
+type ListUsers struct {
+	Users []*ListUsers_Users "json:\"users\" graphql:\"users\""
+}
+
+func (t *ListUsers) GetUsers() []*ListUsers_Users {
+	if t == nil {
+		t = &ListUsers{}
+	}
+	return t.Users
+}
+
+type UpdateUser struct {
+	UpdateUser UpdateUser_UpdateUser "json:\"updateUser\" graphql:\"updateUser\""
+}
+
+func (t *UpdateUser) GetUpdateUser() *UpdateUser_UpdateUser {
+	if t == nil {
+		t = &UpdateUser{}
+	}
+	return &t.UpdateUser
+}
+
+const ListUsersDocument = `query ListUsers ($filter: UserFilter) {
+	users(filter: $filter) {
+		id
+	}
+}
+`
+
+func (c *Client) ListUsers(ctx context.Context, filter *UserFilter, interceptors ...clientv2.RequestInterceptor) (*ListUsers, error) {
+	vars := map[string]any{
+		"filter": filter,
+	}
+
+	var res ListUsers
+	if err := c.Client.Post(ctx, "ListUsers", ListUsersDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const UpdateUserDocument = `mutation UpdateUser ($input: UpdateUserInput!) {
+	updateUser(input: $input) {
+		id
+		name
+	}
+}
+`
+
+func (c *Client) UpdateUser(ctx context.Context, input UpdateUserInput, interceptors ...clientv2.RequestInterceptor) (*UpdateUser, error) {
+	vars := map[string]any{
+		"input": input,
+	}
+
+	var res UpdateUser
+	if err := c.Client.Post(ctx, "UpdateUser", UpdateUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	ListUsersDocument:  "ListUsers",
+	UpdateUserDocument: "UpdateUser",
+}
@@ -0,0 +1,34 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package generated
+
+import (
+	"github.com/99designs/gqlgen/graphql"
+)
+
+type AddressInput struct {
+	City string `json:"city"`
+}
+
+type Mutation struct {
+}
+
+type Query struct {
+}
+
+type UpdateUserInput struct {
+	ID      string                           `json:"id"`
+	Name    graphql.Omittable[*string]       `json:"name,omitempty"`
+	Age     graphql.Omittable[*int]          `json:"age,omitempty"`
+	Address graphql.Omittable[*AddressInput] `json:"address,omitempty"`
+}
+
+type User struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type UserFilter struct {
+	Name  graphql.Omittable[*string]  `json:"name,omitempty"`
+	Roles graphql.Omittable[[]string] `json:"roles,omitempty"`
+}
@@ -0,0 +1,73 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+// HasName reports whether Name is set to a non-null value.
+func (i *UpdateUserInput) HasName() bool {
+	return i != nil && i.Name.IsSet() && i.Name.Value() != nil
+}
+
+// NameOrDefault returns the value of Name, or def when it is unset or null.
+func (i *UpdateUserInput) NameOrDefault(def string) string {
+	if !i.HasName() {
+		return def
+	}
+
+	return *i.Name.Value()
+}
+
+// HasAge reports whether Age is set to a non-null value.
+func (i *UpdateUserInput) HasAge() bool {
+	return i != nil && i.Age.IsSet() && i.Age.Value() != nil
+}
+
+// AgeOrDefault returns the value of Age, or def when it is unset or null.
+func (i *UpdateUserInput) AgeOrDefault(def int) int {
+	if !i.HasAge() {
+		return def
+	}
+
+	return *i.Age.Value()
+}
+
+// HasAddress reports whether Address is set to a non-null value.
+func (i *UpdateUserInput) HasAddress() bool {
+	return i != nil && i.Address.IsSet() && i.Address.Value() != nil
+}
+
+// AddressOrDefault returns the value of Address, or def when it is unset or null.
+func (i *UpdateUserInput) AddressOrDefault(def AddressInput) AddressInput {
+	if !i.HasAddress() {
+		return def
+	}
+
+	return *i.Address.Value()
+}
+
+// HasName reports whether Name is set to a non-null value.
+func (i *UserFilter) HasName() bool {
+	return i != nil && i.Name.IsSet() && i.Name.Value() != nil
+}
+
+// NameOrDefault returns the value of Name, or def when it is unset or null.
+func (i *UserFilter) NameOrDefault(def string) string {
+	if !i.HasName() {
+		return def
+	}
+
+	return *i.Name.Value()
+}
+
+// HasRoles reports whether Roles is set to a non-null value.
+func (i *UserFilter) HasRoles() bool {
+	return i != nil && i.Roles.IsSet() && i.Roles.Value() != nil
+}
+
+// RolesOrDefault returns the value of Roles, or def when it is unset or null.
+func (i *UserFilter) RolesOrDefault(def []string) []string {
+	if !i.HasRoles() {
+		return def
+	}
+
+	return i.Roles.Value()
+}
@@ -0,0 +1,137 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type UserClient interface {
+	// GetUser fetches a user by its id.
+	//
+	// It returns nil when the user does not exist.
+	GetUser(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser, error)
+	GetUserName(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUserName, error)
+}
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) UserClient {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type GetUser_User struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetUser_User) GetID() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.ID
+}
+func (t *GetUser_User) GetName() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Name
+}
+
+type GetUserName_User struct {
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetUserName_User) GetName() string {
+	if t == nil {
+		t = &GetUserName_User{}
+	}
+	return t.Name
+}
+
+type GetUser struct {
+	User *GetUser_User "json:\"user,omitempty\" graphql:\"user\""
+}
+
+func (t *GetUser) GetUser() *GetUser_User {
+	if t == nil {
+		t = &GetUser{}
+	}
+	return t.User
+}
+
+type GetUserName struct {
+	User *GetUserName_User "json:\"user,omitempty\" graphql:\"user\""
+}
+
+func (t *GetUserName) GetUser() *GetUserName_User {
+	if t == nil {
+		t = &GetUserName{}
+	}
+	return t.User
+}
+
+const GetUserDocument = `# GetUser fetches a user by its id.
+#
+# It returns nil when the user does not exist.
+query GetUser ($id: ID!) {
+	user(id: $id) {
+		id
+		# the display name
+		name
+	}
+}
+`
+
+// GetUser fetches a user by its id.
+//
+// It returns nil when the user does not exist.
+func (c *Client) GetUser(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetUser
+	if err := c.Client.Post(ctx, "GetUser", GetUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetUserNameDocument = `query GetUserName ($id: ID!) {
+	user(id: $id) {
+		name
+	}
+}
+`
+
+func (c *Client) GetUserName(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUserName, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetUserName
+	if err := c.Client.Post(ctx, "GetUserName", GetUserNameDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUserDocument:     "GetUser",
+	GetUserNameDocument: "GetUserName",
+}
@@ -0,0 +1,246 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"time"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type UserFields struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *UserFields) GetID() string {
+	if t == nil {
+		t = &UserFields{}
+	}
+	return t.ID
+}
+func (t *UserFields) GetName() string {
+	if t == nil {
+		t = &UserFields{}
+	}
+	return t.Name
+}
+
+type ListUsers_Users struct {
+	ID     string    "json:\"id\" graphql:\"id\""
+	Joined time.Time "json:\"joined\" graphql:\"joined\""
+	Name   string    "json:\"name\" graphql:\"name\""
+	Role   Role      "json:\"role\" graphql:\"role\""
+}
+
+func (t *ListUsers_Users) GetID() string {
+	if t == nil {
+		t = &ListUsers_Users{}
+	}
+	return t.ID
+}
+func (t *ListUsers_Users) GetJoined() *time.Time {
+	if t == nil {
+		t = &ListUsers_Users{}
+	}
+	return &t.Joined
+}
+func (t *ListUsers_Users) GetName() string {
+	if t == nil {
+		t = &ListUsers_Users{}
+	}
+	return t.Name
+}
+func (t *ListUsers_Users) GetRole() *Role {
+	if t == nil {
+		t = &ListUsers_Users{}
+	}
+	return &t.Role
+}
+
+type GetActor_Actor_Bot struct {
+	ID    string      "json:\"id\" graphql:\"id\""
+	Owner *UserFields "json:\"owner\" graphql:\"owner\""
+}
+
+func (t *GetActor_Actor_Bot) GetID() string {
+	if t == nil {
+		t = &GetActor_Actor_Bot{}
+	}
+	return t.ID
+}
+func (t *GetActor_Actor_Bot) GetOwner() *UserFields {
+	if t == nil {
+		t = &GetActor_Actor_Bot{}
+	}
+	return t.Owner
+}
+
+type GetActor_Actor struct {
+	Bot      GetActor_Actor_Bot "graphql:\"... on Bot\""
+	User     UserFields         "graphql:\"... on User\""
+	Typename *string            "json:\"__typename,omitempty\" graphql:\"__typename\""
+}
+
+func (t *GetActor_Actor) GetBot() *GetActor_Actor_Bot {
+	if t == nil {
+		t = &GetActor_Actor{}
+	}
+	return &t.Bot
+}
+func (t *GetActor_Actor) GetUser() *UserFields {
+	if t == nil {
+		t = &GetActor_Actor{}
+	}
+	return &t.User
+}
+func (t *GetActor_Actor) GetTypename() *string {
+	if t == nil {
+		t = &GetActor_Actor{}
+	}
+	return t.Typename
+}
+
+type ListUsers struct {
+	Users []*ListUsers_Users "json:\"users\" graphql:\"users\""
+}
+
+func (t *ListUsers) GetUsers() []*ListUsers_Users {
+	if t == nil {
+		t = &ListUsers{}
+	}
+	return t.Users
+}
+
+type GetActor struct {
+	Actor *GetActor_Actor "json:\"actor,omitempty\" graphql:\"actor\""
+}
+
+func (t *GetActor) GetActor() *GetActor_Actor {
+	if t == nil {
+		t = &GetActor{}
+	}
+	return t.Actor
+}
+
+type UpdateUser struct {
+	UpdateUser *UserFields "json:\"updateUser\" graphql:\"updateUser\""
+}
+
+func (t *UpdateUser) GetUpdateUser() *UserFields {
+	if t == nil {
+		t = &UpdateUser{}
+	}
+	return t.UpdateUser
+}
+
+const ListUsersDocument = `query ListUsers ($filter: UserFilter, $first: Int = 10) {
+	users(filter: $filter, first: $first) {
+		... UserFields
+		role
+		joined: createdAt
+	}
+}
+fragment UserFields on User {
+	id
+	name
+}
+`
+
+func (c *Client) ListUsers(ctx context.Context, filter *UserFilter, first *int, interceptors ...clientv2.RequestInterceptor) (*ListUsers, error) {
+	vars := map[string]any{
+		"filter": filter,
+		"first":  first,
+	}
+
+	var res ListUsers
+	if err := c.Client.Post(ctx, "ListUsers", ListUsersDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetActorDocument = `query GetActor ($id: ID!) {
+	actor(id: $id) {
+		__typename
+		... on User {
+			... UserFields
+		}
+		... on Bot {
+			id
+			owner {
+				... UserFields
+			}
+		}
+	}
+}
+fragment UserFields on User {
+	id
+	name
+}
+`
+
+func (c *Client) GetActor(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetActor, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetActor
+	if err := c.Client.Post(ctx, "GetActor", GetActorDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const UpdateUserDocument = `mutation UpdateUser ($input: UpdateUserInput!) {
+	updateUser(input: $input) {
+		... UserFields
+	}
+}
+fragment UserFields on User {
+	id
+	name
+}
+`
+
+func (c *Client) UpdateUser(ctx context.Context, input UpdateUserInput, interceptors ...clientv2.RequestInterceptor) (*UpdateUser, error) {
+	vars := map[string]any{
+		"input": input,
+	}
+
+	var res UpdateUser
+	if err := c.Client.Post(ctx, "UpdateUser", UpdateUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	ListUsersDocument:  "ListUsers",
+	GetActorDocument:   "GetActor",
+	UpdateUserDocument: "UpdateUser",
+}
@@ -0,0 +1,180 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type UserRole struct {
+	Role Role "json:\"role\" graphql:\"role\""
+}
+
+func (t *UserRole) GetRole() *Role {
+	if t == nil {
+		t = &UserRole{}
+	}
+	return &t.Role
+}
+
+type GetUsers_Users struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetUsers_Users) GetID() string {
+	if t == nil {
+		t = &GetUsers_Users{}
+	}
+	return t.ID
+}
+func (t *GetUsers_Users) GetName() string {
+	if t == nil {
+		t = &GetUsers_Users{}
+	}
+	return t.Name
+}
+
+type GetPost_Post struct {
+	Author     *UserRole  "json:\"author\" graphql:\"author\""
+	ID         string     "json:\"id\" graphql:\"id\""
+	Visibility Visibility "json:\"visibility\" graphql:\"visibility\""
+}
+
+func (t *GetPost_Post) GetAuthor() *UserRole {
+	if t == nil {
+		t = &GetPost_Post{}
+	}
+	return t.Author
+}
+func (t *GetPost_Post) GetID() string {
+	if t == nil {
+		t = &GetPost_Post{}
+	}
+	return t.ID
+}
+func (t *GetPost_Post) GetVisibility() *Visibility {
+	if t == nil {
+		t = &GetPost_Post{}
+	}
+	return &t.Visibility
+}
+
+type GetUsers struct {
+	Users []*GetUsers_Users "json:\"users\" graphql:\"users\""
+}
+
+func (t *GetUsers) GetUsers() []*GetUsers_Users {
+	if t == nil {
+		t = &GetUsers{}
+	}
+	return t.Users
+}
+
+type GetPost struct {
+	Post *GetPost_Post "json:\"post,omitempty\" graphql:\"post\""
+}
+
+func (t *GetPost) GetPost() *GetPost_Post {
+	if t == nil {
+		t = &GetPost{}
+	}
+	return t.Post
+}
+
+const GetUsersDocument = `query GetUsers ($filter: UserFilter) {
+	users(filter: $filter) {
+		id
+		name
+	}
+}
+`
+
+func (c *Client) GetUsers(ctx context.Context, filter *UserFilter, interceptors ...clientv2.RequestInterceptor) (*GetUsers, error) {
+	vars := map[string]any{
+		"filter": filter,
+	}
+
+	var res GetUsers
+	if err := c.Client.Post(ctx, "GetUsers", GetUsersDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetUsersRoleValues are the values of Role that GetUsers may send or receive.
+var GetUsersRoleValues = []Role{
+	"ADMIN",
+	"MEMBER",
+	"GUEST",
+}
+
+// GetUsersStatusValues are the values of Status that GetUsers may send or receive.
+var GetUsersStatusValues = []Status{
+	"ACTIVE",
+	"SUSPENDED",
+}
+
+const GetPostDocument = `query GetPost ($id: ID!) {
+	post(id: $id) {
+		id
+		visibility
+		author {
+			... UserRole
+		}
+	}
+}
+fragment UserRole on User {
+	role
+}
+`
+
+func (c *Client) GetPost(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetPost, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetPost
+	if err := c.Client.Post(ctx, "GetPost", GetPostDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetPostRoleValues are the values of Role that GetPost may send or receive.
+var GetPostRoleValues = []Role{
+	"ADMIN",
+	"MEMBER",
+	"GUEST",
+}
+
+// GetPostVisibilityValues are the values of Visibility that GetPost may send or receive.
+var GetPostVisibilityValues = []Visibility{
+	"PUBLIC",
+	"PRIVATE",
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUsersDocument: "GetUsers",
+	GetPostDocument:  "GetPost",
+}
@@ -0,0 +1,20 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package generated
+
+type Mutation struct {
+}
+
+type Profile struct {
+	Bio string `json:"bio"`
+}
+
+type Query struct {
+}
+
+type User struct {
+	ID       string   `json:"id"`
+	Name     string   `json:"name"`
+	Nickname *string  `json:"nickname,omitempty"`
+	Profile  *Profile `json:"profile,omitempty"`
+}
@@ -0,0 +1,254 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type UserFields struct {
+	ID      string             "json:\"id\" graphql:\"id\""
+	Name    string             "json:\"name\" graphql:\"name\""
+	Profile UserFields_Profile "json:\"profile\" graphql:\"profile\""
+}
+
+func (t *UserFields) GetID() string {
+	if t == nil {
+		t = &UserFields{}
+	}
+	return t.ID
+}
+func (t *UserFields) GetName() string {
+	if t == nil {
+		t = &UserFields{}
+	}
+	return t.Name
+}
+func (t *UserFields) GetProfile() *UserFields_Profile {
+	if t == nil {
+		t = &UserFields{}
+	}
+	return &t.Profile
+}
+
+type UserFields_Profile struct {
+	Bio string "json:\"bio\" graphql:\"bio\""
+}
+
+func (t *UserFields_Profile) GetBio() string {
+	if t == nil {
+		t = &UserFields_Profile{}
+	}
+	return t.Bio
+}
+
+type GetUser_User_UserFields_Profile struct {
+	Bio string "json:\"bio\" graphql:\"bio\""
+}
+
+func (t *GetUser_User_UserFields_Profile) GetBio() string {
+	if t == nil {
+		t = &GetUser_User_UserFields_Profile{}
+	}
+	return t.Bio
+}
+
+type getNode_Node_User_Profile struct {
+	Bio string "json:\"bio\" graphql:\"bio\""
+}
+
+func (t *getNode_Node_User_Profile) GetBio() string {
+	if t == nil {
+		t = &getNode_Node_User_Profile{}
+	}
+	return t.Bio
+}
+
+type getNode_Node_User struct {
+	Name    string                    "json:\"name\" graphql:\"name\""
+	Profile getNode_Node_User_Profile "json:\"profile\" graphql:\"profile\""
+}
+
+func (t *getNode_Node_User) GetName() string {
+	if t == nil {
+		t = &getNode_Node_User{}
+	}
+	return t.Name
+}
+func (t *getNode_Node_User) GetProfile() *getNode_Node_User_Profile {
+	if t == nil {
+		t = &getNode_Node_User{}
+	}
+	return &t.Profile
+}
+
+type getNode_Node struct {
+	User getNode_Node_User "graphql:\"... on User\""
+	ID   string            "json:\"id\" graphql:\"id\""
+}
+
+func (t *getNode_Node) GetUser() *getNode_Node_User {
+	if t == nil {
+		t = &getNode_Node{}
+	}
+	return &t.User
+}
+func (t *getNode_Node) GetID() string {
+	if t == nil {
+		t = &getNode_Node{}
+	}
+	return t.ID
+}
+
+type renameUser_RenameUser_UserFields_Profile struct {
+	Bio string "json:\"bio\" graphql:\"bio\""
+}
+
+func (t *renameUser_RenameUser_UserFields_Profile) GetBio() string {
+	if t == nil {
+		t = &renameUser_RenameUser_UserFields_Profile{}
+	}
+	return t.Bio
+}
+
+type GetUser struct {
+	User *UserFields "json:\"user,omitempty\" graphql:\"user\""
+}
+
+func (t *GetUser) GetUser() *UserFields {
+	if t == nil {
+		t = &GetUser{}
+	}
+	return t.User
+}
+
+type getNode struct {
+	Node *getNode_Node "json:\"node,omitempty\" graphql:\"node\""
+}
+
+func (t *getNode) GetNode() *getNode_Node {
+	if t == nil {
+		t = &getNode{}
+	}
+	return t.Node
+}
+
+type renameUser struct {
+	RenameUser *UserFields "json:\"renameUser\" graphql:\"renameUser\""
+}
+
+func (t *renameUser) GetRenameUser() *UserFields {
+	if t == nil {
+		t = &renameUser{}
+	}
+	return t.RenameUser
+}
+
+const GetUserDocument = `query GetUser ($id: ID!) {
+	user(id: $id) {
+		... UserFields
+	}
+}
+fragment UserFields on User {
+	id
+	name
+	profile {
+		bio
+	}
+}
+`
+
+func (c *Client) GetUser(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetUser
+	if err := c.Client.Post(ctx, "GetUser", GetUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetNodeDocument = `query GetNode ($id: ID!) {
+	node(id: $id) {
+		id
+		... on User {
+			name
+			profile {
+				bio
+			}
+		}
+	}
+}
+`
+
+func (c *Client) GetNode(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*getNode, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res getNode
+	if err := c.Client.Post(ctx, "GetNode", GetNodeDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const RenameUserDocument = `mutation RenameUser ($id: ID!, $name: String!) {
+	renameUser(id: $id, name: $name) {
+		... UserFields
+	}
+}
+fragment UserFields on User {
+	id
+	name
+	profile {
+		bio
+	}
+}
+`
+
+func (c *Client) RenameUser(ctx context.Context, id string, name string, interceptors ...clientv2.RequestInterceptor) (*renameUser, error) {
+	vars := map[string]any{
+		"id":   id,
+		"name": name,
+	}
+
+	var res renameUser
+	if err := c.Client.Post(ctx, "RenameUser", RenameUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUserDocument:    "GetUser",
+	GetNodeDocument:    "GetNode",
+	RenameUserDocument: "RenameUser",
+}
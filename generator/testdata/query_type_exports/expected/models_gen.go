@@ -0,0 +1,27 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package generated
+
+type Node interface {
+	IsNode()
+	GetID() string
+}
+
+type Mutation struct {
+}
+
+type Profile struct {
+	Bio string `json:"bio"`
+}
+
+type Query struct {
+}
+
+type User struct {
+	ID      string   `json:"id"`
+	Name    string   `json:"name"`
+	Profile *Profile `json:"profile"`
+}
+
+func (User) IsNode()            {}
+func (this User) GetID() string { return this.ID }
@@ -0,0 +1,187 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type Login_Login struct {
+	ExpiresIn    int    "json:\"expiresIn\" graphql:\"expiresIn\""
+	RefreshToken string "json:\"refreshToken\" graphql:\"refreshToken\""
+	Token        string "json:\"token\" graphql:\"token\""
+}
+
+func (t *Login_Login) GetExpiresIn() int {
+	if t == nil {
+		t = &Login_Login{}
+	}
+	return t.ExpiresIn
+}
+func (t *Login_Login) GetRefreshToken() string {
+	if t == nil {
+		t = &Login_Login{}
+	}
+	return t.RefreshToken
+}
+func (t *Login_Login) GetToken() string {
+	if t == nil {
+		t = &Login_Login{}
+	}
+	return t.Token
+}
+
+type Register_Register struct {
+	Token string "json:\"token\" graphql:\"token\""
+}
+
+func (t *Register_Register) GetToken() string {
+	if t == nil {
+		t = &Register_Register{}
+	}
+	return t.Token
+}
+
+type Login struct {
+	Login Login_Login "json:\"login\" graphql:\"login\""
+}
+
+func (t *Login) GetLogin() *Login_Login {
+	if t == nil {
+		t = &Login{}
+	}
+	return &t.Login
+}
+
+type Register struct {
+	Register Register_Register "json:\"register\" graphql:\"register\""
+}
+
+func (t *Register) GetRegister() *Register_Register {
+	if t == nil {
+		t = &Register{}
+	}
+	return &t.Register
+}
+
+type ResetPassword struct {
+	ResetPassword bool "json:\"resetPassword\" graphql:\"resetPassword\""
+}
+
+func (t *ResetPassword) GetResetPassword() bool {
+	if t == nil {
+		t = &ResetPassword{}
+	}
+	return t.ResetPassword
+}
+
+const LoginDocument = `mutation Login ($input: LoginInput!) {
+	login(input: $input) {
+		token
+		refreshToken
+		expiresIn
+	}
+}
+`
+
+// LoginSensitiveFields are the sensitive variables and fields of Login, hidden from transcripts.
+var LoginSensitiveFields = clientv2.SensitiveFields{
+	Variables: []string{"input.password", "input.otp"},
+	Response:  []string{"login.refreshToken", "login.token"},
+}
+
+func (c *Client) Login(ctx context.Context, input LoginInput, interceptors ...clientv2.RequestInterceptor) (*Login, error) {
+	vars := map[string]any{
+		"input": input,
+	}
+
+	ctx = clientv2.WithSensitiveFields(ctx, LoginSensitiveFields)
+
+	var res Login
+	if err := c.Client.Post(ctx, "Login", LoginDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const RegisterDocument = `mutation Register ($input: RegisterInput!) {
+	register(input: $input) {
+		token
+	}
+}
+`
+
+// RegisterSensitiveFields are the sensitive variables and fields of Register, hidden from transcripts.
+var RegisterSensitiveFields = clientv2.SensitiveFields{
+	Variables: []string{"input.login.password", "input.login.otp"},
+}
+
+func (c *Client) Register(ctx context.Context, input RegisterInput, interceptors ...clientv2.RequestInterceptor) (*Register, error) {
+	vars := map[string]any{
+		"input": input,
+	}
+
+	ctx = clientv2.WithSensitiveFields(ctx, RegisterSensitiveFields)
+
+	var res Register
+	if err := c.Client.Post(ctx, "Register", RegisterDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const ResetPasswordDocument = `mutation ResetPassword ($email: String!, $token: String!) {
+	resetPassword(email: $email, token: $token)
+}
+`
+
+// ResetPasswordSensitiveFields are the sensitive variables and fields of ResetPassword, hidden from transcripts.
+var ResetPasswordSensitiveFields = clientv2.SensitiveFields{
+	Variables: []string{"token"},
+}
+
+func (c *Client) ResetPassword(ctx context.Context, email string, token string, interceptors ...clientv2.RequestInterceptor) (*ResetPassword, error) {
+	vars := map[string]any{
+		"email": email,
+		"token": token,
+	}
+
+	ctx = clientv2.WithSensitiveFields(ctx, ResetPasswordSensitiveFields)
+
+	var res ResetPassword
+	if err := c.Client.Post(ctx, "ResetPassword", ResetPasswordDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	LoginDocument:         "Login",
+	RegisterDocument:      "Register",
+	ResetPasswordDocument: "ResetPassword",
+}
@@ -0,0 +1,73 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+)
+
+const LoginDocument = `mutation Login ($input: LoginInput!) {
+	login(input: $input) {
+		token
+		refreshToken
+		expiresIn
+	}
+}
+`
+
+// LoginOperationName is the operation name of LoginDocument.
+const LoginOperationName = "Login"
+
+// LoginDocumentHash is the hex encoded SHA-256 of LoginDocument.
+const LoginDocumentHash = "454e912d4b926dba22d1b6e7eb6d9c07e79c7755c2d4a267f0af10aeac523306"
+
+// LoginVariables are the variables of LoginDocument.
+type LoginVariables struct {
+	Input LoginInput `json:"input"`
+}
+
+const RegisterDocument = `mutation Register ($input: RegisterInput!) {
+	register(input: $input) {
+		token
+	}
+}
+`
+
+// RegisterOperationName is the operation name of RegisterDocument.
+const RegisterOperationName = "Register"
+
+// RegisterDocumentHash is the hex encoded SHA-256 of RegisterDocument.
+const RegisterDocumentHash = "eff2cf68f1a6b6b72236f14c4494fb5a19dda4ab43619beb1d6e78fcf60cbbbf"
+
+// RegisterVariables are the variables of RegisterDocument.
+type RegisterVariables struct {
+	Input RegisterInput `json:"input"`
+}
+
+const ResetPasswordDocument = `mutation ResetPassword ($email: String!, $token: String!) {
+	resetPassword(email: $email, token: $token)
+}
+`
+
+// ResetPasswordOperationName is the operation name of ResetPasswordDocument.
+const ResetPasswordOperationName = "ResetPassword"
+
+// ResetPasswordDocumentHash is the hex encoded SHA-256 of ResetPasswordDocument.
+const ResetPasswordDocumentHash = "f1fae340a16b58da0a8a483af6da7f45569933c8a68f19c3773cf36884eab044"
+
+// ResetPasswordVariables are the variables of ResetPasswordDocument.
+type ResetPasswordVariables struct {
+	Email string `json:"email"`
+	Token string `json:"token"`
+}
+
+// String returns ResetPasswordVariables with the values of its @sensitive variables redacted, so logging it does not leak them.
+func (v ResetPasswordVariables) String() string {
+	return fmt.Sprintf("{Email:%v Token:[REDACTED]}", v.Email)
+}
+
+var DocumentOperationNames = map[string]string{
+	LoginDocument:         "Login",
+	RegisterDocument:      "Register",
+	ResetPasswordDocument: "ResetPassword",
+}
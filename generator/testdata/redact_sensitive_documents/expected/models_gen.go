@@ -0,0 +1,31 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package generated
+
+type LoginInput struct {
+	Email    string  `json:"email"`
+	Password string  `json:"password"`
+	Otp      *string `json:"otp,omitempty"`
+}
+
+type Mutation struct {
+}
+
+type ProfileInput struct {
+	Name  string  `json:"name"`
+	Phone *string `json:"phone,omitempty"`
+}
+
+type Query struct {
+}
+
+type RegisterInput struct {
+	Login   *LoginInput   `json:"login"`
+	Profile *ProfileInput `json:"profile,omitempty"`
+}
+
+type Session struct {
+	Token        string `json:"token"`
+	RefreshToken string `json:"refreshToken"`
+	ExpiresIn    int    `json:"expiresIn"`
+}
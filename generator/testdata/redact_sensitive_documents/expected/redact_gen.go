@@ -0,0 +1,12 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+)
+
+// String returns LoginInput with the values of its sensitive fields redacted, so logging it does not leak them.
+func (i LoginInput) String() string {
+	return fmt.Sprintf("{Email:%v Password:[REDACTED] Otp:[REDACTED]}", i.Email)
+}
@@ -0,0 +1,193 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type GetOrder_Order struct {
+	ID      string   "json:\"id\" graphql:\"id\" scalar:\"UUID\""
+	Note    *string  "json:\"note,omitempty\" graphql:\"note\""
+	Refunds []string "json:\"refunds,omitempty\" graphql:\"refunds\" scalar:\"Decimal\""
+	Total   string   "json:\"total\" graphql:\"total\" scalar:\"Decimal\""
+}
+
+func (t *GetOrder_Order) GetID() string {
+	if t == nil {
+		t = &GetOrder_Order{}
+	}
+	return t.ID
+}
+func (t *GetOrder_Order) GetNote() *string {
+	if t == nil {
+		t = &GetOrder_Order{}
+	}
+	return t.Note
+}
+func (t *GetOrder_Order) GetRefunds() []string {
+	if t == nil {
+		t = &GetOrder_Order{}
+	}
+	return t.Refunds
+}
+func (t *GetOrder_Order) GetTotal() string {
+	if t == nil {
+		t = &GetOrder_Order{}
+	}
+	return t.Total
+}
+
+type ListOrders_Orders struct {
+	Amount string "json:\"amount\" graphql:\"amount\" scalar:\"Decimal\""
+	ID     string "json:\"id\" graphql:\"id\" scalar:\"UUID\""
+}
+
+func (t *ListOrders_Orders) GetAmount() string {
+	if t == nil {
+		t = &ListOrders_Orders{}
+	}
+	return t.Amount
+}
+func (t *ListOrders_Orders) GetID() string {
+	if t == nil {
+		t = &ListOrders_Orders{}
+	}
+	return t.ID
+}
+
+type CreateOrder_CreateOrder struct {
+	ID string "json:\"id\" graphql:\"id\" scalar:\"UUID\""
+}
+
+func (t *CreateOrder_CreateOrder) GetID() string {
+	if t == nil {
+		t = &CreateOrder_CreateOrder{}
+	}
+	return t.ID
+}
+
+type GetOrder struct {
+	Order *GetOrder_Order "json:\"order,omitempty\" graphql:\"order\""
+}
+
+func (t *GetOrder) GetOrder() *GetOrder_Order {
+	if t == nil {
+		t = &GetOrder{}
+	}
+	return t.Order
+}
+
+type ListOrders struct {
+	Orders []*ListOrders_Orders "json:\"orders\" graphql:\"orders\""
+}
+
+func (t *ListOrders) GetOrders() []*ListOrders_Orders {
+	if t == nil {
+		t = &ListOrders{}
+	}
+	return t.Orders
+}
+
+type CreateOrder struct {
+	CreateOrder CreateOrder_CreateOrder "json:\"createOrder\" graphql:\"createOrder\""
+}
+
+func (t *CreateOrder) GetCreateOrder() *CreateOrder_CreateOrder {
+	if t == nil {
+		t = &CreateOrder{}
+	}
+	return &t.CreateOrder
+}
+
+const GetOrderDocument = `query GetOrder ($id: UUID!) {
+	order(id: $id) {
+		id
+		total
+		refunds
+		note
+	}
+}
+`
+
+func (c *Client) GetOrder(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetOrder, error) {
+	vars := map[string]any{
+		"id": clientv2.ScalarValue{Name: "UUID", Value: id},
+	}
+
+	var res GetOrder
+	if err := c.Client.Post(ctx, "GetOrder", GetOrderDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const ListOrdersDocument = `query ListOrders ($ids: [UUID!]!) {
+	orders(ids: $ids) {
+		id
+		amount: total
+	}
+}
+`
+
+func (c *Client) ListOrders(ctx context.Context, ids []string, interceptors ...clientv2.RequestInterceptor) (*ListOrders, error) {
+	vars := map[string]any{
+		"ids": clientv2.ScalarValue{Name: "UUID", Value: ids},
+	}
+
+	var res ListOrders
+	if err := c.Client.Post(ctx, "ListOrders", ListOrdersDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const CreateOrderDocument = `mutation CreateOrder ($input: OrderInput!) {
+	createOrder(input: $input) {
+		id
+	}
+}
+`
+
+func (c *Client) CreateOrder(ctx context.Context, input OrderInput, interceptors ...clientv2.RequestInterceptor) (*CreateOrder, error) {
+	vars := map[string]any{
+		"input": input,
+	}
+
+	var res CreateOrder
+	if err := c.Client.Post(ctx, "CreateOrder", CreateOrderDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	GetOrderDocument:    "GetOrder",
+	ListOrdersDocument:  "ListOrders",
+	CreateOrderDocument: "CreateOrder",
+}
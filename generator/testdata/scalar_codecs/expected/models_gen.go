@@ -0,0 +1,22 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package generated
+
+type Mutation struct {
+}
+
+type Order struct {
+	ID      string   `json:"id" scalar:"UUID"`
+	Total   string   `json:"total" scalar:"Decimal"`
+	Refunds []string `json:"refunds,omitempty" scalar:"Decimal"`
+	Note    *string  `json:"note,omitempty"`
+}
+
+type OrderInput struct {
+	CustomerID string   `json:"customerId" scalar:"UUID"`
+	Total      *string  `json:"total,omitempty" scalar:"Decimal"`
+	Tags       []string `json:"tags,omitempty"`
+}
+
+type Query struct {
+}
@@ -0,0 +1,205 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type UserParts struct {
+	ID      string                         "json:\"id\" graphql:\"id\""
+	Name    string                         "json:\"name\" graphql:\"name\""
+	Profile UserParts_ProfileParts_Profile "json:\"profile\" graphql:\"profile\""
+}
+
+func (t *UserParts) GetID() string {
+	if t == nil {
+		t = &UserParts{}
+	}
+	return t.ID
+}
+func (t *UserParts) GetName() string {
+	if t == nil {
+		t = &UserParts{}
+	}
+	return t.Name
+}
+func (t *UserParts) GetProfile() *UserParts_ProfileParts_Profile {
+	if t == nil {
+		t = &UserParts{}
+	}
+	return &t.Profile
+}
+
+type ProfileParts struct {
+	Name    string               "json:\"name\" graphql:\"name\""
+	Profile ProfileParts_Profile "json:\"profile\" graphql:\"profile\""
+}
+
+func (t *ProfileParts) GetName() string {
+	if t == nil {
+		t = &ProfileParts{}
+	}
+	return t.Name
+}
+func (t *ProfileParts) GetProfile() *ProfileParts_Profile {
+	if t == nil {
+		t = &ProfileParts{}
+	}
+	return &t.Profile
+}
+
+type UserParts_ProfileParts_Profile struct {
+	Bio string "json:\"bio\" graphql:\"bio\""
+}
+
+func (t *UserParts_ProfileParts_Profile) GetBio() string {
+	if t == nil {
+		t = &UserParts_ProfileParts_Profile{}
+	}
+	return t.Bio
+}
+
+type ProfileParts_Profile struct {
+	Bio string "json:\"bio\" graphql:\"bio\""
+}
+
+func (t *ProfileParts_Profile) GetBio() string {
+	if t == nil {
+		t = &ProfileParts_Profile{}
+	}
+	return t.Bio
+}
+
+type GetUser_User_UserParts_ProfileParts_Profile struct {
+	Bio string "json:\"bio\" graphql:\"bio\""
+}
+
+func (t *GetUser_User_UserParts_ProfileParts_Profile) GetBio() string {
+	if t == nil {
+		t = &GetUser_User_UserParts_ProfileParts_Profile{}
+	}
+	return t.Bio
+}
+
+type GetNode_Node_User_UserParts_ProfileParts_Profile struct {
+	Bio string "json:\"bio\" graphql:\"bio\""
+}
+
+func (t *GetNode_Node_User_UserParts_ProfileParts_Profile) GetBio() string {
+	if t == nil {
+		t = &GetNode_Node_User_UserParts_ProfileParts_Profile{}
+	}
+	return t.Bio
+}
+
+type GetNode_Node struct {
+	User UserParts "graphql:\"... on User\""
+}
+
+type GetUser struct {
+	User *UserParts "json:\"user,omitempty\" graphql:\"user\""
+}
+
+func (t *GetUser) GetUser() *UserParts {
+	if t == nil {
+		t = &GetUser{}
+	}
+	return t.User
+}
+
+type GetNode struct {
+	Node *GetNode_Node "json:\"node,omitempty\" graphql:\"node\""
+}
+
+func (t *GetNode) GetNode() *GetNode_Node {
+	if t == nil {
+		t = &GetNode{}
+	}
+	return t.Node
+}
+
+const GetUserDocument = `query GetUser ($id: ID!) {
+	user(id: $id) {
+		... UserParts
+	}
+}
+fragment UserParts on User {
+	id
+	... ProfileParts
+}
+fragment ProfileParts on User {
+	name
+	profile {
+		bio
+	}
+}
+`
+
+func (c *Client) GetUser(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUser, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetUser
+	if err := c.Client.Post(ctx, "GetUser", GetUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetNodeDocument = `query GetNode ($id: ID!) {
+	node(id: $id) {
+		... on User {
+			... UserParts
+		}
+	}
+}
+fragment UserParts on User {
+	id
+	... ProfileParts
+}
+fragment ProfileParts on User {
+	name
+	profile {
+		bio
+	}
+}
+`
+
+func (c *Client) GetNode(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetNode, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetNode
+	if err := c.Client.Post(ctx, "GetNode", GetNodeDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUserDocument: "GetUser",
+	GetNodeDocument: "GetNode",
+}
@@ -0,0 +1,154 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"iter"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type StreamClient interface {
+	ListUsers(ctx context.Context, role string, interceptors ...clientv2.RequestInterceptor) (*ListUsers, error)
+	ListUsersStream(ctx context.Context, role string, interceptors ...clientv2.RequestInterceptor) iter.Seq2[*ListUsers_Users, error]
+	ListPosts(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*ListPosts, error)
+	ListPostsStream(ctx context.Context, interceptors ...clientv2.RequestInterceptor) iter.Seq2[*ListPosts_AllPosts, error]
+}
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) StreamClient {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type ListUsers_Users struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *ListUsers_Users) GetID() string {
+	if t == nil {
+		t = &ListUsers_Users{}
+	}
+	return t.ID
+}
+func (t *ListUsers_Users) GetName() string {
+	if t == nil {
+		t = &ListUsers_Users{}
+	}
+	return t.Name
+}
+
+type ListPosts_AllPosts struct {
+	ID    string "json:\"id\" graphql:\"id\""
+	Title string "json:\"title\" graphql:\"title\""
+}
+
+func (t *ListPosts_AllPosts) GetID() string {
+	if t == nil {
+		t = &ListPosts_AllPosts{}
+	}
+	return t.ID
+}
+func (t *ListPosts_AllPosts) GetTitle() string {
+	if t == nil {
+		t = &ListPosts_AllPosts{}
+	}
+	return t.Title
+}
+
+type ListUsers struct {
+	Users []*ListUsers_Users "json:\"users\" graphql:\"users\""
+}
+
+func (t *ListUsers) GetUsers() []*ListUsers_Users {
+	if t == nil {
+		t = &ListUsers{}
+	}
+	return t.Users
+}
+
+type ListPosts struct {
+	AllPosts []*ListPosts_AllPosts "json:\"allPosts,omitempty\" graphql:\"allPosts\""
+}
+
+func (t *ListPosts) GetAllPosts() []*ListPosts_AllPosts {
+	if t == nil {
+		t = &ListPosts{}
+	}
+	return t.AllPosts
+}
+
+const ListUsersDocument = `query ListUsers ($role: String!) {
+	users(role: $role, limit: 1000000, offset: 0) {
+		id
+		name
+	}
+}
+`
+
+func (c *Client) ListUsers(ctx context.Context, role string, interceptors ...clientv2.RequestInterceptor) (*ListUsers, error) {
+	vars := map[string]any{
+		"role": role,
+	}
+
+	var res ListUsers
+	if err := c.Client.Post(ctx, "ListUsers", ListUsersDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// ListUsersStream ranges over the items of ListUsers, decoding them one at a time from the response
+// instead of as a whole.
+func (c *Client) ListUsersStream(ctx context.Context, role string, interceptors ...clientv2.RequestInterceptor) iter.Seq2[*ListUsers_Users, error] {
+	vars := map[string]any{
+		"role": role,
+	}
+
+	return clientv2.PostStream[*ListUsers_Users](ctx, c.Client, "ListUsers", ListUsersDocument, vars, []string{"users"}, interceptors...)
+}
+
+const ListPostsDocument = `query ListPosts {
+	allPosts: posts {
+		id
+		title
+	}
+}
+`
+
+func (c *Client) ListPosts(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*ListPosts, error) {
+	vars := map[string]any{}
+
+	var res ListPosts
+	if err := c.Client.Post(ctx, "ListPosts", ListPostsDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// ListPostsStream ranges over the items of ListPosts, decoding them one at a time from the response
+// instead of as a whole.
+func (c *Client) ListPostsStream(ctx context.Context, interceptors ...clientv2.RequestInterceptor) iter.Seq2[*ListPosts_AllPosts, error] {
+	vars := map[string]any{}
+
+	return clientv2.PostStream[*ListPosts_AllPosts](ctx, c.Client, "ListPosts", ListPostsDocument, vars, []string{"allPosts"}, interceptors...)
+}
+
+var DocumentOperationNames = map[string]string{
+	ListUsersDocument: "ListUsers",
+	ListPostsDocument: "ListPosts",
+}
@@ -0,0 +1,132 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) *Client {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type ListUsers_Users struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *ListUsers_Users) GetID() string {
+	if t == nil {
+		t = &ListUsers_Users{}
+	}
+	return t.ID
+}
+func (t *ListUsers_Users) GetName() string {
+	if t == nil {
+		t = &ListUsers_Users{}
+	}
+	return t.Name
+}
+
+type ListUsers struct {
+	Users []*ListUsers_Users "json:\"users\" graphql:\"users\""
+}
+
+func (t *ListUsers) GetUsers() []*ListUsers_Users {
+	if t == nil {
+		t = &ListUsers{}
+	}
+	return t.Users
+}
+
+const ListUsersDocument = `query ListUsers ($filter: UserFilter!) {
+	users(filter: $filter) {
+		id
+		name
+	}
+}
+`
+
+func (c *Client) ListUsers(ctx context.Context, filter UserFilter, interceptors ...clientv2.RequestInterceptor) (*ListUsers, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("ListUsers: %w", fmt.Errorf("filter: %w", err))
+	}
+
+	vars := map[string]any{
+		"filter": filter,
+	}
+
+	ctx, err := c.Client.BeforeOperation(ctx, "ListUsers", vars)
+	if err != nil {
+		return nil, fmt.Errorf("ListUsers: %w", err)
+	}
+
+	var res ListUsers
+	err = c.Client.Post(ctx, "ListUsers", ListUsersDocument, &res, vars, interceptors...)
+	if err = c.Client.AfterOperation(ctx, "ListUsers", vars, &res, err); err != nil {
+		err = fmt.Errorf("ListUsers: %w", err)
+
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// ListUsersStream ranges over the items of ListUsers, decoding them one at a time from the response
+// instead of as a whole.
+func (c *Client) ListUsersStream(ctx context.Context, filter UserFilter, interceptors ...clientv2.RequestInterceptor) iter.Seq2[*ListUsers_Users, error] {
+	vars := map[string]any{
+		"filter": filter,
+	}
+
+	return func(yield func(*ListUsers_Users, error) bool) {
+		var zero *ListUsers_Users
+
+		if err := filter.Validate(); err != nil {
+			yield(zero, fmt.Errorf("ListUsers: %w", fmt.Errorf("filter: %w", err)))
+
+			return
+		}
+
+		ctx, err := c.Client.BeforeOperation(ctx, "ListUsers", vars)
+		if err != nil {
+			yield(zero, fmt.Errorf("ListUsers: %w", err))
+
+			return
+		}
+
+		for item, itemErr := range clientv2.PostStream[*ListUsers_Users](ctx, c.Client, "ListUsers", ListUsersDocument, vars, []string{"users"}, interceptors...) {
+			if itemErr != nil {
+				err = itemErr
+
+				break
+			}
+
+			if !yield(item, nil) {
+				_ = c.Client.AfterOperation(ctx, "ListUsers", vars, nil, nil)
+
+				return
+			}
+		}
+
+		if err = c.Client.AfterOperation(ctx, "ListUsers", vars, nil, err); err != nil {
+			yield(zero, fmt.Errorf("ListUsers: %w", err))
+		}
+	}
+}
+
+var DocumentOperationNames = map[string]string{
+	ListUsersDocument: "ListUsers",
+}
@@ -0,0 +1,25 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"errors"
+	"unicode/utf8"
+)
+
+// Validate reports the values of UserFilter violating the constraints declared on UserFilter in the schema.
+func (i *UserFilter) Validate() error {
+	if i == nil {
+		return nil
+	}
+
+	var errs []error
+	if utf8.RuneCountInString(string(i.Role)) < 1 {
+		errs = append(errs, errors.New("role: length must be at least 1"))
+	}
+	if utf8.RuneCountInString(string(i.Role)) > 32 {
+		errs = append(errs, errors.New("role: length must be at most 32"))
+	}
+
+	return errors.Join(errs...)
+}
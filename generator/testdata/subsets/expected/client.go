@@ -0,0 +1,272 @@
+// Code generated by github.com/gqlgo/gqlgenc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/gqlgo/gqlgenc/clientv2"
+)
+
+type SubsetClient interface {
+	GetUser(ctx context.Context, id string, first *int, interceptors ...clientv2.RequestInterceptor) (*GetUser, error)
+	GetUserSummary(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUserSummary, error)
+	GetUserPostTitles(ctx context.Context, id string, first *int, interceptors ...clientv2.RequestInterceptor) (*GetUserPostTitles, error)
+}
+
+type Client struct {
+	Client *clientv2.Client
+}
+
+func NewClient(cli clientv2.HttpClient, baseURL string, options *clientv2.Options, interceptors ...clientv2.RequestInterceptor) SubsetClient {
+	return &Client{Client: clientv2.NewClient(cli, baseURL, options, interceptors...)}
+}
+
+type UserProfile struct {
+	Name  string "json:\"name\" graphql:\"name\""
+	Email string "json:\"email\" graphql:\"email\""
+}
+
+func (t *UserProfile) GetName() string {
+	if t == nil {
+		t = &UserProfile{}
+	}
+	return t.Name
+}
+func (t *UserProfile) GetEmail() string {
+	if t == nil {
+		t = &UserProfile{}
+	}
+	return t.Email
+}
+
+type GetUser_User_Posts struct {
+	Body  string "json:\"body\" graphql:\"body\""
+	ID    string "json:\"id\" graphql:\"id\""
+	Title string "json:\"title\" graphql:\"title\""
+}
+
+func (t *GetUser_User_Posts) GetBody() string {
+	if t == nil {
+		t = &GetUser_User_Posts{}
+	}
+	return t.Body
+}
+func (t *GetUser_User_Posts) GetID() string {
+	if t == nil {
+		t = &GetUser_User_Posts{}
+	}
+	return t.ID
+}
+func (t *GetUser_User_Posts) GetTitle() string {
+	if t == nil {
+		t = &GetUser_User_Posts{}
+	}
+	return t.Title
+}
+
+type GetUser_User struct {
+	Email string                "json:\"email\" graphql:\"email\""
+	ID    string                "json:\"id\" graphql:\"id\""
+	Name  string                "json:\"name\" graphql:\"name\""
+	Posts []*GetUser_User_Posts "json:\"posts\" graphql:\"posts\""
+}
+
+func (t *GetUser_User) GetEmail() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Email
+}
+func (t *GetUser_User) GetID() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.ID
+}
+func (t *GetUser_User) GetName() string {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Name
+}
+func (t *GetUser_User) GetPosts() []*GetUser_User_Posts {
+	if t == nil {
+		t = &GetUser_User{}
+	}
+	return t.Posts
+}
+
+type GetUserSummary_User struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetUserSummary_User) GetID() string {
+	if t == nil {
+		t = &GetUserSummary_User{}
+	}
+	return t.ID
+}
+func (t *GetUserSummary_User) GetName() string {
+	if t == nil {
+		t = &GetUserSummary_User{}
+	}
+	return t.Name
+}
+
+type GetUserPostTitles_User_Posts struct {
+	Title string "json:\"title\" graphql:\"title\""
+}
+
+func (t *GetUserPostTitles_User_Posts) GetTitle() string {
+	if t == nil {
+		t = &GetUserPostTitles_User_Posts{}
+	}
+	return t.Title
+}
+
+type GetUserPostTitles_User struct {
+	ID    string                          "json:\"id\" graphql:\"id\""
+	Posts []*GetUserPostTitles_User_Posts "json:\"posts\" graphql:\"posts\""
+}
+
+func (t *GetUserPostTitles_User) GetID() string {
+	if t == nil {
+		t = &GetUserPostTitles_User{}
+	}
+	return t.ID
+}
+func (t *GetUserPostTitles_User) GetPosts() []*GetUserPostTitles_User_Posts {
+	if t == nil {
+		t = &GetUserPostTitles_User{}
+	}
+	return t.Posts
+}
+
+type GetUser struct {
+	User *GetUser_User "json:\"user,omitempty\" graphql:\"user\""
+}
+
+func (t *GetUser) GetUser() *GetUser_User {
+	if t == nil {
+		t = &GetUser{}
+	}
+	return t.User
+}
+
+type GetUserSummary struct {
+	User *GetUserSummary_User "json:\"user,omitempty\" graphql:\"user\""
+}
+
+func (t *GetUserSummary) GetUser() *GetUserSummary_User {
+	if t == nil {
+		t = &GetUserSummary{}
+	}
+	return t.User
+}
+
+type GetUserPostTitles struct {
+	User *GetUserPostTitles_User "json:\"user,omitempty\" graphql:\"user\""
+}
+
+func (t *GetUserPostTitles) GetUser() *GetUserPostTitles_User {
+	if t == nil {
+		t = &GetUserPostTitles{}
+	}
+	return t.User
+}
+
+const GetUserDocument = `query GetUser ($id: ID!, $first: Int) {
+	user(id: $id) {
+		id
+		... UserProfile
+		posts(first: $first) {
+			id
+			title
+			body
+		}
+	}
+}
+fragment UserProfile on User {
+	name
+	email
+}
+`
+
+func (c *Client) GetUser(ctx context.Context, id string, first *int, interceptors ...clientv2.RequestInterceptor) (*GetUser, error) {
+	vars := map[string]any{
+		"id":    id,
+		"first": first,
+	}
+
+	var res GetUser
+	if err := c.Client.Post(ctx, "GetUser", GetUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetUserSummaryDocument = `query GetUserSummary ($id: ID!) {
+	user(id: $id) {
+		id
+		name
+	}
+}
+`
+
+func (c *Client) GetUserSummary(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetUserSummary, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetUserSummary
+	if err := c.Client.Post(ctx, "GetUserSummary", GetUserSummaryDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetUserPostTitlesDocument = `query GetUserPostTitles ($id: ID!, $first: Int) {
+	user(id: $id) {
+		id
+		posts(first: $first) {
+			title
+		}
+	}
+}
+`
+
+func (c *Client) GetUserPostTitles(ctx context.Context, id string, first *int, interceptors ...clientv2.RequestInterceptor) (*GetUserPostTitles, error) {
+	vars := map[string]any{
+		"id":    id,
+		"first": first,
+	}
+
+	var res GetUserPostTitles
+	if err := c.Client.Post(ctx, "GetUserPostTitles", GetUserPostTitlesDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+var DocumentOperationNames = map[string]string{
+	GetUserDocument:           "GetUser",
+	GetUserSummaryDocument:    "GetUserSummary",
+	GetUserPostTitlesDocument: "GetUserPostTitles",
+}
@@ -0,0 +1,51 @@
+package generated
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestGetUser was scaffolded by gqlgenc, which does not write it again, to be completed with the cases of GetUser.
+func TestGetUser(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		id   string
+		name *string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		// response is the body the server answers with.
+		response string
+		wantErr  bool
+	}{
+		{
+			name:     "TODO",
+			response: `{"data":{"user":null}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(tt.response))
+			}))
+			t.Cleanup(server.Close)
+
+			res, err := NewClient(server.Client(), server.URL, nil).GetUser(context.Background(), tt.args.id, tt.args.name)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetUser() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			// TODO: check the response.
+			_ = res
+		})
+	}
+}
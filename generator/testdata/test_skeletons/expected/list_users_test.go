@@ -0,0 +1,45 @@
+package generated
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestListUsers was scaffolded by gqlgenc, which does not write it again, to be completed with the cases of ListUsers.
+func TestListUsers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		// response is the body the server answers with.
+		response string
+		wantErr  bool
+	}{
+		{
+			name:     "TODO",
+			response: `{"data":{"users":null}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(tt.response))
+			}))
+			t.Cleanup(server.Close)
+
+			res, err := NewClient(server.Client(), server.URL, nil).ListUsers(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ListUsers() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			// TODO: check the response.
+			_ = res
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package generator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+
+	"github.com/gqlgo/gqlgenc/config"
+)
+
+// generatedPackageDirs returns the directories of the packages generation writes Go code to.
+func generatedPackageDirs(cfg *config.Config) []string {
+	dirs := []string{filepath.Dir(cfg.Client.Filename)}
+
+	if interfacePackage := cfg.Generate.GetClientInterfacePackage(); interfacePackage != nil {
+		dirs = append(dirs, filepath.Dir(interfacePackage.Filename))
+	}
+
+	if cfg.Model.IsDefined() {
+		dirs = append(dirs, filepath.Dir(cfg.Model.Filename))
+	}
+
+	for i, dir := range dirs {
+		if abs, err := filepath.Abs(dir); err == nil {
+			dirs[i] = abs
+		}
+	}
+
+	slices.Sort(dirs)
+
+	return slices.Compact(dirs)
+}
+
+// checkWasm builds the generated packages for js/wasm, returning the output of the go command when they
+// do not build there.
+func checkWasm(ctx context.Context, cfg *config.Config) error {
+	cmd := exec.CommandContext(ctx, "go", append([]string{"build"}, generatedPackageDirs(cfg)...)...)
+	cmd.Env = append(os.Environ(), "GOOS=js", "GOARCH=wasm")
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("generated code does not build for js/wasm: %w\n%s", err, output)
+	}
+
+	return nil
+}
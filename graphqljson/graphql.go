@@ -33,7 +33,9 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"slices"
 	"strings"
+	"sync"
 
 	"github.com/99designs/gqlgen/graphql"
 )
@@ -45,10 +47,26 @@ import (
 //
 // The implementation is created on top of the JSON tokenizer available
 // in "encoding/json".Decoder.
-func UnmarshalData(data json.RawMessage, v any) error {
-	d := newDecoder(bytes.NewBuffer(data))
+//
+// Targets without a fixed shape, maps and empty interfaces, or slices and
+// pointers of them, receive the whole JSON value as encoding/json decodes it
+// into them: objects become map[string]any, lists []any and numbers float64.
+// They keep every key, __typename included, and are not matched against
+// inline fragments. v itself may be such a target.
+func UnmarshalData(data json.RawMessage, v any, options ...Option) error {
+	d := newDecoder(data)
+	defer releaseDecoder(d)
+
+	for _, option := range options {
+		option(d)
+	}
+
+	err := d.checkLimits(data)
+	if err != nil {
+		return err
+	}
 
-	err := d.Decode(v)
+	err = d.Decode(v)
 	if err != nil {
 		return fmt.Errorf(": %w", err)
 	}
@@ -90,16 +108,80 @@ type Decoder struct {
 	// to the __typename value seen at that depth. Used to discriminate which
 	// inline fragment pointer to initialize when multiple variants share a field name.
 	typenameByDepth map[int]string
+
+	// data is the complete input, used to slice out the raw JSON of objects
+	// captured into Unknown fields.
+	data []byte
+
+	// objectStarts is a stack of input offsets where each currently open object begins.
+	objectStarts []int64
+
+	duplicateKeyPolicy DuplicateKeyPolicy
+
+	caseInsensitiveFields bool
+
+	numberMode NumberMode
+
+	precisionLossError bool
+
+	maxDepth int
+
+	maxStringLength int
+
+	scalarUnmarshalers map[string]ScalarUnmarshaler
+
+	// objectKeys is a stack of the keys seen in each currently open object,
+	// kept unless duplicate keys are decoded with DuplicateKeyLastWins.
+	objectKeys []map[string]bool
+}
+
+// Unknown holds a union or interface member whose __typename is not covered by
+// any inline fragment of the struct it is decoded into.
+//
+// A struct opts in by declaring a *Unknown field with a "typenames" tag listing
+// the concrete type names it knows, e.g. `graphql:"-" typenames:"Article,Video"`.
+// The field stays nil unless __typename is selected and names another type.
+type Unknown struct {
+	Typename string
+	Raw      json.RawMessage
+}
+
+var unknownPtrType = reflect.TypeFor[*Unknown]()
+
+// decoderPool keeps the state of released decoders, so decoding does not allocate it for each response.
+var decoderPool = sync.Pool{
+	New: func() any {
+		return &Decoder{
+			typenameByDepth: make(map[int]string),
+		}
+	},
 }
 
-func newDecoder(r io.Reader) *Decoder {
-	jsonDecoder := json.NewDecoder(r)
+func newDecoder(data []byte) *Decoder {
+	jsonDecoder := json.NewDecoder(bytes.NewReader(data))
 	jsonDecoder.UseNumber()
 
-	return &Decoder{
-		jsonDecoder:     jsonDecoder,
-		typenameByDepth: make(map[int]string),
+	d := decoderPool.Get().(*Decoder)
+	d.jsonDecoder = jsonDecoder
+	d.data = data
+
+	return d
+}
+
+// releaseDecoder resets d and puts it back in the pool, without keeping references to the decoded data.
+func releaseDecoder(d *Decoder) {
+	clear(d.typenameByDepth)
+	clear(d.objectKeys)
+
+	*d = Decoder{
+		parseState:      d.parseState[:0],
+		vsFragTypes:     d.vsFragTypes[:0],
+		typenameByDepth: d.typenameByDepth,
+		objectStarts:    d.objectStarts[:0],
+		objectKeys:      d.objectKeys[:0],
 	}
+
+	decoderPool.Put(d)
 }
 
 // Decode decodes a single JSON value from d.tokenizer into v.
@@ -109,6 +191,15 @@ func (d *Decoder) Decode(v any) error {
 		return fmt.Errorf("cannot decode into non-pointer %T", v)
 	}
 
+	if isDynamicType(rv.Type().Elem()) {
+		data, err := d.dynamicValue()
+		if err != nil {
+			return fmt.Errorf(": %w", err)
+		}
+
+		return d.assignValue(data, rv.Elem())
+	}
+
 	d.vs = [][]reflect.Value{{rv.Elem()}}
 	d.vsFragTypes = []string{""}
 
@@ -140,8 +231,27 @@ func (d *Decoder) decode() error { //nolint:maintidx
 				return errors.New("unexpected non-key in JSON input")
 			}
 
+			if d.duplicateKeyPolicy != DuplicateKeyLastWins {
+				skip, err := d.skipDuplicateKey(d.objectKeys[len(d.objectKeys)-1], key)
+				if err != nil {
+					return err
+				}
+
+				if skip {
+					err = d.jsonDecoder.Decode(&json.RawMessage{})
+					if err != nil {
+						return fmt.Errorf(": %w", err)
+					}
+
+					continue
+				}
+			}
+
 			// The last matching one is the one considered
-			var matchingFieldValue *reflect.Value
+			var (
+				matchingFieldValue *reflect.Value
+				scalarUnmarshaler  ScalarUnmarshaler
+			)
 
 			// If this key is __typename, eagerly read its value so we can use it
 			// to discriminate which inline fragment pointers to initialize below.
@@ -160,6 +270,9 @@ func (d *Decoder) decode() error { //nolint:maintidx
 				}
 			}
 
+			// Keys of an object captured as Unknown may not exist in any struct.
+			unknownObject := d.isUnknownObject()
+
 			for i := range d.vs {
 				v := d.vs[i][len(d.vs[i])-1]
 				// If v is a nil pointer, check whether the key exists in the pointed-to
@@ -167,7 +280,7 @@ func (d *Decoder) decode() error { //nolint:maintidx
 				// When a __typename was seen, also require the fragment type to match.
 				if v.Kind() == reflect.Ptr && v.IsNil() && v.CanSet() {
 					if elemType := v.Type().Elem(); elemType.Kind() == reflect.Struct {
-						if fieldByGraphQLName(reflect.New(elemType).Elem(), key).IsValid() && d.shouldInitFragPtr(i) {
+						if d.fieldByGraphQLName(reflect.New(elemType).Elem(), key).IsValid() && d.shouldInitFragPtr(i) {
 							v.Set(reflect.New(elemType))
 						}
 					}
@@ -179,16 +292,17 @@ func (d *Decoder) decode() error { //nolint:maintidx
 
 				var f reflect.Value
 				if v.Kind() == reflect.Struct {
-					f = fieldByGraphQLName(v, key)
+					f = d.fieldByGraphQLName(v, key)
 					if f.IsValid() {
 						matchingFieldValue = &f
+						scalarUnmarshaler = d.scalarUnmarshaler(v.Type(), key)
 					}
 				}
 
 				d.vs[i] = append(d.vs[i], f)
 			}
 
-			if matchingFieldValue == nil {
+			if matchingFieldValue == nil && !unknownObject {
 				return fmt.Errorf("struct field for %q doesn't exist in any of %v places to unmarshal", key, len(d.vs))
 			}
 
@@ -196,22 +310,33 @@ func (d *Decoder) decode() error { //nolint:maintidx
 			// Read the next token, which should be the value.
 			// If it's of json.RawMessage or map type, decode the value.
 			// Skip reading if we already eagerly read the value above (for __typename).
-			if earlyReadTok != nil {
+			switch {
+			case earlyReadTok != nil:
 				tok = earlyReadTok
-			} else {
+			case matchingFieldValue == nil:
+				// Skip the whole value; it is kept in the Unknown raw JSON.
+				var data json.RawMessage
+
+				err = d.jsonDecoder.Decode(&data)
+				tok = data
+			case scalarUnmarshaler != nil:
+				var value any
+
+				value, err = d.dynamicValue()
+				tok = scalarToken{value: value, unmarshal: scalarUnmarshaler}
+			default:
 				switch matchingFieldValue.Type() {
 				case reflect.TypeFor[json.RawMessage]():
 					var data json.RawMessage
 
-					err = d.jsonDecoder.Decode(&data)
-					tok = data
-				case reflect.TypeFor[map[string]any]():
-					var data map[string]any
-
 					err = d.jsonDecoder.Decode(&data)
 					tok = data
 				default:
-					tok, err = d.jsonDecoder.Token()
+					if isDynamicType(matchingFieldValue.Type()) {
+						tok, err = d.dynamicValue()
+					} else {
+						tok, err = d.jsonDecoder.Token()
+					}
 				}
 			}
 
@@ -268,57 +393,43 @@ func (d *Decoder) decode() error { //nolint:maintidx
 			d.popAllVs()
 
 			continue
-		case string, json.Number, bool, json.RawMessage, map[string]any:
+		case string, json.Number, bool, json.RawMessage, map[string]any, []any:
 			for i := range d.vs {
 				v := d.vs[i][len(d.vs[i])-1]
 				if !v.IsValid() {
 					continue
 				}
 
-				// Initialize the pointer if it is nil
-				if v.Kind() == reflect.Ptr && v.IsNil() {
-					v.Set(reflect.New(v.Type().Elem()))
-				}
-
-				// Handle both pointer and non-pointer types
-				target := v
-				if v.Kind() == reflect.Ptr {
-					target = v.Elem()
+				if err := d.assignValue(tok, v); err != nil {
+					return err
 				}
+			}
 
-				// Check if the type of target (or its address) implements graphql.Unmarshaler
-				var (
-					unmarshaler graphql.Unmarshaler
-					ok          bool
-				)
+			d.popAllVs()
 
-				if target.CanAddr() {
-					unmarshaler, ok = target.Addr().Interface().(graphql.Unmarshaler)
-				} else if target.CanInterface() {
-					unmarshaler, ok = target.Interface().(graphql.Unmarshaler)
+		case scalarToken:
+			for i := range d.vs {
+				v := d.vs[i][len(d.vs[i])-1]
+				if !v.IsValid() {
+					continue
 				}
 
-				if ok {
-					err := unmarshaler.UnmarshalGQL(tok)
-					if err != nil {
-						return fmt.Errorf("unmarshal gql error: %w", err)
-					}
-				} else {
-					// Use the standard unmarshal method for non-custom types
-					err := unmarshalValue(tok, target)
-					if err != nil {
-						return fmt.Errorf(": %w", err)
-					}
+				if err := assignScalar(tok.value, v, tok.unmarshal); err != nil {
+					return err
 				}
 			}
 
 			d.popAllVs()
-
 		case json.Delim:
 			switch tok {
 			case '{':
 				// Start of object.
 				d.pushState(tok)
+				d.objectStarts = append(d.objectStarts, d.jsonDecoder.InputOffset()-1)
+
+				if d.duplicateKeyPolicy != DuplicateKeyLastWins {
+					d.objectKeys = append(d.objectKeys, make(map[string]bool))
+				}
 
 				frontier := make([]reflect.Value, len(d.vs)) // Places to look for GraphQL fragments/embedded structs.
 				for i := range d.vs {
@@ -343,14 +454,12 @@ func (d *Decoder) decode() error { //nolint:maintidx
 						continue
 					}
 
-					for i := range v.NumField() {
-						field := v.Type().Field(i)
-						if isGraphQLFragment(field) || field.Anonymous {
-							// Add GraphQL fragment or embedded struct.
-							d.vs = append(d.vs, []reflect.Value{v.Field(i)})
-							d.vsFragTypes = append(d.vsFragTypes, inlineFragmentType(field))
-							frontier = append(frontier, v.Field(i))
-						}
+					info := cachedStructInfo(v.Type())
+					for _, i := range info.nested {
+						// Add GraphQL fragment or embedded struct.
+						d.vs = append(d.vs, []reflect.Value{v.Field(i)})
+						d.vsFragTypes = append(d.vsFragTypes, info.fields[i].fragmentType)
+						frontier = append(frontier, v.Field(i))
 					}
 				}
 			case '[':
@@ -359,10 +468,16 @@ func (d *Decoder) decode() error { //nolint:maintidx
 
 				for i := range d.vs {
 					v := d.vs[i][len(d.vs[i])-1]
-					// TODO: Confirm this is needed, write a test case.
-					// if v.Kind() == reflect.Ptr && v.IsNil() {
-					//	v.Set(reflect.New(v.Type().Elem())) // v = new(T).
-					//}
+					// A pointer to a slice is a nullable list, allocated when it is not null.
+					if v.Kind() == reflect.Ptr && v.IsNil() && v.Type().Elem().Kind() == reflect.Slice && v.CanSet() {
+						v.Set(reflect.New(v.Type().Elem())) // v = new(T).
+					}
+
+					// The items of a NullableList are decoded in place of it.
+					if list, ok := addrInterface(v).(nullableList); ok {
+						v = list.itemsValue()
+						d.vs[i][len(d.vs[i])-1] = v
+					}
 
 					// Reset slice to empty (in case it had non-zero initial value).
 					if v.Kind() == reflect.Ptr {
@@ -378,7 +493,13 @@ func (d *Decoder) decode() error { //nolint:maintidx
 			case '}', ']':
 				// End of object or array.
 				if tok == '}' {
+					d.captureUnknown()
 					delete(d.typenameByDepth, d.objectDepth())
+					d.objectStarts = d.objectStarts[:len(d.objectStarts)-1]
+
+					if d.duplicateKeyPolicy != DuplicateKeyLastWins {
+						d.objectKeys = d.objectKeys[:len(d.objectKeys)-1]
+					}
 				}
 
 				d.popAllVs()
@@ -394,6 +515,15 @@ func (d *Decoder) decode() error { //nolint:maintidx
 	return nil
 }
 
+// addrInterface returns the pointer to v as an interface, or nil when v is not addressable.
+func addrInterface(v reflect.Value) any {
+	if !v.IsValid() || !v.CanAddr() {
+		return nil
+	}
+
+	return v.Addr().Interface()
+}
+
 // pushState pushes a new parse state s onto the stack.
 func (d *Decoder) pushState(s json.Delim) {
 	d.parseState = append(d.parseState, s)
@@ -435,34 +565,52 @@ func (d *Decoder) popAllVs() {
 
 // fieldByGraphQLName returns an exported struct field of struct v
 // that matches GraphQL name, or invalid reflect.Value if none found.
-func fieldByGraphQLName(v reflect.Value, name string) reflect.Value {
-	for i := range v.NumField() {
-		if v.Type().Field(i).PkgPath != "" {
-			// Skip unexported field.
-			continue
-		}
+// When the decoder matches names case-insensitively, an exact match is still preferred.
+func (d *Decoder) fieldByGraphQLName(v reflect.Value, name string) reflect.Value {
+	if f := fieldByGraphQLName(v, name, false); f.IsValid() || !d.caseInsensitiveFields {
+		return f
+	}
 
-		if hasGraphQLName(v.Type().Field(i), name) {
-			return v.Field(i)
-		}
+	return fieldByGraphQLName(v, name, true)
+}
+
+// fieldByGraphQLName returns an exported struct field of struct v
+// that matches GraphQL name, ignoring case when fold is set.
+func fieldByGraphQLName(v reflect.Value, name string, fold bool) reflect.Value {
+	if i := fieldIndex(v.Type(), name, fold); i != -1 {
+		return v.Field(i)
 	}
 
 	return reflect.Value{}
 }
 
-// hasGraphQLName reports whether struct field f has GraphQL name.
-func hasGraphQLName(f reflect.StructField, name string) bool {
+// fieldIndex returns the index of the exported field of struct type t that matches GraphQL name,
+// ignoring case when fold is set, or -1 if none found.
+func fieldIndex(t reflect.Type, name string, fold bool) int {
+	info := cachedStructInfo(t)
+	for i := range info.fields {
+		if info.fields[i].matches(name, fold) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// graphQLName returns the GraphQL name of struct field f, and whether it comes from its graphql tag.
+// Fragments have no name.
+func graphQLName(f reflect.StructField) (string, bool) {
 	value, ok := f.Tag.Lookup("graphql")
 	if !ok {
 		// TODO: caseconv package is relatively slow. Optimize it, then consider using it here.
 		// return caseconv.MixedCapsToLowerCamelCase(f.Name) == name
-		return strings.EqualFold(f.Name, name)
+		return f.Name, false
 	}
 
 	value = strings.TrimSpace(value) // TODO: Parse better.
 	if strings.HasPrefix(value, "...") {
 		// GraphQL fragment. It doesn't have a name.
-		return false
+		return "", true
 	}
 
 	if i := strings.Index(value, "("); i != -1 {
@@ -473,7 +621,7 @@ func hasGraphQLName(f reflect.StructField, name string) bool {
 		value = value[:i]
 	}
 
-	return strings.TrimSpace(value) == name
+	return strings.TrimSpace(value), true
 }
 
 // isGraphQLFragment reports whether struct field f is a GraphQL fragment.
@@ -488,16 +636,149 @@ func isGraphQLFragment(f reflect.StructField) bool {
 	return strings.HasPrefix(value, "...")
 }
 
-// unmarshalValue unmarshals JSON value into v.
+// assignValue stores the JSON value into v, through its graphql.Unmarshaler when it implements it.
+func (d *Decoder) assignValue(value json.Token, v reflect.Value) error {
+	value, err := d.convertNumbers(value, v.Type())
+	if err != nil {
+		return err
+	}
+
+	// Initialize the pointer if it is nil
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		v.Set(reflect.New(v.Type().Elem()))
+	}
+
+	// Handle both pointer and non-pointer types
+	target := v
+	if v.Kind() == reflect.Ptr {
+		target = v.Elem()
+	}
+
+	// Check if the type of target (or its address) implements graphql.Unmarshaler
+	var (
+		unmarshaler graphql.Unmarshaler
+		ok          bool
+	)
+
+	if target.CanAddr() {
+		unmarshaler, ok = target.Addr().Interface().(graphql.Unmarshaler)
+	} else if target.CanInterface() {
+		unmarshaler, ok = target.Interface().(graphql.Unmarshaler)
+	}
+
+	if ok {
+		err := unmarshaler.UnmarshalGQL(value)
+		if err != nil {
+			return fmt.Errorf("unmarshal gql error: %w", err)
+		}
+
+		return nil
+	}
+
+	// Use the standard unmarshal method for non-custom types
+	err = unmarshalValue(value, target, d.numberMode == NumberJSONNumber)
+	if err != nil {
+		return fmt.Errorf(": %w", err)
+	}
+
+	return nil
+}
+
+// dynamicValue reads the next JSON value as encoding/json decodes it into an empty interface,
+// except that numbers are kept as json.Number and objects follow the duplicate key policy.
+func (d *Decoder) dynamicValue() (any, error) {
+	tok, err := d.jsonDecoder.Token()
+	if err == io.EOF {
+		return nil, errors.New("unexpected end of JSON input")
+	} else if err != nil {
+		return nil, fmt.Errorf(": %w", err)
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		object := make(map[string]any)
+		seen := make(map[string]bool)
+
+		for d.jsonDecoder.More() {
+			keyTok, err := d.jsonDecoder.Token()
+			if err != nil {
+				return nil, fmt.Errorf(": %w", err)
+			}
+
+			key, ok := keyTok.(string)
+			if !ok {
+				return nil, errors.New("unexpected non-key in JSON input")
+			}
+
+			skip, err := d.skipDuplicateKey(seen, key)
+			if err != nil {
+				return nil, err
+			}
+
+			value, err := d.dynamicValue()
+			if err != nil {
+				return nil, err
+			}
+
+			if !skip {
+				object[key] = value
+			}
+		}
+
+		_, err = d.jsonDecoder.Token()
+		if err != nil {
+			return nil, fmt.Errorf(": %w", err)
+		}
+
+		return object, nil
+	case json.Delim('['):
+		list := make([]any, 0)
+
+		for d.jsonDecoder.More() {
+			value, err := d.dynamicValue()
+			if err != nil {
+				return nil, err
+			}
+
+			list = append(list, value)
+		}
+
+		_, err = d.jsonDecoder.Token()
+		if err != nil {
+			return nil, fmt.Errorf(": %w", err)
+		}
+
+		return list, nil
+	default:
+		return tok, nil
+	}
+}
+
+// isDynamicType reports whether t, once its pointers and slices are removed, is a map or an empty interface,
+// whose values are decoded as a whole rather than field by field.
+func isDynamicType(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+
+	return t.Kind() == reflect.Map || t.Kind() == reflect.Interface && t.NumMethod() == 0
+}
+
+// unmarshalValue unmarshals JSON value into v, decoding numbers into interfaces as json.Number when useNumber is set.
 // v must be addressable and not obtained by the use of unexported
 // struct fields, otherwise unmarshalValue will panic.
-func unmarshalValue(value json.Token, v reflect.Value) error {
+func unmarshalValue(value json.Token, v reflect.Value, useNumber bool) error {
 	b, err := json.Marshal(value) // TODO: Short-circuit (if profiling says it's worth it).
 	if err != nil {
 		return fmt.Errorf(": %w", err)
 	}
 
-	err = json.Unmarshal(b, v.Addr().Interface())
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	if useNumber {
+		decoder.UseNumber()
+	}
+
+	err = decoder.Decode(v.Addr().Interface())
 	if err != nil {
 		return fmt.Errorf(": %w", err)
 	}
@@ -536,6 +817,59 @@ func (d *Decoder) shouldInitFragPtr(i int) bool {
 	return fragType == typename
 }
 
+// captureUnknown fills the *Unknown fields of the objects being closed when the
+// __typename seen for them is not one of the type names the struct knows.
+func (d *Decoder) captureUnknown() {
+	for _, f := range d.unknownFields() {
+		start, end := d.objectStarts[len(d.objectStarts)-1], d.jsonDecoder.InputOffset()
+		raw := make(json.RawMessage, end-start)
+		copy(raw, d.data[start:end])
+
+		f.Set(reflect.ValueOf(&Unknown{Typename: d.typenameByDepth[d.objectDepth()], Raw: raw}))
+	}
+}
+
+// isUnknownObject reports whether the object currently being decoded has a
+// __typename that some target struct captures as Unknown.
+func (d *Decoder) isUnknownObject() bool {
+	return len(d.unknownFields()) > 0
+}
+
+// unknownFields returns the settable *Unknown fields of the structs on top of
+// d.vs that do not know the __typename seen at the current object depth.
+func (d *Decoder) unknownFields() []reflect.Value {
+	typename, ok := d.typenameByDepth[d.objectDepth()]
+	if !ok {
+		return nil
+	}
+
+	var fields []reflect.Value
+
+	for i := range d.vs {
+		v := d.vs[i][len(d.vs[i])-1]
+		if v.Kind() == reflect.Ptr {
+			v = v.Elem()
+		}
+
+		if v.Kind() != reflect.Struct {
+			continue
+		}
+
+		info := cachedStructInfo(v.Type())
+		for _, j := range info.unknowns {
+			if !v.Field(j).CanSet() {
+				continue
+			}
+
+			if !slices.Contains(info.fields[j].typenames, typename) {
+				fields = append(fields, v.Field(j))
+			}
+		}
+	}
+
+	return fields
+}
+
 // inlineFragmentType returns the concrete type name from a "... on TypeName" graphql
 // tag, or "" if the field is not a typed inline fragment.
 func inlineFragmentType(f reflect.StructField) string {
@@ -555,6 +555,58 @@ func TestUnmarshalGraphQL_pointerInlineFragmentWithTypename(t *testing.T) {
 	}
 }
 
+func TestUnmarshalGraphQL_unknownTypename(t *testing.T) {
+	t.Parallel()
+
+	type Article struct {
+		Title string
+	}
+
+	type Video struct {
+		Duration int
+	}
+
+	type SearchResult struct {
+		Typename *string              `graphql:"__typename"`
+		Article  *Article             `graphql:"... on Article"`
+		Video    *Video               `graphql:"... on Video"`
+		Unknown  *graphqljson.Unknown `graphql:"-" typenames:"Article,Video"`
+	}
+
+	type query struct {
+		Search []SearchResult
+	}
+
+	var got query
+
+	err := graphqljson.UnmarshalData([]byte(`{
+		"search": [
+			{"__typename": "Article", "title": "Hello"},
+			{"__typename": "Podcast", "host": {"name": "Bob"}},
+			{"__typename": "Video", "duration": 42}
+		]
+	}`), &got)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	article, podcast, video := "Article", "Podcast", "Video"
+
+	want := query{
+		Search: []SearchResult{
+			{Typename: &article, Article: &Article{Title: "Hello"}},
+			{Typename: &podcast, Unknown: &graphqljson.Unknown{
+				Typename: "Podcast",
+				Raw:      json.RawMessage(`{"__typename": "Podcast", "host": {"name": "Bob"}}`),
+			}},
+			{Typename: &video, Video: &Video{Duration: 42}},
+		},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Error(diff)
+	}
+}
+
 // Issue https://github.com/shurcooL/githubv4/issues/18.
 func TestUnmarshalGraphQL_arrayInsideInlineFragment(t *testing.T) {
 	t.Parallel()
@@ -770,6 +822,86 @@ func TestUnmarshalGraphQL_map(t *testing.T) {
 	}
 }
 
+func TestUnmarshalGraphQL_mapTarget(t *testing.T) {
+	t.Parallel()
+
+	var got map[string]any
+
+	err := graphqljson.UnmarshalData([]byte(`{
+		"node": {"__typename": "User", "id": "1", "age": 42},
+		"tags": ["a", "b"],
+		"deleted": null
+	}`), &got)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]any{
+		"node":    map[string]any{"__typename": "User", "id": "1", "age": float64(42)},
+		"tags":    []any{"a", "b"},
+		"deleted": nil,
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestUnmarshalGraphQL_anyTarget(t *testing.T) {
+	t.Parallel()
+
+	var got any
+
+	err := graphqljson.UnmarshalData([]byte(`{"users": [{"id": "1"}]}`), &got)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]any{
+		"users": []any{map[string]any{"id": "1"}},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestUnmarshalGraphQL_dynamicFields(t *testing.T) {
+	t.Parallel()
+
+	type query struct {
+		Typename string `graphql:"__typename"`
+		Payload  any
+		Items    []map[string]any
+		Extra    *map[string]any
+		Scalar   any
+		Null     any
+	}
+
+	var got query
+
+	err := graphqljson.UnmarshalData([]byte(`{
+		"__typename": "Query",
+		"payload": {"nested": [1, {"__typename": "Tag", "name": "go"}]},
+		"items": [{"id": "1"}, {"id": "2"}],
+		"extra": {"key": "value"},
+		"scalar": "text",
+		"null": null
+	}`), &got)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := query{
+		Typename: "Query",
+		Payload:  map[string]any{"nested": []any{float64(1), map[string]any{"__typename": "Tag", "name": "go"}}},
+		Items:    []map[string]any{{"id": "1"}, {"id": "2"}},
+		Extra:    &map[string]any{"key": "value"},
+		Scalar:   "text",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Error(diff)
+	}
+}
+
 type Number int64
 
 const (
@@ -1065,3 +1197,75 @@ func TestUnmarshalGraphQL_nestedFragmentSpread_multiLevel(t *testing.T) {
 		t.Errorf("mismatch:\n%s", diff)
 	}
 }
+
+func TestUnmarshalGraphQL_nullableLists(t *testing.T) {
+	t.Parallel()
+
+	type user struct {
+		Name string `graphql:"name"`
+	}
+
+	type query struct {
+		PointerNull   *[]*user                                                   `graphql:"pointerNull"`
+		PointerEmpty  *[]*user                                                   `graphql:"pointerEmpty"`
+		Pointer       *[]*user                                                   `graphql:"pointer"`
+		WrapperNull   graphqljson.NullableList[*user]                            `graphql:"wrapperNull"`
+		WrapperEmpty  graphqljson.NullableList[*user]                            `graphql:"wrapperEmpty"`
+		Wrapper       graphqljson.NullableList[*user]                            `graphql:"wrapper"`
+		WrapperNested graphqljson.NullableList[*string]                          `graphql:"wrapperNested"`
+		Groups        graphqljson.NullableList[graphqljson.NullableList[string]] `graphql:"groups"`
+	}
+
+	var got query
+
+	err := graphqljson.UnmarshalData([]byte(`{
+		"pointerNull": null,
+		"pointerEmpty": [],
+		"pointer": [{"name": "a"}, null],
+		"wrapperNull": null,
+		"wrapperEmpty": [],
+		"wrapper": [{"name": "b"}],
+		"wrapperNested": ["c", null],
+		"groups": [["d"], null]
+	}`), &got)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := "c"
+	want := query{
+		PointerEmpty:  &[]*user{},
+		Pointer:       &[]*user{{Name: "a"}, nil},
+		WrapperEmpty:  graphqljson.NewNullableList[*user](),
+		Wrapper:       graphqljson.NewNullableList(&user{Name: "b"}),
+		WrapperNested: graphqljson.NewNullableList(&c, nil),
+		Groups:        graphqljson.NewNullableList(graphqljson.NewNullableList("d"), graphqljson.NullableList[string]{}),
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestNullableList_JSON(t *testing.T) {
+	t.Parallel()
+
+	for _, data := range []string{`null`, `[]`, `["a","b"]`} {
+		var list graphqljson.NullableList[string]
+		if err := json.Unmarshal([]byte(data), &list); err != nil {
+			t.Fatal(err)
+		}
+
+		if list.Valid != (data != "null") {
+			t.Errorf("%s: Valid = %v", data, list.Valid)
+		}
+
+		encoded, err := json.Marshal(list)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(encoded) != data {
+			t.Errorf("json.Marshal(%s) = %s", data, encoded)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package graphqljson
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrLimitExceeded is returned by UnmarshalData and CheckLimits for data exceeding a limit set by
+// WithMaxDepth or WithMaxStringLength.
+var ErrLimitExceeded = errors.New("limit exceeded")
+
+// WithMaxDepth limits the nesting of objects and lists of the decoded data to depth levels,
+// so deeply nested data from an untrusted server fails before being decoded. Zero means no limit.
+func WithMaxDepth(depth int) Option {
+	return func(d *Decoder) {
+		d.maxDepth = depth
+	}
+}
+
+// WithMaxStringLength limits the strings of the decoded data, object keys included, to length bytes
+// as they are encoded in the JSON input. Zero means no limit.
+func WithMaxStringLength(length int) Option {
+	return func(d *Decoder) {
+		d.maxStringLength = length
+	}
+}
+
+// CheckLimits returns ErrLimitExceeded when data exceeds the limits set by options, without decoding it.
+// Options other than limits are ignored. data needs not be valid JSON, its errors are left to the decoding.
+func CheckLimits(data []byte, options ...Option) error {
+	var d Decoder
+	for _, option := range options {
+		option(&d)
+	}
+
+	return d.checkLimits(data)
+}
+
+// checkLimits scans data in a single pass, counting the nesting and the length of strings.
+func (d *Decoder) checkLimits(data []byte) error {
+	if d.maxDepth <= 0 && d.maxStringLength <= 0 {
+		return nil
+	}
+
+	depth, stringStart, inString := 0, 0, false
+
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+
+		if inString {
+			switch c {
+			case '\\':
+				i++
+			case '"':
+				inString = false
+
+				if d.maxStringLength > 0 && i-stringStart > d.maxStringLength {
+					return fmt.Errorf("%w: string of %d bytes at offset %d is longer than %d", ErrLimitExceeded, i-stringStart, stringStart, d.maxStringLength)
+				}
+			}
+
+			continue
+		}
+
+		switch c {
+		case '"':
+			inString, stringStart = true, i+1
+		case '{', '[':
+			depth++
+
+			if d.maxDepth > 0 && depth > d.maxDepth {
+				return fmt.Errorf("%w: nesting at offset %d is deeper than %d", ErrLimitExceeded, i, d.maxDepth)
+			}
+		case '}', ']':
+			depth--
+		}
+	}
+
+	// an unterminated string is reported by the decoding, unless it is already too long
+	if inString && d.maxStringLength > 0 && len(data)-stringStart > d.maxStringLength {
+		return fmt.Errorf("%w: string of %d bytes at offset %d is longer than %d", ErrLimitExceeded, len(data)-stringStart, stringStart, d.maxStringLength)
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package graphqljson_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gqlgo/gqlgenc/graphqljson"
+)
+
+func TestUnmarshalData_limits(t *testing.T) {
+	t.Parallel()
+
+	type query struct {
+		Me struct {
+			Name    string
+			Friends []struct {
+				Name string
+			}
+		}
+		Extra any
+	}
+
+	tests := []struct {
+		name    string
+		data    string
+		options []graphqljson.Option
+		wantErr bool
+	}{
+		{
+			name:    "no limits by default",
+			data:    `{"me": {"name": "` + strings.Repeat("a", 1<<16) + `"}, "extra": ` + strings.Repeat("[", 512) + strings.Repeat("]", 512) + `}`,
+			wantErr: false,
+		},
+		{
+			name:    "depth within the limit",
+			data:    `{"me": {"friends": [{"name": "a"}]}}`,
+			options: []graphqljson.Option{graphqljson.WithMaxDepth(4)},
+			wantErr: false,
+		},
+		{
+			name:    "depth over the limit",
+			data:    `{"me": {"friends": [{"name": "a"}]}}`,
+			options: []graphqljson.Option{graphqljson.WithMaxDepth(3)},
+			wantErr: true,
+		},
+		{
+			name:    "depth of dynamic values",
+			data:    `{"extra": [[[[]]]]}`,
+			options: []graphqljson.Option{graphqljson.WithMaxDepth(4)},
+			wantErr: true,
+		},
+		{
+			name:    "brackets in strings are not nesting",
+			data:    `{"me": {"name": "[[[{{{"}}`,
+			options: []graphqljson.Option{graphqljson.WithMaxDepth(2)},
+			wantErr: false,
+		},
+		{
+			name:    "string within the limit",
+			data:    `{"me": {"name": "a\"b"}}`,
+			options: []graphqljson.Option{graphqljson.WithMaxStringLength(4)},
+			wantErr: false,
+		},
+		{
+			name:    "string over the limit",
+			data:    `{"me": {"name": "abcde"}}`,
+			options: []graphqljson.Option{graphqljson.WithMaxStringLength(4)},
+			wantErr: true,
+		},
+		{
+			name:    "keys are strings",
+			data:    `{"extra": {"abcde": 1}}`,
+			options: []graphqljson.Option{graphqljson.WithMaxStringLength(4)},
+			wantErr: true,
+		},
+		{
+			name:    "unterminated string over the limit",
+			data:    `{"me": {"name": "abcde`,
+			options: []graphqljson.Option{graphqljson.WithMaxStringLength(4)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got query
+
+			err := graphqljson.UnmarshalData([]byte(tt.data), &got, tt.options...)
+			if tt.wantErr != errors.Is(err, graphqljson.ErrLimitExceeded) {
+				t.Errorf("UnmarshalData() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr && err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func FuzzUnmarshalData(f *testing.F) {
+	for _, data := range []string{
+		`{"me": {"name": "Luke", "friends": [{"name": "Leia"}, null]}}`,
+		`{"node": {"__typename": "Droid", "name": "R2-D2"}}`,
+		`{"node": {"__typename": "User", "user": {"name": "Luke"}}}`,
+		`{"extra": {"a": [1, 2.5, "b", true, null, {"c": {}}]}}`,
+		`{"me": {"name": "a", "name": "b"}}`,
+		`{"me": [`,
+		`[[[[]]]]`,
+	} {
+		f.Add([]byte(data))
+	}
+
+	type query struct {
+		Me *struct {
+			Name    string
+			Friends []*struct {
+				Name string
+			}
+		}
+		Node struct {
+			Typename string `graphql:"__typename"`
+			User     *struct {
+				Name string
+			} `graphql:"... on User"`
+			Unknown *graphqljson.Unknown `graphql:"-" typenames:"User"`
+		}
+		Extra map[string]any
+	}
+
+	options := []graphqljson.Option{
+		graphqljson.WithMaxDepth(32),
+		graphqljson.WithMaxStringLength(1024),
+		graphqljson.WithDuplicateKeyPolicy(graphqljson.DuplicateKeyFirstWins),
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// must not panic, whatever the data
+		var got query
+		_ = graphqljson.UnmarshalData(data, &got, options...)
+
+		var dynamic any
+		_ = graphqljson.UnmarshalData(data, &dynamic)
+	})
+}
@@ -0,0 +1,60 @@
+package graphqljson
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// NullableList is a GraphQL list that may be null, telling a null list from an empty one without a pointer.
+// UnmarshalData and encoding/json decode null as a NullableList that is not Valid.
+type NullableList[T any] struct {
+	Items []T
+	// Valid is false when the list is null.
+	Valid bool
+}
+
+// NewNullableList returns the non-null list of items.
+func NewNullableList[T any](items ...T) NullableList[T] {
+	if items == nil {
+		items = []T{}
+	}
+
+	return NullableList[T]{Items: items, Valid: true}
+}
+
+// MarshalJSON encodes the list as a JSON array, or null when it is not valid.
+func (l NullableList[T]) MarshalJSON() ([]byte, error) {
+	if !l.Valid {
+		return []byte("null"), nil
+	}
+
+	if l.Items == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal(l.Items)
+}
+
+// UnmarshalJSON decodes a JSON array, or null as a list that is not valid.
+func (l *NullableList[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+
+	*l = NullableList[T]{Items: items, Valid: items != nil}
+
+	return nil
+}
+
+// itemsValue marks the list as not null and returns its items, for UnmarshalData to decode the array into.
+func (l *NullableList[T]) itemsValue() reflect.Value {
+	l.Valid = true
+
+	return reflect.ValueOf(&l.Items).Elem()
+}
+
+// nullableList is implemented by the pointers to NullableList.
+type nullableList interface {
+	itemsValue() reflect.Value
+}
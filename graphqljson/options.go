@@ -0,0 +1,260 @@
+package graphqljson
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+)
+
+// Option configures how UnmarshalData decodes.
+type Option func(*Decoder)
+
+// DuplicateKeyPolicy decides how an object having the same key more than once is decoded.
+type DuplicateKeyPolicy int
+
+const (
+	// DuplicateKeyLastWins keeps the value of the last occurrence of a key, like encoding/json. It is the default.
+	DuplicateKeyLastWins DuplicateKeyPolicy = iota
+	// DuplicateKeyFirstWins keeps the value of the first occurrence of a key and skips the others.
+	DuplicateKeyFirstWins
+	// DuplicateKeyError fails the decoding with ErrDuplicateKey.
+	DuplicateKeyError
+)
+
+// ErrDuplicateKey is returned by UnmarshalData for an object having the same key twice under DuplicateKeyError.
+var ErrDuplicateKey = errors.New("duplicate key")
+
+// WithDuplicateKeyPolicy sets how objects having the same key more than once are decoded,
+// including the objects decoded into map and any targets.
+func WithDuplicateKeyPolicy(policy DuplicateKeyPolicy) Option {
+	return func(d *Decoder) {
+		d.duplicateKeyPolicy = policy
+	}
+}
+
+// WithCaseInsensitiveFields matches the keys of objects to the GraphQL names of struct fields ignoring case,
+// for responses whose field casing varies between server versions. A field whose name matches exactly is
+// still preferred over one matching only when ignoring case.
+func WithCaseInsensitiveFields() Option {
+	return func(d *Decoder) {
+		d.caseInsensitiveFields = true
+	}
+}
+
+// skipDuplicateKey applies the duplicate key policy to key, a key of the object being decoded
+// whose keys are seen, reporting whether its value must be skipped.
+func (d *Decoder) skipDuplicateKey(seen map[string]bool, key string) (bool, error) {
+	if d.duplicateKeyPolicy == DuplicateKeyLastWins {
+		return false, nil
+	}
+
+	if !seen[key] {
+		seen[key] = true
+
+		return false, nil
+	}
+
+	if d.duplicateKeyPolicy == DuplicateKeyError {
+		return false, fmt.Errorf("%w %q", ErrDuplicateKey, key)
+	}
+
+	return true, nil
+}
+
+// NumberMode decides how numbers are decoded into interface targets.
+type NumberMode int
+
+const (
+	// NumberFloat64 decodes numbers into interface targets as float64, like encoding/json. It is the default.
+	NumberFloat64 NumberMode = iota
+	// NumberJSONNumber decodes numbers into interface targets as json.Number, keeping their literal.
+	NumberJSONNumber
+	// NumberString decodes numbers into interface targets as the string of their literal. Fields of string
+	// types accept numbers too, for IDs or Long scalars sent as numbers too large for a float64.
+	NumberString
+)
+
+// ErrPrecisionLoss is returned by UnmarshalData under WithPrecisionLossError for an integer a float64 cannot hold exactly.
+var ErrPrecisionLoss = errors.New("number loses precision")
+
+// WithNumberMode sets how numbers are decoded into interface targets.
+func WithNumberMode(mode NumberMode) Option {
+	return func(d *Decoder) {
+		d.numberMode = mode
+	}
+}
+
+// WithPrecisionLossError fails the decoding with ErrPrecisionLoss when an integer is decoded into a float64,
+// a float64 field or an interface target under NumberFloat64, and the float64 cannot hold it exactly.
+func WithPrecisionLossError() Option {
+	return func(d *Decoder) {
+		d.precisionLossError = true
+	}
+}
+
+// convertNumbers applies the number options to the numbers of value, a JSON value to be decoded into a target of type t.
+func (d *Decoder) convertNumbers(value any, t reflect.Type) (any, error) {
+	if d.numberMode == NumberFloat64 && !d.precisionLossError {
+		return value, nil
+	}
+
+	leaf := leafKind(t)
+
+	switch {
+	case d.numberMode == NumberString && (leaf == reflect.Interface || leaf == reflect.String):
+		return mapNumbers(value, func(n json.Number) (any, error) {
+			return string(n), nil
+		})
+	case d.precisionLossError && (leaf == reflect.Float64 || leaf == reflect.Interface && d.numberMode == NumberFloat64):
+		return mapNumbers(value, func(n json.Number) (any, error) {
+			return n, checkPrecision(n)
+		})
+	default:
+		return value, nil
+	}
+}
+
+// leafKind returns the kind of the values held by t through its pointers, slices, arrays and maps.
+func leafKind(t reflect.Type) reflect.Kind {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array || t.Kind() == reflect.Map {
+		t = t.Elem()
+	}
+
+	return t.Kind()
+}
+
+// mapNumbers returns a copy of the JSON value with its numbers replaced by f.
+func mapNumbers(value any, f func(json.Number) (any, error)) (any, error) {
+	switch value := value.(type) {
+	case json.Number:
+		return f(value)
+	case map[string]any:
+		mapped := make(map[string]any, len(value))
+
+		for key, element := range value {
+			m, err := mapNumbers(element, f)
+			if err != nil {
+				return nil, err
+			}
+
+			mapped[key] = m
+		}
+
+		return mapped, nil
+	case []any:
+		mapped := make([]any, len(value))
+
+		for i, element := range value {
+			m, err := mapNumbers(element, f)
+			if err != nil {
+				return nil, err
+			}
+
+			mapped[i] = m
+		}
+
+		return mapped, nil
+	default:
+		return value, nil
+	}
+}
+
+// checkPrecision returns ErrPrecisionLoss when n is an integer a float64 cannot hold exactly.
+func checkPrecision(n json.Number) error {
+	literal := string(n)
+	if strings.ContainsAny(literal, ".eE") {
+		return nil
+	}
+
+	i, ok := new(big.Int).SetString(literal, 10)
+	if !ok {
+		return nil
+	}
+
+	if _, accuracy := new(big.Float).SetInt(i).Float64(); accuracy != big.Exact {
+		return fmt.Errorf("%w: %s", ErrPrecisionLoss, literal)
+	}
+
+	return nil
+}
+
+// ScalarUnmarshaler decodes data, the JSON value of a custom scalar, into v, a pointer to the Go value of the field.
+type ScalarUnmarshaler func(data []byte, v any) error
+
+// WithScalarUnmarshalers decodes the fields whose scalar tag names a custom scalar of unmarshalers with its
+// ScalarUnmarshaler instead of their Go types, e.g. `graphql:"id" scalar:"UUID"`. The unmarshalers are called
+// once per element of lists and never for null, which leaves the field zero.
+func WithScalarUnmarshalers(unmarshalers map[string]ScalarUnmarshaler) Option {
+	return func(d *Decoder) {
+		d.scalarUnmarshalers = unmarshalers
+	}
+}
+
+// scalarUnmarshaler returns the ScalarUnmarshaler of the field of struct type t that matches GraphQL name,
+// or nil if the field is not of a custom scalar having one.
+func (d *Decoder) scalarUnmarshaler(t reflect.Type, name string) ScalarUnmarshaler {
+	if len(d.scalarUnmarshalers) == 0 {
+		return nil
+	}
+
+	i := fieldIndex(t, name, false)
+	if i == -1 && d.caseInsensitiveFields {
+		i = fieldIndex(t, name, true)
+	}
+
+	if i == -1 {
+		return nil
+	}
+
+	return d.scalarUnmarshalers[cachedStructInfo(t).fields[i].scalar]
+}
+
+// scalarToken is the JSON value of a field of a custom scalar, decoded by its ScalarUnmarshaler.
+type scalarToken struct {
+	value     any
+	unmarshal ScalarUnmarshaler
+}
+
+// assignScalar stores value, a JSON value as returned by dynamicValue, into v through unmarshal,
+// following the pointers of v and decoding lists element by element.
+func assignScalar(value any, v reflect.Value, unmarshal ScalarUnmarshaler) error {
+	if value == nil {
+		v.Set(reflect.Zero(v.Type()))
+
+		return nil
+	}
+
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+
+		return assignScalar(value, v.Elem(), unmarshal)
+	}
+
+	if list, ok := value.([]any); ok && v.Kind() == reflect.Slice {
+		v.Set(reflect.MakeSlice(v.Type(), len(list), len(list)))
+
+		for i, element := range list {
+			if err := assignScalar(element, v.Index(i), unmarshal); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf(": %w", err)
+	}
+
+	if err := unmarshal(data, v.Addr().Interface()); err != nil {
+		return fmt.Errorf("unmarshal scalar: %w", err)
+	}
+
+	return nil
+}
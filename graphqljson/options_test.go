@@ -0,0 +1,311 @@
+package graphqljson_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/gqlgo/gqlgenc/graphqljson"
+)
+
+func TestUnmarshalData_duplicateKeyPolicy(t *testing.T) {
+	t.Parallel()
+
+	type query struct {
+		Me struct {
+			Name string
+		}
+		Extra map[string]any
+	}
+
+	data := []byte(`{
+		"me": {"name": "first", "name": "last"},
+		"extra": {"key": "first", "key": "last"}
+	}`)
+
+	t.Run("last wins by default", func(t *testing.T) {
+		t.Parallel()
+
+		var got query
+		if err := graphqljson.UnmarshalData(data, &got); err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff("last", got.Me.Name); diff != "" {
+			t.Error(diff)
+		}
+
+		if diff := cmp.Diff(map[string]any{"key": "last"}, got.Extra); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("first wins", func(t *testing.T) {
+		t.Parallel()
+
+		var got query
+		if err := graphqljson.UnmarshalData(data, &got, graphqljson.WithDuplicateKeyPolicy(graphqljson.DuplicateKeyFirstWins)); err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff("first", got.Me.Name); diff != "" {
+			t.Error(diff)
+		}
+
+		if diff := cmp.Diff(map[string]any{"key": "first"}, got.Extra); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("first wins skips duplicated objects", func(t *testing.T) {
+		t.Parallel()
+
+		var got query
+		if err := graphqljson.UnmarshalData([]byte(`{"me": {"name": "first"}, "me": {"name": "last"}}`), &got, graphqljson.WithDuplicateKeyPolicy(graphqljson.DuplicateKeyFirstWins)); err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff("first", got.Me.Name); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Parallel()
+
+		var got query
+
+		err := graphqljson.UnmarshalData(data, &got, graphqljson.WithDuplicateKeyPolicy(graphqljson.DuplicateKeyError))
+		if !errors.Is(err, graphqljson.ErrDuplicateKey) {
+			t.Fatalf("expected ErrDuplicateKey, got %v", err)
+		}
+	})
+
+	t.Run("error in map targets", func(t *testing.T) {
+		t.Parallel()
+
+		var got map[string]any
+
+		err := graphqljson.UnmarshalData([]byte(`{"a": {"b": 1, "b": 2}}`), &got, graphqljson.WithDuplicateKeyPolicy(graphqljson.DuplicateKeyError))
+		if !errors.Is(err, graphqljson.ErrDuplicateKey) {
+			t.Fatalf("expected ErrDuplicateKey, got %v", err)
+		}
+	})
+
+	t.Run("same key in different objects", func(t *testing.T) {
+		t.Parallel()
+
+		type users struct {
+			Users []struct {
+				ID string
+			}
+		}
+
+		var got users
+		if err := graphqljson.UnmarshalData([]byte(`{"users": [{"id": "1"}, {"id": "2"}]}`), &got, graphqljson.WithDuplicateKeyPolicy(graphqljson.DuplicateKeyError)); err != nil {
+			t.Fatal(err)
+		}
+
+		if len(got.Users) != 2 || got.Users[1].ID != "2" {
+			t.Errorf("unexpected users %+v", got.Users)
+		}
+	})
+}
+
+func TestUnmarshalData_caseInsensitiveFields(t *testing.T) {
+	t.Parallel()
+
+	type query struct {
+		UserID  string `graphql:"userId"`
+		UserIDs string `graphql:"userID"`
+		Name    string `graphql:"name"`
+	}
+
+	data := []byte(`{"userID": "exact", "USERID": "folded", "Name": "Luke"}`)
+
+	t.Run("exact by default", func(t *testing.T) {
+		t.Parallel()
+
+		var got query
+
+		err := graphqljson.UnmarshalData(data, &got)
+		if err == nil {
+			t.Fatal("expected an error for the unknown keys")
+		}
+	})
+
+	t.Run("ignoring case", func(t *testing.T) {
+		t.Parallel()
+
+		var got query
+		if err := graphqljson.UnmarshalData(data, &got, graphqljson.WithCaseInsensitiveFields()); err != nil {
+			t.Fatal(err)
+		}
+
+		want := query{
+			UserID:  "folded",
+			UserIDs: "exact",
+			Name:    "Luke",
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Error(diff)
+		}
+	})
+}
+
+func TestUnmarshalData_numbers(t *testing.T) {
+	t.Parallel()
+
+	type query struct {
+		ID      string
+		Count   int64
+		Ratio   float64
+		Payload any
+		Values  map[string]any
+	}
+
+	data := []byte(`{
+		"id": 9007199254740993,
+		"count": 9007199254740993,
+		"ratio": 0.5,
+		"payload": 9007199254740993,
+		"values": {"big": 9007199254740993, "list": [1.5]}
+	}`)
+
+	t.Run("float64 by default", func(t *testing.T) {
+		t.Parallel()
+
+		var got map[string]any
+		if err := graphqljson.UnmarshalData([]byte(`{"n": 1}`), &got); err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(map[string]any{"n": float64(1)}, got); diff != "" {
+			t.Error(diff)
+		}
+
+		var q query
+		if err := graphqljson.UnmarshalData(data, &q); err == nil {
+			t.Fatal("expected an error decoding a number into a string")
+		}
+	})
+
+	t.Run("json.Number", func(t *testing.T) {
+		t.Parallel()
+
+		var got map[string]any
+		if err := graphqljson.UnmarshalData([]byte(`{"n": 9007199254740993, "list": [1.5]}`), &got, graphqljson.WithNumberMode(graphqljson.NumberJSONNumber)); err != nil {
+			t.Fatal(err)
+		}
+
+		want := map[string]any{"n": json.Number("9007199254740993"), "list": []any{json.Number("1.5")}}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("string", func(t *testing.T) {
+		t.Parallel()
+
+		var got query
+		if err := graphqljson.UnmarshalData(data, &got, graphqljson.WithNumberMode(graphqljson.NumberString)); err != nil {
+			t.Fatal(err)
+		}
+
+		want := query{
+			ID:      "9007199254740993",
+			Count:   9007199254740993,
+			Ratio:   0.5,
+			Payload: "9007199254740993",
+			Values:  map[string]any{"big": "9007199254740993", "list": []any{"1.5"}},
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("precision loss", func(t *testing.T) {
+		t.Parallel()
+
+		type floats struct {
+			Ratio   float64
+			Payload any
+		}
+
+		var got floats
+		if err := graphqljson.UnmarshalData([]byte(`{"ratio": 9007199254740992, "payload": {"n": 0.1}}`), &got, graphqljson.WithPrecisionLossError()); err != nil {
+			t.Fatal(err)
+		}
+
+		err := graphqljson.UnmarshalData([]byte(`{"ratio": 9007199254740993}`), &got, graphqljson.WithPrecisionLossError())
+		if !errors.Is(err, graphqljson.ErrPrecisionLoss) {
+			t.Fatalf("expected ErrPrecisionLoss, got %v", err)
+		}
+
+		err = graphqljson.UnmarshalData([]byte(`{"payload": {"n": [9007199254740993]}}`), &got, graphqljson.WithPrecisionLossError())
+		if !errors.Is(err, graphqljson.ErrPrecisionLoss) {
+			t.Fatalf("expected ErrPrecisionLoss, got %v", err)
+		}
+
+		var count struct {
+			Count int64
+		}
+		if err := graphqljson.UnmarshalData([]byte(`{"count": 9007199254740993}`), &count, graphqljson.WithPrecisionLossError()); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestUnmarshalData_scalarUnmarshalers(t *testing.T) {
+	t.Parallel()
+
+	type cents int64
+
+	type query struct {
+		Price    cents    `graphql:"price" scalar:"Money"`
+		Discount *cents   `graphql:"discount" scalar:"Money"`
+		History  []*cents `graphql:"history" scalar:"Money"`
+		Untagged string   `graphql:"untagged"`
+	}
+
+	unmarshalers := map[string]graphqljson.ScalarUnmarshaler{
+		"Money": func(data []byte, v any) error {
+			var amount json.Number
+			if err := json.Unmarshal(data, &amount); err != nil {
+				return err
+			}
+
+			f, err := amount.Float64()
+			if err != nil {
+				return err
+			}
+
+			*v.(*cents) = cents(f * 100)
+
+			return nil
+		},
+	}
+
+	var got query
+	if err := graphqljson.UnmarshalData([]byte(`{"price": 1.5, "discount": null, "history": [2, null], "untagged": "1.5"}`), &got, graphqljson.WithScalarUnmarshalers(unmarshalers)); err != nil {
+		t.Fatal(err)
+	}
+
+	two := cents(200)
+
+	want := query{
+		Price:    150,
+		History:  []*cents{&two, nil},
+		Untagged: "1.5",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+
+	err := graphqljson.UnmarshalData([]byte(`{"price": "free"}`), &got, graphqljson.WithScalarUnmarshalers(unmarshalers))
+	if err == nil {
+		t.Fatal("expected the error of the unmarshaler")
+	}
+}
@@ -0,0 +1,85 @@
+package graphqljson
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// structInfo is the reflection metadata of a struct type the decoder needs, computed once per type
+// so decoding many responses into the same types does not parse struct tags again.
+type structInfo struct {
+	fields []fieldInfo
+	// nested are the indexes of the fragment and embedded fields, decoded from the same object as the struct.
+	nested []int
+	// unknowns are the indexes of the *Unknown fields.
+	unknowns []int
+}
+
+// fieldInfo is the metadata of a struct field.
+type fieldInfo struct {
+	// name is the GraphQL name of an exported field, "" for fragments.
+	name string
+	// tagged is set when name comes from the graphql tag, otherwise name is the Go name, matched ignoring case.
+	tagged bool
+	// fragmentType is the type condition of an inline fragment field.
+	fragmentType string
+	// typenames are the type names known by an *Unknown field.
+	typenames []string
+	// scalar is the custom scalar named by the scalar tag of the field, decoded by its ScalarUnmarshaler.
+	scalar string
+}
+
+// structInfos caches the *structInfo of each struct type.
+var structInfos sync.Map
+
+// cachedStructInfo returns the metadata of the struct type t.
+func cachedStructInfo(t reflect.Type) *structInfo {
+	if info, ok := structInfos.Load(t); ok {
+		return info.(*structInfo)
+	}
+
+	info, _ := structInfos.LoadOrStore(t, buildStructInfo(t))
+
+	return info.(*structInfo)
+}
+
+func buildStructInfo(t reflect.Type) *structInfo {
+	info := &structInfo{
+		fields: make([]fieldInfo, t.NumField()),
+	}
+
+	for i := range t.NumField() {
+		field := t.Field(i)
+
+		if field.PkgPath == "" {
+			info.fields[i].name, info.fields[i].tagged = graphQLName(field)
+			info.fields[i].scalar = field.Tag.Get("scalar")
+		}
+
+		if isGraphQLFragment(field) || field.Anonymous {
+			info.nested = append(info.nested, i)
+			info.fields[i].fragmentType = inlineFragmentType(field)
+		}
+
+		if field.Type == unknownPtrType {
+			info.unknowns = append(info.unknowns, i)
+			info.fields[i].typenames = strings.Split(field.Tag.Get("typenames"), ",")
+		}
+	}
+
+	return info
+}
+
+// matches reports whether the field has GraphQL name, ignoring case when fold is set.
+func (f *fieldInfo) matches(name string, fold bool) bool {
+	if f.name == "" {
+		return false
+	}
+
+	if !f.tagged || fold {
+		return strings.EqualFold(f.name, name)
+	}
+
+	return f.name == name
+}
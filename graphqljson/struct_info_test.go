@@ -0,0 +1,63 @@
+package graphqljson_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/gqlgo/gqlgenc/graphqljson"
+)
+
+func TestUnmarshalData_reusedDecoders(t *testing.T) {
+	t.Parallel()
+
+	type query struct {
+		Node struct {
+			Typename string `graphql:"__typename"`
+			User     *struct {
+				Name string
+			} `graphql:"... on User"`
+			Unknown *graphqljson.Unknown `graphql:"-" typenames:"User"`
+		}
+	}
+
+	// a failed decoding must not leave state behind for the next one
+	var failed query
+	if err := graphqljson.UnmarshalData([]byte(`{"node": {"__typename": "User", "name": `), &failed); err == nil {
+		t.Fatal("expected an error for truncated data")
+	}
+
+	var wg sync.WaitGroup
+
+	for i := range 16 {
+		wg.Go(func() {
+			data, want := []byte(`{"node": {"__typename": "User", "name": "Luke"}}`), "Luke"
+			if i%2 == 1 {
+				data, want = []byte(`{"node": {"__typename": "Droid", "name": "R2-D2"}}`), ""
+			}
+
+			var got query
+			if err := graphqljson.UnmarshalData(data, &got); err != nil {
+				t.Error(err)
+
+				return
+			}
+
+			var name string
+			if got.Node.User != nil {
+				name = got.Node.User.Name
+			}
+
+			if diff := cmp.Diff(want, name); diff != "" {
+				t.Error(diff)
+			}
+
+			if (got.Node.Unknown != nil) != (i%2 == 1) {
+				t.Errorf("unexpected unknown %+v", got.Node.Unknown)
+			}
+		})
+	}
+
+	wg.Wait()
+}
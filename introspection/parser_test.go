@@ -2,10 +2,13 @@ package introspection
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/gqlgo/gqlgenc/graphqljson"
 )
 
 func TestParseIntrospectionQuery_Parse(t *testing.T) {
@@ -52,3 +55,71 @@ func readQueryResult(t *testing.T, filename string) Query {
 
 	return query
 }
+
+func TestQuery_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var decoded Query
+	require.NoError(t, graphqljson.UnmarshalData([]byte(introspectedSchema), &decoded))
+
+	var query Query
+	require.NoError(t, json.Unmarshal([]byte(introspectedSchema), &query))
+	require.Equal(t, decoded, query, "encoding/json must decode what graphqljson does")
+	require.Len(t, query.Schema.Types, 8)
+}
+
+// largeIntrospectedSchema returns introspectedSchema with its types copied n times under other names, standing in
+// for the introspection response of a big schema.
+func largeIntrospectedSchema(tb testing.TB, n int) []byte {
+	tb.Helper()
+
+	var data map[string]any
+	require.NoError(tb, json.Unmarshal([]byte(introspectedSchema), &data))
+
+	schema, _ := data["__schema"].(map[string]any)
+	types, _ := schema["types"].([]any)
+
+	for i := range n {
+		for _, typ := range types {
+			var copied map[string]any
+
+			b, err := json.Marshal(typ)
+			require.NoError(tb, err)
+			require.NoError(tb, json.Unmarshal(b, &copied))
+
+			copied["name"] = fmt.Sprintf("%s%d", copied["name"], i)
+			schema["types"] = append(schema["types"].([]any), copied)
+		}
+	}
+
+	b, err := json.Marshal(data)
+	require.NoError(tb, err)
+
+	return b
+}
+
+func BenchmarkQuery_UnmarshalJSON(b *testing.B) {
+	data := largeIntrospectedSchema(b, 500)
+
+	b.Run("graphqljson", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for b.Loop() {
+			var query Query
+			if err := graphqljson.UnmarshalData(data, &query); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("encoding/json", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for b.Loop() {
+			var query Query
+			if err := query.UnmarshalJSON(data); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,119 @@
+package introspection
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// builtInScalars are declared by local schemas through the prelude and listed by introspection.
+var builtInScalars = []string{"Boolean", "Float", "ID", "Int", "String"}
+
+// SchemaHash returns the hex encoded SHA-256 of the canonical form of schema: its root operation types and
+// the kinds, fields, arguments, input fields, enum values, interfaces and union members of its types, sorted
+// by name. Descriptions, directives and default values are left out, since introspection does not return the
+// same ones as the files they are declared in, so a schema loaded from its files and the schema introspected
+// from a server running them have the same hash.
+func SchemaHash(schema *ast.Schema) string {
+	sum := sha256.Sum256([]byte(canonicalSchema(schema)))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// canonicalSchema returns the canonical form of schema hashed by SchemaHash.
+func canonicalSchema(schema *ast.Schema) string {
+	var b strings.Builder
+
+	b.WriteString("schema")
+
+	for _, root := range []struct {
+		operation  string
+		definition *ast.Definition
+	}{
+		{"query", schema.Query},
+		{"mutation", schema.Mutation},
+		{"subscription", schema.Subscription},
+	} {
+		if root.definition != nil {
+			b.WriteString(" " + root.operation + ": " + root.definition.Name)
+		}
+	}
+
+	b.WriteString("\n")
+
+	names := make([]string, 0, len(schema.Types))
+
+	for name, definition := range schema.Types {
+		if definition.BuiltIn || strings.HasPrefix(name, "__") || slices.Contains(builtInScalars, name) {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	for _, name := range names {
+		writeDefinition(&b, schema.Types[name])
+	}
+
+	return b.String()
+}
+
+func writeDefinition(b *strings.Builder, definition *ast.Definition) {
+	b.WriteString(strings.ToLower(string(definition.Kind)) + " " + definition.Name)
+
+	if len(definition.Interfaces) > 0 {
+		b.WriteString(" implements " + strings.Join(sorted(definition.Interfaces), " & "))
+	}
+
+	if len(definition.Types) > 0 {
+		b.WriteString(" = " + strings.Join(sorted(definition.Types), " | "))
+	}
+
+	b.WriteString("\n")
+
+	fields := slices.Clone(definition.Fields)
+	slices.SortFunc(fields, func(a, b *ast.FieldDefinition) int { return strings.Compare(a.Name, b.Name) })
+
+	for _, field := range fields {
+		if strings.HasPrefix(field.Name, "__") {
+			continue
+		}
+
+		b.WriteString("  " + field.Name)
+
+		if len(field.Arguments) > 0 {
+			arguments := make([]string, 0, len(field.Arguments))
+			for _, argument := range field.Arguments {
+				arguments = append(arguments, argument.Name+": "+argument.Type.String())
+			}
+
+			slices.Sort(arguments)
+			b.WriteString("(" + strings.Join(arguments, ", ") + ")")
+		}
+
+		b.WriteString(": " + field.Type.String() + "\n")
+	}
+
+	values := make([]string, 0, len(definition.EnumValues))
+	for _, value := range definition.EnumValues {
+		values = append(values, value.Name)
+	}
+
+	slices.Sort(values)
+
+	for _, value := range values {
+		b.WriteString("  " + value + "\n")
+	}
+}
+
+func sorted(names []string) []string {
+	names = slices.Clone(names)
+	slices.Sort(names)
+
+	return names
+}
@@ -0,0 +1,102 @@
+package introspection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gqlgo/gqlgenc/graphqljson"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/validator"
+)
+
+const hashedSchema = `
+type Query {
+	user(id: ID!): User
+}
+
+type User implements Node {
+	id: ID!
+	role: Role!
+}
+
+interface Node {
+	id: ID!
+}
+
+enum Role {
+	ADMIN
+	MEMBER
+}
+`
+
+// introspectedSchema is the introspection result of hashedSchema.
+const introspectedSchema = `{"__schema":{
+	"queryType":{"name":"Query"},
+	"types":[
+		{"kind":"OBJECT","name":"Query","fields":[{"name":"user","args":[{"name":"id","type":{"kind":"NON_NULL","ofType":{"kind":"SCALAR","name":"ID"}}}],"type":{"kind":"OBJECT","name":"User"}}],"interfaces":[]},
+		{"kind":"OBJECT","name":"User","fields":[
+			{"name":"id","args":[],"type":{"kind":"NON_NULL","ofType":{"kind":"SCALAR","name":"ID"}}},
+			{"name":"role","args":[],"type":{"kind":"NON_NULL","ofType":{"kind":"ENUM","name":"Role"}}}
+		],"interfaces":[{"kind":"INTERFACE","name":"Node"}]},
+		{"kind":"INTERFACE","name":"Node","fields":[{"name":"id","args":[],"type":{"kind":"NON_NULL","ofType":{"kind":"SCALAR","name":"ID"}}}],"possibleTypes":[{"kind":"OBJECT","name":"User"}]},
+		{"kind":"ENUM","name":"Role","enumValues":[{"name":"ADMIN"},{"name":"MEMBER"}]},
+		{"kind":"SCALAR","name":"ID"},
+		{"kind":"SCALAR","name":"String"},
+		{"kind":"SCALAR","name":"Boolean"},
+		{"kind":"OBJECT","name":"__Schema","fields":[{"name":"description","args":[],"type":{"kind":"SCALAR","name":"String"}}],"interfaces":[]}
+	],
+	"directives":[]
+}}`
+
+func TestSchemaHash(t *testing.T) {
+	t.Parallel()
+
+	hash := SchemaHash(gqlparser.MustLoadSchema(&ast.Source{Input: hashedSchema}))
+
+	t.Run("introspected schema", func(t *testing.T) {
+		t.Parallel()
+
+		var query Query
+		require.NoError(t, graphqljson.UnmarshalData([]byte(introspectedSchema), &query))
+
+		schema, err := validator.ValidateSchemaDocument(ParseIntrospectionQuery("test", query))
+		require.NoError(t, err)
+		require.Equal(t, hash, SchemaHash(schema))
+	})
+
+	t.Run("order, descriptions and directives", func(t *testing.T) {
+		t.Parallel()
+
+		schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+			enum Role { MEMBER ADMIN @deprecated }
+
+			"a user"
+			type User implements Node {
+				role: Role!
+				id: ID!
+			}
+
+			interface Node { id: ID! }
+
+			type Query { user("the id" id: ID!): User }
+		`})
+
+		require.Equal(t, hash, SchemaHash(schema))
+	})
+
+	t.Run("drift", func(t *testing.T) {
+		t.Parallel()
+
+		schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+			type Query { user(id: ID!): User }
+			type User implements Node { id: ID! role: Role }
+			interface Node { id: ID! }
+			enum Role { ADMIN MEMBER }
+		`})
+
+		require.NotEqual(t, hash, SchemaHash(schema))
+	})
+}
@@ -16,6 +16,7 @@ func main() {
 	var (
 		showVersion = flag.Bool("version", false, "print the version")
 		configDir   = flag.String("configdir", ".", "the directory with configuration file")
+		progress    = flag.Bool("progress", false, "print the phases of generation and the time each operation took")
 	)
 
 	flag.StringVar(configDir, "c", ".", "the directory with configuration file (shorthand)")
@@ -34,6 +35,10 @@ func main() {
 		os.Exit(2)
 	}
 
+	if *progress {
+		cfg.Progress = os.Stderr
+	}
+
 	ctx := context.Background()
 
 	err = generator.Generate(ctx, cfg)
@@ -0,0 +1,180 @@
+package parsequery
+
+import (
+	"maps"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// IteratorDirective marks a query whose single root field is a list paginated by limit and offset
+// variables, so an iterator method is generated for it. Its arguments name the variables:
+//
+//	query ListUsers($limit: Int!, $offset: Int!) @iterator(limit: "limit", offset: "offset") { ... }
+const IteratorDirective = "iterator"
+
+// StreamDirective marks a query whose single root field is a list, so a method decoding the list
+// one element at a time instead of as a whole is generated for it:
+//
+//	query ListUsers @streamList { ... }
+const StreamDirective = "streamList"
+
+// ClientDirective marks a field computed by the client rather than sent to the server. The field is
+// removed from the documents sent to the server and filled by a clientv2.ClientFieldResolver after
+// decoding the response; it is usually declared by a client schema extension:
+//
+//	query GetUser { user { id fullName @client } }
+const ClientDirective = "client"
+
+// SubsetDirective derives from a query an additional operation named name selecting only fields, so a slimmer
+// variant of a query is generated without a second query file drifting apart from the first. The fields are
+// paths of response keys separated by dots, relative to the root fields of the query; a field with a selection
+// set keeps all of it unless paths go on below it. The directive can be repeated for several subsets:
+//
+//	query GetUser($id: ID!) @subset(name: "GetUserSummary", fields: ["id", "name"]) { user(id: $id) { id name posts { title } } }
+const SubsetDirective = "subset"
+
+// SensitiveDirective marks a variable or a field holding a secret. With generate.redactSensitive, the String
+// method of the variables struct redacts the variable, and the transcripts of clientv2 hide both, so logging
+// operations does not leak them:
+//
+//	mutation Login($email: String!, $password: String! @sensitive) { login(email: $email, password: $password) { token @sensitive } }
+const SensitiveDirective = "sensitive"
+
+// clientDirectives are directives understood by gqlgenc itself. They are accepted in query files
+// without being declared in the schema and are removed from the documents sent to the server.
+var clientDirectives = map[string]*ast.DirectiveDefinition{
+	IteratorDirective: {
+		Name: IteratorDirective,
+		Arguments: ast.ArgumentDefinitionList{
+			{Name: "limit", Type: ast.NamedType("String", nil), DefaultValue: &ast.Value{Kind: ast.StringValue, Raw: "limit"}},
+			{Name: "offset", Type: ast.NamedType("String", nil), DefaultValue: &ast.Value{Kind: ast.StringValue, Raw: "offset"}},
+		},
+		Locations: []ast.DirectiveLocation{ast.LocationQuery},
+	},
+	StreamDirective: {
+		Name:      StreamDirective,
+		Locations: []ast.DirectiveLocation{ast.LocationQuery},
+	},
+	ClientDirective: {
+		Name:      ClientDirective,
+		Locations: []ast.DirectiveLocation{ast.LocationField},
+	},
+	SubsetDirective: {
+		Name: SubsetDirective,
+		Arguments: ast.ArgumentDefinitionList{
+			{Name: "name", Type: ast.NonNullNamedType("String", nil)},
+			{Name: "fields", Type: ast.NonNullListType(ast.NonNullNamedType("String", nil), nil)},
+		},
+		Locations:    []ast.DirectiveLocation{ast.LocationQuery},
+		IsRepeatable: true,
+	},
+	SensitiveDirective: {
+		Name:      SensitiveDirective,
+		Locations: []ast.DirectiveLocation{ast.LocationVariableDefinition, ast.LocationField},
+	},
+}
+
+// IsClientDirective reports whether name is a directive understood by gqlgenc rather than the server.
+func IsClientDirective(name string) bool {
+	_, ok := clientDirectives[name]
+
+	return ok
+}
+
+// StripClientDirectives returns directives without the client directives.
+func StripClientDirectives(directives ast.DirectiveList) ast.DirectiveList {
+	var stripped ast.DirectiveList
+
+	for _, directive := range directives {
+		if !IsClientDirective(directive.Name) {
+			stripped = append(stripped, directive)
+		}
+	}
+
+	return stripped
+}
+
+// StripClientVariableDirectives returns a copy of variableDefinitions without the client directives.
+func StripClientVariableDirectives(variableDefinitions ast.VariableDefinitionList) ast.VariableDefinitionList {
+	stripped := make(ast.VariableDefinitionList, 0, len(variableDefinitions))
+
+	for _, variableDefinition := range variableDefinitions {
+		variable := *variableDefinition
+		variable.Directives = StripClientDirectives(variableDefinition.Directives)
+		stripped = append(stripped, &variable)
+	}
+
+	return stripped
+}
+
+// StripClientFields returns a copy of selectionSet without the fields marked with @client, nor the client
+// directives of the other fields. The fragment
+// definitions are stripped on their own; spreads are copied too, since validating a document resolves
+// them to the definitions of the document.
+func StripClientFields(selectionSet ast.SelectionSet) ast.SelectionSet {
+	stripped := make(ast.SelectionSet, 0, len(selectionSet))
+
+	for _, selection := range selectionSet {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if selection.Directives.ForName(ClientDirective) != nil {
+				continue
+			}
+
+			field := *selection
+			field.Directives = StripClientDirectives(selection.Directives)
+			field.SelectionSet = StripClientFields(selection.SelectionSet)
+			stripped = append(stripped, &field)
+		case *ast.InlineFragment:
+			fragment := *selection
+			fragment.SelectionSet = StripClientFields(selection.SelectionSet)
+			stripped = append(stripped, &fragment)
+		case *ast.FragmentSpread:
+			spread := *selection
+			stripped = append(stripped, &spread)
+		}
+	}
+
+	return stripped
+}
+
+// SelectsClientFields reports whether selectionSet, or a fragment it spreads, selects a field marked with @client.
+func SelectsClientFields(selectionSet ast.SelectionSet) bool {
+	for _, selection := range selectionSet {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if selection.Directives.ForName(ClientDirective) != nil || SelectsClientFields(selection.SelectionSet) {
+				return true
+			}
+		case *ast.InlineFragment:
+			if SelectsClientFields(selection.SelectionSet) {
+				return true
+			}
+		case *ast.FragmentSpread:
+			if selection.Definition != nil && SelectsClientFields(selection.Definition.SelectionSet) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// schemaWithClientDirectives returns a shallow copy of schema declaring the client directives
+// it does not declare itself.
+func schemaWithClientDirectives(schema *ast.Schema) *ast.Schema {
+	withDirectives := *schema
+	withDirectives.Directives = maps.Clone(schema.Directives)
+
+	if withDirectives.Directives == nil {
+		withDirectives.Directives = make(map[string]*ast.DirectiveDefinition, len(clientDirectives))
+	}
+
+	for name, definition := range clientDirectives {
+		if _, ok := withDirectives.Directives[name]; !ok {
+			withDirectives.Directives[name] = definition
+		}
+	}
+
+	return &withDirectives
+}
@@ -0,0 +1,46 @@
+package parsequery
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Errors are the errors of query files, printed one per line as path:line:column: message like the errors of
+// compilers, so editors and terminals link them to their position.
+type Errors gqlerror.List
+
+func (errs Errors) Error() string {
+	lines := make([]string, 0, len(errs))
+	for _, err := range errs {
+		lines = append(lines, err.Error())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (errs Errors) Unwrap() []error {
+	return gqlerror.List(errs).Unwrap()
+}
+
+// AsErrors returns the errors of query files wrapped by err, which are reported as they are rather than
+// after the context of err, to keep their positions at the start of their lines.
+func AsErrors(err error) (Errors, bool) {
+	var errs Errors
+	if errors.As(err, &errs) {
+		return errs, true
+	}
+
+	var list gqlerror.List
+	if errors.As(err, &list) {
+		return Errors(list), true
+	}
+
+	var gqlErr *gqlerror.Error
+	if errors.As(err, &gqlErr) {
+		return Errors{gqlErr}, true
+	}
+
+	return nil, false
+}
@@ -4,31 +4,65 @@ import (
 	"fmt"
 
 	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 	"github.com/vektah/gqlparser/v2/parser"
-	"github.com/vektah/gqlparser/v2/validator"
 )
 
+// ParseQueryDocuments parses querySources into a single query document validated against schema with the rules
+// of the GraphQL specification.
 func ParseQueryDocuments(schema *ast.Schema, querySources []*ast.Source) (*ast.QueryDocument, error) {
+	queryDocument, _, err := ParseQueryDocumentsWithRules(schema, querySources, nil)
+
+	return queryDocument, err
+}
+
+// ParseQueryDocumentsWithRules is ParseQueryDocuments validating with rules, which returns the errors
+// of the rules downgraded to warnings.
+func ParseQueryDocumentsWithRules(schema *ast.Schema, querySources []*ast.Source, rules ValidationRules) (*ast.QueryDocument, Errors, error) {
 	var queryDocument ast.QueryDocument
 
 	for _, querySource := range querySources {
-		query, gqlerr := parser.ParseQuery(querySource)
-		if gqlerr != nil {
-			return nil, fmt.Errorf(": %w", gqlerr)
+		query, err := parser.ParseQuery(querySource)
+		if err != nil {
+			if errs, ok := AsErrors(err); ok {
+				return nil, nil, errs
+			}
+
+			return nil, nil, fmt.Errorf("%s: %w", querySource.Name, err)
 		}
 
 		mergeQueryDocument(&queryDocument, query)
 	}
 
-	errs := validator.Validate(schema, &queryDocument)
-	if errs != nil {
-		return nil, fmt.Errorf(": %w", errs)
+	warnings, err := rules.Validate(schemaWithClientDirectives(schema), &queryDocument)
+	if err != nil {
+		return nil, warnings, err
 	}
 
-	return &queryDocument, nil
+	return &queryDocument, warnings, nil
 }
 
 func mergeQueryDocument(q, other *ast.QueryDocument) {
 	q.Operations = append(q.Operations, other.Operations...)
 	q.Fragments = append(q.Fragments, other.Fragments...)
 }
+
+// RejectSubscriptions returns an error positioned at each subscription operation in the query document.
+// The generated client only sends requests over HTTP POST, which cannot deliver subscription events.
+func RejectSubscriptions(queryDocument *ast.QueryDocument) error {
+	var errs gqlerror.List
+
+	for _, operation := range queryDocument.Operations {
+		if operation.Operation != ast.Subscription {
+			continue
+		}
+
+		errs = append(errs, gqlerror.ErrorPosf(operation.Position, "subscription %s is not supported by the generated client; remove it from the query files or set generate.allowSubscriptions", operation.Name))
+	}
+
+	if len(errs) > 0 {
+		return Errors(errs)
+	}
+
+	return nil
+}
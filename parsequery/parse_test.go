@@ -0,0 +1,336 @@
+package parsequery_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gqlgo/gqlgenc/config"
+	"github.com/gqlgo/gqlgenc/parsequery"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const testSchema = `
+type Query {
+	hello: String!
+}
+
+type Subscription {
+	ticks: Int!
+}
+`
+
+func TestRejectSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: testSchema})
+
+	t.Run("query only", func(t *testing.T) {
+		t.Parallel()
+
+		doc, err := parsequery.ParseQueryDocuments(schema, []*ast.Source{{Name: "query.graphql", Input: `query Hello { hello }`}})
+		require.NoError(t, err)
+		require.NoError(t, parsequery.RejectSubscriptions(doc))
+	})
+
+	t.Run("subscription", func(t *testing.T) {
+		t.Parallel()
+
+		doc, err := parsequery.ParseQueryDocuments(schema, []*ast.Source{{Name: "query.graphql", Input: "query Hello { hello }\n\nsubscription Ticks { ticks }\n"}})
+		require.NoError(t, err)
+		require.ErrorContains(t, parsequery.RejectSubscriptions(doc), "query.graphql:3:1: subscription Ticks is not supported by the generated client; remove it from the query files or set generate.allowSubscriptions")
+	})
+}
+
+func TestParseQueryDocuments_clientDirectives(t *testing.T) {
+	t.Parallel()
+
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+type Query {
+	users(limit: Int!, offset: Int!): [String!]!
+}
+`})
+
+	doc, err := parsequery.ParseQueryDocuments(schema, []*ast.Source{{Name: "query.graphql", Input: `query ListUsers($limit: Int!, $offset: Int!) @iterator @streamList { users(limit: $limit, offset: $offset) }`}})
+	require.NoError(t, err)
+	require.NotNil(t, doc.Operations[0].Directives.ForName(parsequery.IteratorDirective))
+	require.NotNil(t, doc.Operations[0].Directives.ForName(parsequery.StreamDirective))
+	require.Empty(t, parsequery.StripClientDirectives(doc.Operations[0].Directives))
+
+	_, err = parsequery.ParseQueryDocuments(schema, []*ast.Source{{Name: "query.graphql", Input: `query ListUsers @unknown { users(limit: 1, offset: 0) }`}})
+	require.Error(t, err)
+}
+
+func TestParseQueryDocuments_errors(t *testing.T) {
+	t.Parallel()
+
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: testSchema})
+
+	t.Run("validation", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parsequery.ParseQueryDocuments(schema, []*ast.Source{
+			{Name: "queries/hello.graphql", Input: "query Hello {\n  hello\n  goodbye\n}\n"},
+			{Name: "queries/unused.graphql", Input: "query Unused($id: ID) {\n  hello\n}\n"},
+		})
+		require.EqualError(t, err, `queries/hello.graphql:3:3: Cannot query field "goodbye" on type "Query".
+queries/unused.graphql:1:14: Variable "$id" is never used in operation "Unused".`)
+
+		errs, ok := parsequery.AsErrors(fmt.Errorf("clientgen failed: %w", err))
+		require.True(t, ok)
+		require.Len(t, errs, 2)
+	})
+
+	t.Run("syntax", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parsequery.ParseQueryDocuments(schema, []*ast.Source{{Name: "queries/hello.graphql", Input: "query Hello {\n  hello\n"}})
+		require.EqualError(t, err, "queries/hello.graphql:3:1: Expected Name, found <EOF>")
+	})
+}
+
+func TestParseQueryDocumentsWithRules(t *testing.T) {
+	t.Parallel()
+
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+enum Role {
+	ADMIN
+	GUEST @deprecated(reason: "Use ANONYMOUS.")
+	ANONYMOUS
+}
+
+type User {
+	name: String!
+	login: String! @deprecated
+}
+
+type Bot {
+	name: String!
+}
+
+union Actor = User | Bot
+
+type Query {
+	users(role: Role): [User!]!
+	actors: [Actor!]!
+}
+`})
+
+	sources := []*ast.Source{{Name: "query.graphql", Input: `query Users($limit: Int) {
+  users(role: GUEST) {
+    login
+  }
+}
+
+query Actors {
+  actors {
+    ... on User {
+      name
+    }
+  }
+}
+`}}
+
+	t.Run("specification rules", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := parsequery.ParseQueryDocumentsWithRules(schema, sources, nil)
+		require.EqualError(t, err, `query.graphql:1:13: Variable "$limit" is never used in operation "Users".`)
+
+		_, warnings, err := parsequery.ParseQueryDocumentsWithRules(schema, sources, parsequery.ValidationRules{"NoUnusedVariables": config.RuleOff})
+		require.NoError(t, err)
+		require.Empty(t, warnings)
+
+		_, warnings, err = parsequery.ParseQueryDocumentsWithRules(schema, sources, parsequery.ValidationRules{"NoUnusedVariables": config.RuleWarning})
+		require.NoError(t, err)
+		require.EqualError(t, warnings, `query.graphql:1:13: warning: Variable "$limit" is never used in operation "Users".`)
+	})
+
+	t.Run("client rules", func(t *testing.T) {
+		t.Parallel()
+
+		_, warnings, err := parsequery.ParseQueryDocumentsWithRules(schema, sources, parsequery.ValidationRules{
+			"NoUnusedVariables":            config.RuleOff,
+			parsequery.NoDeprecatedRule:    config.RuleWarning,
+			parsequery.RequireTypenameRule: config.RuleError,
+		})
+		require.EqualError(t, err, `query.graphql:8:3: Field "actors" of UNION Actor must select __typename.`)
+		require.EqualError(t, warnings, `query.graphql:2:15: warning: The enum value Role.GUEST is deprecated: Use ANONYMOUS.
+query.graphql:3:5: warning: The field User.login is deprecated: No longer supported`)
+	})
+
+	t.Run("variables only", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := parsequery.ParseQueryDocumentsWithRules(schema, sources, parsequery.ValidationRules{
+			"NoUnusedVariables":          config.RuleOff,
+			parsequery.VariablesOnlyRule: config.RuleError,
+		})
+		require.EqualError(t, err, `query.graphql:2:9: The argument role of Query.users is a literal, pass it in a variable.`)
+
+		variableSources := []*ast.Source{{Name: "query.graphql", Input: `query Users($role: Role) {
+  users(role: $role) {
+    name
+  }
+}
+`}}
+
+		_, warnings, err := parsequery.ParseQueryDocumentsWithRules(schema, variableSources, parsequery.ValidationRules{parsequery.VariablesOnlyRule: config.RuleError})
+		require.NoError(t, err)
+		require.Empty(t, warnings)
+	})
+
+	t.Run("unknown severity", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := parsequery.ParseQueryDocumentsWithRules(schema, sources, parsequery.ValidationRules{"NoUnusedVariables": "ignore"})
+		require.ErrorContains(t, err, `validation rule NoUnusedVariables: unknown severity "ignore"`)
+	})
+}
+
+func TestStripClientFields(t *testing.T) {
+	t.Parallel()
+
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+type Query {
+	user: User
+}
+
+type User {
+	id: ID!
+	name: String!
+	isSelected: Boolean!
+}
+`})
+
+	doc, err := parsequery.ParseQueryDocuments(schema, []*ast.Source{{Name: "query.graphql", Input: `
+query GetUser { user { id @sensitive isSelected @client ...UserName } }
+
+fragment UserName on User { name isSelected @client }
+`}})
+	require.NoError(t, err)
+
+	selectionSet := doc.Operations[0].SelectionSet
+	require.True(t, parsequery.SelectsClientFields(selectionSet))
+	require.True(t, parsequery.SelectsClientFields(doc.Fragments[0].SelectionSet))
+
+	stripped := parsequery.StripClientFields(selectionSet)
+	require.False(t, parsequery.SelectsClientFields(parsequery.StripClientFields(doc.Fragments[0].SelectionSet)))
+
+	user, ok := stripped[0].(*ast.Field)
+	require.True(t, ok)
+	require.Len(t, user.SelectionSet, 2)
+	require.Empty(t, user.SelectionSet[0].(*ast.Field).Directives, "client directives of fields must be stripped")
+	require.Len(t, selectionSet[0].(*ast.Field).SelectionSet, 3, "the selection set must not be modified")
+}
+
+func TestLoadQuerySources(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "nested"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "nested", "query.graphql"), []byte("query Hello {\r\n  hello\r\n}\r\n"), 0o600))
+
+	sources, err := parsequery.LoadQuerySources([]string{
+		filepath.Join(dir, "nested", "*.graphql"),
+		filepath.Join(dir, "**", "*.graphql"),
+		dir + "/nested/../nested/query.graphql",
+	})
+	require.NoError(t, err)
+	require.Len(t, sources, 1)
+	require.Equal(t, filepath.ToSlash(filepath.Join(dir, "nested", "query.graphql")), sources[0].Name)
+	require.Equal(t, "query Hello {\n  hello\n}\n", sources[0].Input)
+}
+
+func TestDeriveSubsets(t *testing.T) {
+	t.Parallel()
+
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+type Query {
+	user(id: ID!): User
+}
+
+type User {
+	id: ID!
+	name: String!
+	email: String!
+	posts(first: Int): [Post!]!
+}
+
+type Post {
+	title: String!
+	body: String!
+}
+`})
+
+	parse := func(t *testing.T, input string) *ast.QueryDocument {
+		t.Helper()
+
+		doc, err := parsequery.ParseQueryDocuments(schema, []*ast.Source{{Name: "query.graphql", Input: input}})
+		require.NoError(t, err)
+
+		return doc
+	}
+
+	t.Run("subsets", func(t *testing.T) {
+		t.Parallel()
+
+		doc := parse(t, `
+query GetUser($id: ID!, $first: Int)
+@subset(name: "GetUserSummary", fields: ["id", "name"])
+@subset(name: "GetUserPostTitles", fields: ["posts.title"]) {
+	user(id: $id) { id ...UserProfile posts(first: $first) { title body } }
+}
+
+fragment UserProfile on User { name email }
+`)
+		require.NoError(t, parsequery.DeriveSubsets(doc))
+		require.Len(t, doc.Operations, 3)
+
+		summary := doc.Operations.ForName("GetUserSummary")
+		require.NotNil(t, summary)
+		require.Empty(t, summary.Directives)
+		require.Len(t, summary.VariableDefinitions, 1)
+		require.Equal(t, "id", summary.VariableDefinitions[0].Variable)
+
+		user, ok := summary.SelectionSet[0].(*ast.Field)
+		require.True(t, ok)
+		require.Len(t, user.SelectionSet, 2)
+		require.Equal(t, "name", user.SelectionSet[1].(*ast.Field).Name, "the fields of a fragment on the same type are inlined")
+
+		titles := doc.Operations.ForName("GetUserPostTitles")
+		require.NotNil(t, titles)
+		require.Len(t, titles.VariableDefinitions, 2)
+
+		posts, ok := titles.SelectionSet[0].(*ast.Field).SelectionSet[0].(*ast.Field)
+		require.True(t, ok)
+		require.Equal(t, "posts", posts.Name)
+		require.Len(t, posts.SelectionSet, 1)
+
+		require.Len(t, doc.Operations.ForName("GetUser").SelectionSet[0].(*ast.Field).SelectionSet, 3, "the query must not be modified")
+	})
+
+	t.Run("existing operation", func(t *testing.T) {
+		t.Parallel()
+
+		doc := parse(t, `
+query GetUser($id: ID!) @subset(name: "GetUserName", fields: ["name"]) { user(id: $id) { id name } }
+
+query GetUserName($id: ID!) { user(id: $id) { name } }
+`)
+		require.EqualError(t, parsequery.DeriveSubsets(doc), "query.graphql:2:26: subset GetUserName of GetUser: an operation named GetUserName already exists")
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		t.Parallel()
+
+		doc := parse(t, `query GetUser($id: ID!) @subset(name: "GetUserName", fields: ["name", "email", "posts.title"]) { user(id: $id) { id name } }`)
+		require.EqualError(t, parsequery.DeriveSubsets(doc), `query.graphql:1:26: subset GetUserName of GetUser: field email is not selected by the query
+query.graphql:1:26: subset GetUserName of GetUser: field posts.title is not selected by the query`)
+	})
+}
@@ -84,6 +84,7 @@ func LoadQuerySources(queryFileNames []string) ([]*ast.Source, error) {
 		}
 
 		for _, m := range matches {
+			m = normalizePath(m)
 			if noGlobQueryFileNames.Has(m) {
 				continue
 			}
@@ -106,8 +107,20 @@ func LoadQuerySources(queryFileNames []string) ([]*ast.Source, error) {
 			return nil, fmt.Errorf("unable to open schema: %w", err)
 		}
 
-		querySources = append(querySources, &ast.Source{Name: filename, Input: string(schemaRaw)})
+		querySources = append(querySources, &ast.Source{Name: filename, Input: normalizeLineEndings(schemaRaw)})
 	}
 
 	return querySources, nil
 }
+
+// normalizePath cleans path and uses forward slashes, so the same file matched by several patterns is loaded once
+// and named the same on every platform.
+func normalizePath(path string) string {
+	return filepath.ToSlash(filepath.Clean(path))
+}
+
+// normalizeLineEndings converts the CRLF line endings of files checked out on Windows to LF,
+// so the documents generated from them do not depend on the platform.
+func normalizeLineEndings(content []byte) string {
+	return strings.ReplaceAll(string(content), "\r\n", "\n")
+}
@@ -0,0 +1,226 @@
+package parsequery
+
+import (
+	"fmt"
+
+	"github.com/gqlgo/gqlgenc/config"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/validator"
+)
+
+// The client rules check what the GraphQL specification allows but clients usually want to avoid.
+// They are off unless enabled in generate.validationRules.
+const (
+	// NoDeprecatedRule reports the deprecated fields, arguments and enum values used by queries.
+	NoDeprecatedRule = "NoDeprecated"
+	// RequireTypenameRule reports the selections of unions and interfaces without __typename, without which
+	// responses do not tell which member they hold.
+	RequireTypenameRule = "RequireTypename"
+	// VariablesOnlyRule reports the arguments of fields given literal values rather than variables, which make
+	// documents differ by value and defeat caching and operation allowlists.
+	VariablesOnlyRule = "VariablesOnly"
+)
+
+var clientRules = []validator.Rule{
+	{Name: NoDeprecatedRule, RuleFunc: noDeprecated},
+	{Name: RequireTypenameRule, RuleFunc: requireTypename},
+	{Name: VariablesOnlyRule, RuleFunc: variablesOnly},
+}
+
+// ValidationRules are the severities of the rules queries are validated with, by rule name. The rules of the
+// GraphQL specification not listed are errors and the client rules not listed are off.
+type ValidationRules map[string]config.RuleSeverity
+
+// Validate validates queryDocument against schema. The errors of the rules downgraded to warnings are
+// returned apart, with their messages starting with "warning: ", and the errors of the others fail.
+func (r ValidationRules) Validate(schema *ast.Schema, queryDocument *ast.QueryDocument) (Errors, error) {
+	for name, severity := range r {
+		switch severity {
+		case config.RuleError, config.RuleWarning, config.RuleOff:
+		default:
+			return nil, fmt.Errorf("validation rule %s: unknown severity %q, want error, warning or off", name, severity)
+		}
+	}
+
+	errs := validator.Validate(schema, queryDocument)
+
+	var enabled []validator.Rule
+
+	for _, rule := range clientRules {
+		if severity, ok := r[rule.Name]; ok && severity != config.RuleOff {
+			enabled = append(enabled, rule)
+		}
+	}
+
+	if len(enabled) > 0 {
+		errs = append(errs, validator.Validate(schema, queryDocument, enabled...)...)
+	}
+
+	var warnings, failures Errors
+
+	for _, err := range errs {
+		if isRepeatedDirectiveError(schema, err) {
+			continue
+		}
+
+		switch r[err.Rule] {
+		case config.RuleOff:
+		case config.RuleWarning:
+			warning := *err
+			warning.Message = "warning: " + warning.Message
+			warnings = append(warnings, &warning)
+		default:
+			failures = append(failures, err)
+		}
+	}
+
+	if len(failures) > 0 {
+		return warnings, failures
+	}
+
+	return warnings, nil
+}
+
+// isRepeatedDirectiveError reports whether err reports a directive used several times at a location while its
+// definition in schema is repeatable, which the rule UniqueDirectivesPerLocation of gqlparser does not check.
+func isRepeatedDirectiveError(schema *ast.Schema, err *gqlerror.Error) bool {
+	if err.Rule != "UniqueDirectivesPerLocation" {
+		return false
+	}
+
+	for name, definition := range schema.Directives {
+		if definition.IsRepeatable && err.Message == fmt.Sprintf(`The directive "@%s" can only be used once at this location.`, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func noDeprecated(observers *validator.Events, addError validator.AddErrFunc) {
+	observers.OnField(func(_ *validator.Walker, field *ast.Field) {
+		if field.Definition == nil {
+			return
+		}
+
+		if reason, ok := deprecationReason(field.Definition.Directives); ok {
+			addError(validator.Message(`The field %s.%s is deprecated: %s`, field.ObjectDefinition.Name, field.Name, reason), validator.At(field.Position))
+		}
+
+		for _, argument := range field.Arguments {
+			definition := field.Definition.Arguments.ForName(argument.Name)
+			if definition == nil {
+				continue
+			}
+
+			if reason, ok := deprecationReason(definition.Directives); ok {
+				addError(validator.Message(`The argument %s of %s.%s is deprecated: %s`, argument.Name, field.ObjectDefinition.Name, field.Name, reason), validator.At(argument.Position))
+			}
+		}
+	})
+
+	observers.OnValue(func(_ *validator.Walker, value *ast.Value) {
+		if value.Kind != ast.EnumValue || value.Definition == nil {
+			return
+		}
+
+		enumValue := value.Definition.EnumValues.ForName(value.Raw)
+		if enumValue == nil {
+			return
+		}
+
+		if reason, ok := deprecationReason(enumValue.Directives); ok {
+			addError(validator.Message(`The enum value %s.%s is deprecated: %s`, value.Definition.Name, value.Raw, reason), validator.At(value.Position))
+		}
+	})
+}
+
+// deprecationReason returns the reason of the @deprecated directive of directives, and whether there is one.
+func deprecationReason(directives ast.DirectiveList) (string, bool) {
+	deprecated := directives.ForName("deprecated")
+	if deprecated == nil {
+		return "", false
+	}
+
+	if reason := deprecated.Arguments.ForName("reason"); reason != nil && reason.Value != nil {
+		return reason.Value.Raw, true
+	}
+
+	return "No longer supported", true
+}
+
+func requireTypename(observers *validator.Events, addError validator.AddErrFunc) {
+	observers.OnField(func(walker *validator.Walker, field *ast.Field) {
+		if field.Definition == nil {
+			return
+		}
+
+		definition := walker.Schema.Types[field.Definition.Type.Name()]
+		if definition == nil || !definition.IsAbstractType() || selectsTypename(field.SelectionSet, definition.Name) {
+			return
+		}
+
+		addError(validator.Message(`Field "%s" of %s %s must select __typename.`, field.Alias, definition.Kind, definition.Name), validator.At(field.Position))
+	})
+}
+
+// selectsTypename reports whether selectionSet, selected on the type named typeName, selects __typename
+// directly or through fragments on the same type.
+func selectsTypename(selectionSet ast.SelectionSet, typeName string) bool {
+	for _, selection := range selectionSet {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if selection.Name == "__typename" {
+				return true
+			}
+		case *ast.InlineFragment:
+			if (selection.TypeCondition == "" || selection.TypeCondition == typeName) && selectsTypename(selection.SelectionSet, typeName) {
+				return true
+			}
+		case *ast.FragmentSpread:
+			if selection.Definition != nil && selection.Definition.TypeCondition == typeName && selectsTypename(selection.Definition.SelectionSet, typeName) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func variablesOnly(observers *validator.Events, addError validator.AddErrFunc) {
+	observers.OnField(func(_ *validator.Walker, field *ast.Field) {
+		if field.ObjectDefinition == nil {
+			return
+		}
+
+		for _, argument := range field.Arguments {
+			if hasLiteral(argument.Value) {
+				addError(validator.Message(`The argument %s of %s.%s is a literal, pass it in a variable.`, argument.Name, field.ObjectDefinition.Name, field.Name), validator.At(argument.Position))
+			}
+		}
+	})
+}
+
+// hasLiteral reports whether value is or holds a literal, lists and objects of variables only are not.
+func hasLiteral(value *ast.Value) bool {
+	if value == nil {
+		return false
+	}
+
+	switch value.Kind {
+	case ast.Variable:
+		return false
+	case ast.ListValue, ast.ObjectValue:
+		for _, child := range value.Children {
+			if hasLiteral(child.Value) {
+				return true
+			}
+		}
+
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,314 @@
+package parsequery
+
+import (
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// subsetPath is a node of the tree of field paths of a @subset, keyed by response key.
+type subsetPath struct {
+	children map[string]*subsetPath
+	matched  bool
+}
+
+// DeriveSubsets appends to queryDocument the operations derived from its queries with @subset, and returns an
+// error positioned at each subset naming an existing operation or a field the query does not select.
+// Fragment spreads narrowed by a subset become inline fragments, or their fields when the fragment is on the
+// type it is spread in, since the fragment itself stays whole.
+func DeriveSubsets(queryDocument *ast.QueryDocument) error {
+	var errs gqlerror.List
+
+	names := make(map[string]bool, len(queryDocument.Operations))
+	for _, operation := range queryDocument.Operations {
+		names[operation.Name] = true
+	}
+
+	var subsets ast.OperationList
+
+	for _, operation := range queryDocument.Operations {
+		for _, directive := range operation.Directives.ForNames(SubsetDirective) {
+			name := directive.Arguments.ForName("name").Value.Raw
+			if names[name] {
+				errs = append(errs, gqlerror.ErrorPosf(directive.Position, "subset %s of %s: an operation named %s already exists", name, operation.Name, name))
+
+				continue
+			}
+
+			names[name] = true
+
+			root := &subsetPath{children: make(map[string]*subsetPath)}
+
+			var paths []string
+
+			for _, child := range directive.Arguments.ForName("fields").Value.Children {
+				paths = append(paths, child.Value.Raw)
+
+				path := root
+				for _, key := range strings.Split(child.Value.Raw, ".") {
+					if path.children == nil {
+						path.children = make(map[string]*subsetPath)
+					}
+
+					if path.children[key] == nil {
+						path.children[key] = &subsetPath{}
+					}
+
+					path = path.children[key]
+				}
+			}
+
+			subset, err := deriveSubset(operation, name, root, directive.Position)
+			if err != nil {
+				errs = append(errs, err)
+
+				continue
+			}
+
+			for _, path := range paths {
+				if !root.matches(strings.Split(path, ".")) {
+					errs = append(errs, gqlerror.ErrorPosf(directive.Position, "subset %s of %s: field %s is not selected by the query", name, operation.Name, path))
+				}
+			}
+
+			subsets = append(subsets, subset)
+		}
+	}
+
+	if len(errs) > 0 {
+		return Errors(errs)
+	}
+
+	queryDocument.Operations = append(queryDocument.Operations, subsets...)
+
+	return nil
+}
+
+// deriveSubset returns the operation name selecting the fields of root in the root fields of operation, with
+// the variables the remaining arguments use.
+func deriveSubset(operation *ast.OperationDefinition, name string, root *subsetPath, position *ast.Position) (*ast.OperationDefinition, *gqlerror.Error) {
+	var selectionSet ast.SelectionSet
+
+	for _, selection := range operation.SelectionSet {
+		field, ok := selection.(*ast.Field)
+		if !ok || len(field.SelectionSet) == 0 {
+			selectionSet = append(selectionSet, selection)
+
+			continue
+		}
+
+		subsetField := *field
+
+		subsetField.SelectionSet = subsetSelectionSet(field.SelectionSet, root)
+		if len(subsetField.SelectionSet) > 0 {
+			selectionSet = append(selectionSet, &subsetField)
+		}
+	}
+
+	if len(selectionSet) == 0 {
+		return nil, gqlerror.ErrorPosf(position, "subset %s of %s selects no field", name, operation.Name)
+	}
+
+	variables := make(map[string]bool)
+	collectSelectionVariables(selectionSet, variables)
+
+	subset := &ast.OperationDefinition{
+		Operation:    operation.Operation,
+		Name:         name,
+		SelectionSet: selectionSet,
+		Position:     position,
+	}
+
+	for _, directive := range operation.Directives {
+		if directive.Name != SubsetDirective {
+			subset.Directives = append(subset.Directives, directive)
+		}
+	}
+
+	for _, variable := range operation.VariableDefinitions {
+		if variables[variable.Variable] {
+			subset.VariableDefinitions = append(subset.VariableDefinitions, variable)
+		}
+	}
+
+	return subset, nil
+}
+
+// subsetSelectionSet returns the selections of selectionSet on the paths of path, marking the paths it finds.
+// __typename is kept wherever it is selected, since decoding abstract types relies on it.
+func subsetSelectionSet(selectionSet ast.SelectionSet, path *subsetPath) ast.SelectionSet {
+	var subset ast.SelectionSet
+
+	for _, selection := range selectionSet {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			child := path.children[selection.Alias]
+			if child == nil {
+				if selection.Name == "__typename" {
+					subset = append(subset, selection)
+				}
+
+				continue
+			}
+
+			if len(child.children) == 0 || len(selection.SelectionSet) == 0 {
+				child.matched = true
+
+				subset = append(subset, selection)
+
+				continue
+			}
+
+			field := *selection
+
+			field.SelectionSet = subsetSelectionSet(selection.SelectionSet, child)
+			if len(field.SelectionSet) > 0 {
+				subset = append(subset, &field)
+			}
+		case *ast.InlineFragment:
+			fragment := *selection
+
+			fragment.SelectionSet = subsetSelectionSet(selection.SelectionSet, path)
+			if selectsField(fragment.SelectionSet) {
+				subset = append(subset, &fragment)
+			}
+		case *ast.FragmentSpread:
+			if selection.Definition == nil {
+				continue
+			}
+
+			fragment := &ast.InlineFragment{
+				TypeCondition:    selection.Definition.TypeCondition,
+				Directives:       selection.Directives,
+				SelectionSet:     subsetSelectionSet(selection.Definition.SelectionSet, path),
+				ObjectDefinition: selection.ObjectDefinition,
+				Position:         selection.Position,
+			}
+			if !selectsField(fragment.SelectionSet) {
+				continue
+			}
+
+			// a fragment on the type it is spread in adds its fields to the selection set itself, unless the
+			// selection set selects them too and the generated struct would have them twice
+			if len(fragment.Directives) == 0 && selection.ObjectDefinition != nil && fragment.TypeCondition == selection.ObjectDefinition.Name &&
+				!selectsKeyOf(selectionSet, fragment.SelectionSet) {
+				subset = append(subset, fragment.SelectionSet...)
+			} else {
+				subset = append(subset, fragment)
+			}
+		}
+	}
+
+	if !selectsField(subset) {
+		return nil
+	}
+
+	return subset
+}
+
+// selectsKeyOf reports whether selectionSet directly selects a field with the response key of a field of other.
+func selectsKeyOf(selectionSet, other ast.SelectionSet) bool {
+	keys := make(map[string]bool, len(selectionSet))
+
+	for _, selection := range selectionSet {
+		if field, ok := selection.(*ast.Field); ok {
+			keys[field.Alias] = true
+		}
+	}
+
+	for _, selection := range other {
+		if field, ok := selection.(*ast.Field); ok && keys[field.Alias] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// selectsField reports whether selectionSet selects a field other than __typename.
+func selectsField(selectionSet ast.SelectionSet) bool {
+	for _, selection := range selectionSet {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if selection.Name != "__typename" {
+				return true
+			}
+		case *ast.InlineFragment:
+			if selectsField(selection.SelectionSet) {
+				return true
+			}
+		case *ast.FragmentSpread:
+			return true
+		}
+	}
+
+	return false
+}
+
+// matches reports whether the field at keys, or the paths below it, were found in the query.
+func (p *subsetPath) matches(keys []string) bool {
+	path := p
+	for _, key := range keys {
+		path = path.children[key]
+	}
+
+	if path.matched {
+		return true
+	}
+
+	for _, child := range path.children {
+		if child.matches(nil) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// collectSelectionVariables adds to variables the names of the variables the arguments and directives of
+// selectionSet refer to.
+func collectSelectionVariables(selectionSet ast.SelectionSet, variables map[string]bool) {
+	for _, selection := range selectionSet {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			for _, argument := range selection.Arguments {
+				collectValueVariables(argument.Value, variables)
+			}
+
+			collectDirectiveVariables(selection.Directives, variables)
+			collectSelectionVariables(selection.SelectionSet, variables)
+		case *ast.InlineFragment:
+			collectDirectiveVariables(selection.Directives, variables)
+			collectSelectionVariables(selection.SelectionSet, variables)
+		case *ast.FragmentSpread:
+			collectDirectiveVariables(selection.Directives, variables)
+
+			if selection.Definition != nil {
+				collectSelectionVariables(selection.Definition.SelectionSet, variables)
+			}
+		}
+	}
+}
+
+func collectDirectiveVariables(directives ast.DirectiveList, variables map[string]bool) {
+	for _, directive := range directives {
+		for _, argument := range directive.Arguments {
+			collectValueVariables(argument.Value, variables)
+		}
+	}
+}
+
+func collectValueVariables(value *ast.Value, variables map[string]bool) {
+	if value == nil {
+		return
+	}
+
+	if value.Kind == ast.Variable {
+		variables[value.Raw] = true
+	}
+
+	for _, child := range value.Children {
+		collectValueVariables(child.Value, variables)
+	}
+}
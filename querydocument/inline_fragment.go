@@ -0,0 +1,126 @@
+package querydocument
+
+import (
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// InlineSingleUseFragments replaces the spread of every fragment that is spread exactly once
+// in the query document with the fragment's own selections, and removes the fragment definition.
+//
+// A spread on the same type as its parent is spliced into the parent selection set, so the
+// fields end up directly on the parent struct. A spread on a different type becomes an inline
+// fragment. Spreads with directives, and spreads whose fields would collide with a sibling
+// field of the same response name, are left untouched.
+func InlineSingleUseFragments(queryDocument *ast.QueryDocument) {
+	uses := make(map[string]int)
+
+	for _, operation := range queryDocument.Operations {
+		countFragmentSpreads(operation.SelectionSet, uses)
+	}
+
+	for _, fragment := range queryDocument.Fragments {
+		countFragmentSpreads(fragment.SelectionSet, uses)
+	}
+
+	inlined := make(map[string]bool)
+
+	for _, operation := range queryDocument.Operations {
+		operation.SelectionSet = inlineFragmentSpreads(operation.SelectionSet, uses, inlined)
+	}
+
+	for _, fragment := range queryDocument.Fragments {
+		fragment.SelectionSet = inlineFragmentSpreads(fragment.SelectionSet, uses, inlined)
+	}
+
+	fragments := make(ast.FragmentDefinitionList, 0, len(queryDocument.Fragments))
+	for _, fragment := range queryDocument.Fragments {
+		if !inlined[fragment.Name] {
+			fragments = append(fragments, fragment)
+		}
+	}
+
+	queryDocument.Fragments = fragments
+}
+
+func countFragmentSpreads(selectionSet ast.SelectionSet, uses map[string]int) {
+	for _, selection := range selectionSet {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			countFragmentSpreads(selection.SelectionSet, uses)
+		case *ast.InlineFragment:
+			countFragmentSpreads(selection.SelectionSet, uses)
+		case *ast.FragmentSpread:
+			uses[selection.Name]++
+		}
+	}
+}
+
+func inlineFragmentSpreads(selectionSet ast.SelectionSet, uses map[string]int, inlined map[string]bool) ast.SelectionSet {
+	result := make(ast.SelectionSet, 0, len(selectionSet))
+
+	for i, selection := range selectionSet {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			selection.SelectionSet = inlineFragmentSpreads(selection.SelectionSet, uses, inlined)
+		case *ast.InlineFragment:
+			selection.SelectionSet = inlineFragmentSpreads(selection.SelectionSet, uses, inlined)
+		case *ast.FragmentSpread:
+			siblings := make(ast.SelectionSet, 0, len(result)+len(selectionSet)-i)
+			siblings = append(append(siblings, result...), selectionSet[i+1:]...)
+
+			if !canInlineFragmentSpread(selection, siblings, uses) {
+				break
+			}
+
+			inlined[selection.Name] = true
+			fragment := selection.Definition
+			selections := inlineFragmentSpreads(fragment.SelectionSet, uses, inlined)
+
+			if selection.ObjectDefinition != nil && selection.ObjectDefinition.Name == fragment.TypeCondition {
+				result = append(result, selections...)
+
+				continue
+			}
+
+			result = append(result, &ast.InlineFragment{
+				TypeCondition:    fragment.TypeCondition,
+				SelectionSet:     selections,
+				ObjectDefinition: fragment.Definition,
+				Position:         selection.Position,
+			})
+
+			continue
+		}
+
+		result = append(result, selection)
+	}
+
+	return result
+}
+
+func canInlineFragmentSpread(spread *ast.FragmentSpread, siblings ast.SelectionSet, uses map[string]int) bool {
+	if uses[spread.Name] != 1 || spread.Definition == nil || len(spread.Directives) > 0 {
+		return false
+	}
+
+	if spread.ObjectDefinition == nil || spread.ObjectDefinition.Name != spread.Definition.TypeCondition {
+		// becomes an inline fragment, so its fields do not share the parent's namespace
+		return true
+	}
+
+	names := make(map[string]bool)
+
+	for _, sibling := range siblings {
+		if field, ok := sibling.(*ast.Field); ok {
+			names[field.Alias] = true
+		}
+	}
+
+	for _, selection := range spread.Definition.SelectionSet {
+		if field, ok := selection.(*ast.Field); ok && names[field.Alias] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,136 @@
+package querydocument
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gqlgo/gqlgenc/parsequery"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ValidateNextSchema validates querySources against nextSchema, the upcoming version of the schema of the server,
+// and returns its errors with the operations they break: the operation they are in, or the operations spreading
+// the fragment they are in. queryDocument is querySources validated against the current schema.
+func ValidateNextSchema(nextSchema *ast.Schema, querySources []*ast.Source, queryDocument *ast.QueryDocument) (parsequery.Errors, error) {
+	_, _, err := parsequery.ParseQueryDocumentsWithRules(nextSchema, querySources, nil)
+	if err == nil {
+		return nil, nil
+	}
+
+	errs, ok := parsequery.AsErrors(err)
+	if !ok {
+		return nil, err
+	}
+
+	breaks := make(parsequery.Errors, 0, len(errs))
+	// the errors of fragments are reported once for each time they are visited
+	seen := make(map[string]bool, len(errs))
+
+	for _, err := range errs {
+		if seen[err.Error()] {
+			continue
+		}
+
+		seen[err.Error()] = true
+
+		operations := brokenOperations(queryDocument, err.Extensions["file"], err.Locations)
+
+		broken := *err
+		if len(operations) > 0 {
+			broken.Message = "next schema breaks " + strings.Join(operations, ", ") + ": " + err.Message
+		} else {
+			broken.Message = "next schema: " + err.Message
+		}
+
+		breaks = append(breaks, &broken)
+	}
+
+	sort.SliceStable(breaks, func(i, j int) bool {
+		return errorBefore(breaks[i], breaks[j])
+	})
+
+	return breaks, nil
+}
+
+// brokenOperations returns the names of the operations of queryDocument broken by an error at the first of
+// locations in file, from the operation or fragment definition starting last before it.
+func brokenOperations(queryDocument *ast.QueryDocument, file any, locations []gqlerror.Location) []string {
+	if len(locations) == 0 {
+		return nil
+	}
+
+	location := locations[0]
+
+	var (
+		definition *ast.Position
+		operation  *ast.OperationDefinition
+		fragment   *ast.FragmentDefinition
+	)
+
+	contains := func(position *ast.Position) bool {
+		if position == nil || position.Src == nil || position.Src.Name != file {
+			return false
+		}
+
+		if position.Line > location.Line || position.Line == location.Line && position.Column > location.Column {
+			return false
+		}
+
+		return definition == nil || position.Line > definition.Line ||
+			position.Line == definition.Line && position.Column > definition.Column
+	}
+
+	for _, o := range queryDocument.Operations {
+		if contains(o.Position) {
+			definition, operation, fragment = o.Position, o, nil
+		}
+	}
+
+	for _, f := range queryDocument.Fragments {
+		if contains(f.Position) {
+			definition, operation, fragment = f.Position, nil, f
+		}
+	}
+
+	if operation != nil {
+		return []string{operation.Name}
+	}
+
+	if fragment == nil {
+		return nil
+	}
+
+	var names []string
+
+	for _, o := range queryDocument.Operations {
+		for _, f := range fragmentsInOperationDefinition(o) {
+			if f.Name == fragment.Name {
+				names = append(names, o.Name)
+
+				break
+			}
+		}
+	}
+
+	return names
+}
+
+// errorBefore reports whether a is positioned before b, by file, line and column.
+func errorBefore(a, b *gqlerror.Error) bool {
+	if fileA, fileB := fmt.Sprint(a.Extensions["file"]), fmt.Sprint(b.Extensions["file"]); fileA != fileB {
+		return fileA < fileB
+	}
+
+	if len(a.Locations) == 0 || len(b.Locations) == 0 {
+		return len(a.Locations) < len(b.Locations)
+	}
+
+	if a.Locations[0].Line != b.Locations[0].Line {
+		return a.Locations[0].Line < b.Locations[0].Line
+	}
+
+	return a.Locations[0].Column < b.Locations[0].Column
+}
@@ -0,0 +1,79 @@
+package querydocument_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/gqlgo/gqlgenc/parsequery"
+	"github.com/gqlgo/gqlgenc/querydocument"
+)
+
+func TestValidateNextSchema(t *testing.T) {
+	t.Parallel()
+
+	const query = `query Todos {
+	todos {
+		...TodoFields
+	}
+}
+
+query SortedTodos($order: SortOrder!) {
+	todosBySortOrder(order: $order) {
+		id
+	}
+}
+
+query TodoTexts {
+	todos {
+		...TodoFields
+	}
+}
+
+fragment TodoFields on Todo {
+	id
+	text
+}
+`
+
+	querySources := []*ast.Source{{Name: "todos.graphql", Input: query}}
+
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: testSchema})
+	queryDocument, err := parsequery.ParseQueryDocuments(schema, querySources)
+	require.NoError(t, err)
+
+	t.Run("compatible", func(t *testing.T) {
+		t.Parallel()
+
+		errs, err := querydocument.ValidateNextSchema(schema, querySources, queryDocument)
+		require.NoError(t, err)
+		require.Empty(t, errs)
+	})
+
+	t.Run("breaking", func(t *testing.T) {
+		t.Parallel()
+
+		nextSchema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+type Query {
+	todos: [Todo!]!
+}
+
+type Todo {
+	id: ID!
+	title: String!
+}
+
+enum SortOrder {
+	ASC
+	DESC
+}
+`})
+
+		errs, err := querydocument.ValidateNextSchema(nextSchema, querySources, queryDocument)
+		require.NoError(t, err)
+		require.Equal(t, `todos.graphql:8:2: next schema breaks SortedTodos: Cannot query field "todosBySortOrder" on type "Query".
+todos.graphql:21:2: next schema breaks Todos, TodoTexts: Cannot query field "text" on type "Todo".`, errs.Error())
+	})
+}
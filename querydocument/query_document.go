@@ -1,26 +1,39 @@
 package querydocument
 
 import (
-	"fmt"
+	"github.com/gqlgo/gqlgenc/parsequery"
 
 	"github.com/vektah/gqlparser/v2/ast"
-	"github.com/vektah/gqlparser/v2/validator"
 )
 
-func QueryDocumentsByOperations(schema *ast.Schema, operations ast.OperationList) ([]*ast.QueryDocument, error) {
+// QueryDocumentsByOperations returns the document sent to the server for each operation, validated with rules.
+// Their warnings are not returned, since the query document the operations come from has the same.
+func QueryDocumentsByOperations(schema *ast.Schema, operations ast.OperationList, rules parsequery.ValidationRules) ([]*ast.QueryDocument, error) {
 	queryDocuments := make([]*ast.QueryDocument, 0, len(operations))
 	for _, operation := range operations {
 		fragments := fragmentsInOperationDefinition(operation)
 
+		// the document is sent to the server, which does not know the client directives nor the client fields
+		sentOperation := *operation
+		sentOperation.Directives = parsequery.StripClientDirectives(operation.Directives)
+		sentOperation.VariableDefinitions = parsequery.StripClientVariableDirectives(operation.VariableDefinitions)
+		sentOperation.SelectionSet = parsequery.StripClientFields(operation.SelectionSet)
+
+		for i, fragment := range fragments {
+			sentFragment := *fragment
+			sentFragment.SelectionSet = parsequery.StripClientFields(fragment.SelectionSet)
+			fragments[i] = &sentFragment
+		}
+
 		queryDocument := &ast.QueryDocument{
-			Operations: ast.OperationList{operation},
+			Operations: ast.OperationList{&sentOperation},
 			Fragments:  fragments,
 			Position:   nil,
 		}
 
-		errs := validator.Validate(schema, queryDocument)
-		if errs != nil {
-			return nil, fmt.Errorf(": %w", errs)
+		_, err := rules.Validate(schema, queryDocument)
+		if err != nil {
+			return nil, err
 		}
 
 		queryDocuments = append(queryDocuments, queryDocument)
@@ -63,7 +63,7 @@ func loadSchemaAndQuery(t *testing.T, query string) (*ast.Schema, []*ast.QueryDo
 	doc, errs := gqlparser.LoadQuery(schema, query)
 	require.Empty(t, errs)
 
-	docs, err := querydocument.QueryDocumentsByOperations(schema, doc.Operations)
+	docs, err := querydocument.QueryDocumentsByOperations(schema, doc.Operations, nil)
 	require.NoError(t, err)
 
 	return schema, docs
@@ -148,3 +148,63 @@ func TestCollectTypesFromQueryDocuments(t *testing.T) {
 	})
 
 }
+
+func TestInlineSingleUseFragments(t *testing.T) {
+	t.Parallel()
+
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: testSchema})
+	doc, errs := gqlparser.LoadQuery(schema, `
+		query Todos {
+			todos {
+				...TodoFields
+			}
+		}
+
+		query SortedTodos($order: SortOrder!) {
+			todosBySortOrder(order: $order) {
+				id
+				...TodoID
+				...TodoStatus
+			}
+		}
+
+		query MoreTodos {
+			todos {
+				...TodoStatus
+			}
+		}
+
+		fragment TodoFields on Todo {
+			id
+			text
+		}
+
+		fragment TodoID on Todo {
+			id
+		}
+
+		fragment TodoStatus on Todo {
+			status
+		}
+	`)
+	require.Empty(t, errs)
+
+	querydocument.InlineSingleUseFragments(doc)
+
+	fragmentNames := make([]string, 0, len(doc.Fragments))
+	for _, fragment := range doc.Fragments {
+		fragmentNames = append(fragmentNames, fragment.Name)
+	}
+
+	// TodoFields is spliced into todos, TodoID collides with the sibling id field,
+	// and TodoStatus is spread twice.
+	require.Equal(t, []string{"TodoID", "TodoStatus"}, fragmentNames)
+
+	todos := doc.Operations.ForName("Todos").SelectionSet[0].(*ast.Field)
+	require.Len(t, todos.SelectionSet, 2)
+	require.Equal(t, "id", todos.SelectionSet[0].(*ast.Field).Name)
+	require.Equal(t, "text", todos.SelectionSet[1].(*ast.Field).Name)
+
+	_, err := querydocument.QueryDocumentsByOperations(schema, doc.Operations, nil)
+	require.NoError(t, err)
+}